@@ -65,4 +65,4 @@ require (
 	google.golang.org/protobuf v1.36.3 // indirect
 )
 
-replace github.com/unasra/nios-go-client => ../nios-go-client
+replace github.com/unasra/nios-go-client => ./third_party/nios-go-client
@@ -11,6 +11,7 @@ import (
 	niosclient "github.com/unasra/nios-go-client/client"
 	"github.com/unasra/nios-go-client/option"
 	"github.com/unasra/terraform-provider-nios/internal/provider"
+	"github.com/unasra/terraform-provider-nios/internal/transport"
 )
 
 const (
@@ -57,14 +58,30 @@ func PreCheck(t *testing.T) {
 	}
 
 	auth := os.Getenv("NIOS_AUTH")
+	if username, password := os.Getenv("NIOS_USERNAME"), os.Getenv("NIOS_PASSWORD"); username != "" && password != "" {
+		auth = username + ":" + password
+	}
 	if auth == "" {
-		t.Fatal("NIOS_AUTH must be set for acceptance tests")
+		t.Fatal("NIOS_USERNAME and NIOS_PASSWORD, or NIOS_AUTH, must be set for acceptance tests")
+	}
+
+	httpClient, err := transport.NewHTTPClient(transport.Config{
+		TLS: transport.TLSConfig{
+			SSLMode:           os.Getenv("NIOS_SSL_MODE"),
+			CACertificate:     os.Getenv("NIOS_CA_CERTIFICATE"),
+			CACertificateFile: os.Getenv("NIOS_CA_CERTIFICATE_FILE"),
+			ClientCertificate: os.Getenv("NIOS_CLIENT_CERTIFICATE"),
+			ClientKey:         os.Getenv("NIOS_CLIENT_KEY"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("invalid TLS configuration for acceptance tests: %s", err)
 	}
 
 	NIOSClient = niosclient.NewAPIClient(
 		option.WithClientName("terraform-acceptance-tests"),
 		option.WithNIOSHostUrl(hostURL),
 		option.WithNIOSAuth(auth),
-		option.WithDebug(true),
+		option.WithHTTPClient(httpClient),
 	)
 }
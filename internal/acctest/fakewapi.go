@@ -0,0 +1,1773 @@
+package acctest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+const (
+	// FakeWAPIUsername and FakeWAPIPassword are the credentials accepted by the fake WAPI server.
+	FakeWAPIUsername = "admin"
+	FakeWAPIPassword = "infoblox"
+
+	fakeWAPISessionCookie = "ibapauth"
+)
+
+// fakeWAPIPath matches the path of a WAPI request: the WAPI version and what follows it.
+var fakeWAPIPath = regexp.MustCompile(`^/wapi/v([0-9.]+)/(.*)$`)
+
+// FakeObjectType describes how the fake WAPI server handles an object type.
+type FakeObjectType struct {
+	// Fields lists all the fields of the object type, as reported by `?_schema`.
+	Fields []string
+	// BaseFields are the fields returned when `_return_fields` is not set.
+	BaseFields []string
+	// Required are the fields that must be set to create an object.
+	Required []string
+	// Unique are the fields identifying an object: creating a second object with the same values is a conflict.
+	Unique []string
+	// Defaults are the values of the fields not set at creation.
+	Defaults map[string]interface{}
+	// Computed sets the fields computed by the grid, it is called after every create and update.
+	Computed func(obj map[string]interface{})
+	// Interchangeable are fields computed from each other, such as the name and the address of a PTR record.
+	// When an update sets one of them, the others are cleared before Computed is called.
+	Interchangeable []string
+	// RefName returns the name part of the reference of an object. Defaults to its name and view.
+	RefName func(obj map[string]interface{}) string
+	// Functions are the WAPI functions of the object type, called with `_function`. They return the result of the
+	// function called on obj with the given arguments.
+	Functions map[string]func(f *FakeWAPI, obj, args map[string]interface{}) (interface{}, *fakeWAPIError)
+}
+
+// FakeObjectTypes are the object types supported by the fake WAPI server.
+var FakeObjectTypes = map[string]FakeObjectType{
+	"record:a": {
+		Fields: []string{"aws_rte53_record_info", "cloud_info", "comment", "creation_time", "creator", "ddns_principal",
+			"ddns_protected", "disable", "discovered_data", "dns_name", "extattrs", "forbid_reclamation", "ipv4addr",
+			"last_queried", "ms_ad_user_data", "name", "reclaimable", "remove_associated_ptr", "shared_record_group",
+			"ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"ipv4addr", "name", "view"},
+		Required:   []string{"name", "ipv4addr"},
+		Unique:     []string{"name", "ipv4addr", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: fakeRecordComputed,
+	},
+	"record:aaaa": {
+		Fields: []string{"aws_rte53_record_info", "cloud_info", "comment", "creation_time", "creator", "ddns_principal",
+			"ddns_protected", "disable", "discovered_data", "dns_name", "extattrs", "forbid_reclamation", "ipv6addr",
+			"last_queried", "ms_ad_user_data", "name", "reclaimable", "remove_associated_ptr", "shared_record_group",
+			"ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"ipv6addr", "name", "view"},
+		Required:   []string{"name", "ipv6addr"},
+		Unique:     []string{"name", "ipv6addr", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			canonicalIP(obj, "ipv6addr")
+		},
+	},
+	"record:cname": {
+		Fields: []string{"aws_rte53_record_info", "canonical", "cloud_info", "comment", "creation_time", "creator",
+			"ddns_principal", "ddns_protected", "disable", "dns_canonical", "dns_name", "extattrs", "forbid_reclamation",
+			"last_queried", "name", "reclaimable", "shared_record_group", "ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"canonical", "name", "view"},
+		Required:   []string{"name", "canonical"},
+		Unique:     []string{"name", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			obj["dns_canonical"] = obj["canonical"]
+		},
+	},
+	"record:ptr": {
+		Fields: []string{"aws_rte53_record_info", "cloud_info", "comment", "creation_time", "creator", "ddns_principal",
+			"ddns_protected", "disable", "discovered_data", "dns_name", "dns_ptrdname", "extattrs", "forbid_reclamation",
+			"ipv4addr", "ipv6addr", "last_queried", "ms_ad_user_data", "name", "ptrdname", "reclaimable",
+			"shared_record_group", "ttl", "use_ttl", "view", "zone"},
+		BaseFields:      []string{"ptrdname", "view"},
+		Required:        []string{"ptrdname"},
+		Unique:          []string{"name", "ptrdname", "view"},
+		Interchangeable: []string{"ipv4addr", "ipv6addr", "name"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			// The reverse name is computed from the address, and the address from the reverse name
+			canonicalIP(obj, "ipv6addr")
+			for _, field := range []string{"ipv4addr", "ipv6addr"} {
+				if s, ok := obj[field].(string); ok {
+					if addr, err := netip.ParseAddr(s); err == nil {
+						obj["name"] = utils.ReverseName(addr)
+					}
+				}
+			}
+			if name, ok := obj["name"].(string); ok {
+				if addr, ok := utils.AddrFromReverseName(name); ok && addr.Is4() {
+					obj["ipv4addr"] = addr.String()
+				} else if ok {
+					obj["ipv6addr"] = addr.String()
+				}
+			}
+			fakeRecordComputed(obj)
+			obj["dns_ptrdname"] = obj["ptrdname"]
+		},
+	},
+	"record:mx": {
+		Fields: []string{"aws_rte53_record_info", "cloud_info", "comment", "creation_time", "creator", "ddns_principal",
+			"ddns_protected", "disable", "dns_mail_exchanger", "dns_name", "extattrs", "forbid_reclamation",
+			"last_queried", "mail_exchanger", "name", "preference", "reclaimable", "shared_record_group", "ttl",
+			"use_ttl", "view", "zone"},
+		BaseFields: []string{"mail_exchanger", "name", "preference", "view"},
+		Required:   []string{"name", "mail_exchanger", "preference"},
+		Unique:     []string{"name", "mail_exchanger", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			obj["dns_mail_exchanger"] = obj["mail_exchanger"]
+		},
+	},
+	"record:srv": {
+		Fields: []string{"aws_rte53_record_info", "cloud_info", "comment", "creation_time", "creator", "ddns_principal",
+			"ddns_protected", "disable", "dns_name", "dns_target", "extattrs", "forbid_reclamation", "last_queried",
+			"name", "port", "priority", "reclaimable", "shared_record_group", "target", "ttl", "use_ttl", "view",
+			"weight", "zone"},
+		BaseFields: []string{"name", "port", "priority", "target", "view", "weight"},
+		Required:   []string{"name", "port", "priority", "target", "weight"},
+		Unique:     []string{"name", "port", "priority", "target", "view", "weight"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			obj["dns_target"] = obj["target"]
+		},
+	},
+	"record:naptr": {
+		Fields: []string{"cloud_info", "comment", "creation_time", "creator", "ddns_principal", "ddns_protected",
+			"disable", "dns_name", "dns_replacement", "extattrs", "flags", "forbid_reclamation", "last_queried", "name",
+			"order", "preference", "reclaimable", "regexp", "replacement", "services", "ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"name", "order", "preference", "regexp", "replacement", "services", "view"},
+		Required:   []string{"name", "order", "preference", "replacement"},
+		Unique:     []string{"name", "order", "preference", "flags", "services", "regexp", "replacement", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"flags":              "",
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"regexp":             "",
+			"services":           "",
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			obj["dns_replacement"] = obj["replacement"]
+		},
+	},
+	"record:txt": {
+		Fields: []string{"aws_rte53_record_info", "cloud_info", "comment", "creation_time", "creator", "ddns_principal",
+			"ddns_protected", "disable", "dns_name", "extattrs", "forbid_reclamation", "last_queried", "name",
+			"reclaimable", "shared_record_group", "text", "ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"name", "text", "view"},
+		Required:   []string{"name", "text"},
+		Unique:     []string{"name", "text", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			// The grid returns the text with every character-string quoted, whatever the syntax it was set with
+			if text, ok := obj["text"].(string); ok {
+				parts := utils.ParseTXTText(text)
+				for i, p := range parts {
+					parts[i] = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(p) + `"`
+				}
+				obj["text"] = strings.Join(parts, " ")
+			}
+		},
+	},
+	"record:host": {
+		Fields: []string{"aliases", "cloud_info", "comment", "configure_for_dns", "creation_time", "ddns_protected",
+			"device_description", "device_location", "device_type", "device_vendor", "disable", "disable_discovery",
+			"dns_aliases", "dns_name", "extattrs", "ipv4addrs", "ipv6addrs", "last_queried", "ms_ad_user_data", "name",
+			"network_view", "rrset_order", "ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"ipv4addrs", "ipv6addrs", "name", "view"},
+		Required:   []string{"name"},
+		Unique:     []string{"name", "view"},
+		Defaults: map[string]interface{}{
+			"configure_for_dns": true,
+			"ddns_protected":    false,
+			"disable":           false,
+			"disable_discovery": false,
+			"network_view":      "default",
+			"rrset_order":       "cyclic",
+			"use_ttl":           false,
+			"view":              "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			obj["dns_aliases"] = obj["aliases"]
+			// A host that is not in DNS has neither a view nor a zone
+			if configureForDNS, ok := obj["configure_for_dns"].(bool); ok && !configureForDNS {
+				delete(obj, "view")
+				delete(obj, "zone")
+			}
+			fakeHostAddresses(obj, "ipv4addrs", "ipv4addr", 24)
+			fakeHostAddresses(obj, "ipv6addrs", "ipv6addr", 64)
+		},
+	},
+	"record:caa": {
+		Fields: []string{"ca_flag", "ca_tag", "ca_value", "cloud_info", "comment", "creation_time", "creator",
+			"ddns_principal", "ddns_protected", "disable", "dns_name", "extattrs", "forbid_reclamation", "last_queried",
+			"name", "reclaimable", "ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"ca_flag", "ca_tag", "ca_value", "name", "view"},
+		Required:   []string{"ca_flag", "ca_tag", "ca_value", "name"},
+		Unique:     []string{"name", "ca_tag", "ca_value", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: fakeRecordComputed,
+	},
+	"record:tlsa": {
+		Fields: []string{"certificate_data", "certificate_usage", "cloud_info", "comment", "creator", "disable",
+			"dns_name", "extattrs", "last_queried", "matched_type", "name", "selector", "ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"certificate_data", "certificate_usage", "matched_type", "name", "selector", "view"},
+		Required:   []string{"certificate_data", "certificate_usage", "matched_type", "name", "selector"},
+		Unique:     []string{"name", "certificate_data", "view"},
+		Defaults: map[string]interface{}{
+			"creator": "STATIC",
+			"disable": false,
+			"use_ttl": false,
+			"view":    "default",
+		},
+		Computed: fakeRecordComputed,
+	},
+	"record:alias": {
+		Fields: []string{"aws_rte53_record_info", "cloud_info", "comment", "creator", "disable", "dns_name",
+			"dns_target_name", "extattrs", "last_queried", "name", "target_name", "target_type", "ttl", "use_ttl",
+			"view", "zone"},
+		BaseFields: []string{"name", "target_name", "target_type", "view"},
+		Required:   []string{"name", "target_name", "target_type"},
+		Unique:     []string{"name", "target_type", "view"},
+		Defaults: map[string]interface{}{
+			"creator": "STATIC",
+			"disable": false,
+			"use_ttl": false,
+			"view":    "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			obj["dns_target_name"] = obj["target_name"]
+		},
+	},
+	"record:ns": {
+		Fields: []string{"addresses", "cloud_info", "creator", "dns_name", "last_queried", "ms_delegation_name",
+			"name", "nameserver", "policy", "view", "zone"},
+		BaseFields: []string{"name", "nameserver", "view"},
+		Required:   []string{"addresses", "name", "nameserver"},
+		Unique:     []string{"name", "nameserver", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ms_delegation_name": "",
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			addresses, _ := obj["addresses"].([]interface{})
+			for _, a := range addresses {
+				if address, ok := a.(map[string]interface{}); ok {
+					canonicalIP(address, "address")
+					if _, ok := address["auto_create_ptr"]; !ok {
+						address["auto_create_ptr"] = true
+					}
+				}
+			}
+		},
+	},
+	"record:dname": {
+		Fields: []string{"cloud_info", "comment", "creation_time", "creator", "ddns_principal", "ddns_protected",
+			"disable", "dns_name", "dns_target", "extattrs", "forbid_reclamation", "last_queried", "name",
+			"reclaimable", "shared_record_group", "target", "ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"name", "target", "view"},
+		Required:   []string{"name", "target"},
+		Unique:     []string{"name", "view"},
+		Defaults: map[string]interface{}{
+			"creator":            "STATIC",
+			"ddns_protected":     false,
+			"disable":            false,
+			"forbid_reclamation": false,
+			"reclaimable":        false,
+			"use_ttl":            false,
+			"view":               "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			obj["dns_target"] = obj["target"]
+		},
+	},
+	"record:rpz:cname":                 fakeRPZRuleType("canonical"),
+	"record:rpz:cname:clientipaddress": fakeRPZRuleType("canonical", "is_ipv4"),
+	"record:rpz:cname:ipaddress":       fakeRPZRuleType("canonical", "is_ipv4"),
+	"record:rpz:a":                     fakeRPZRuleType("ipv4addr"),
+	"record:rpz:aaaa":                  fakeRPZRuleType("ipv6addr"),
+	"record:unknown": {
+		Fields: []string{"cloud_info", "comment", "creator", "disable", "display_rdata", "dns_name",
+			"enable_host_name_policy", "extattrs", "last_queried", "name", "policy", "record_type", "subfield_values",
+			"ttl", "use_ttl", "view", "zone"},
+		BaseFields: []string{"name", "record_type", "subfield_values", "view"},
+		Required:   []string{"name", "record_type"},
+		Unique:     []string{"name", "record_type", "view"},
+		Defaults: map[string]interface{}{
+			"creator":                 "STATIC",
+			"disable":                 false,
+			"enable_host_name_policy": false,
+			"use_ttl":                 false,
+			"view":                    "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeRecordComputed(obj)
+			var rdata []string
+			subfields, _ := obj["subfield_values"].([]interface{})
+			for _, s := range subfields {
+				if subfield, ok := s.(map[string]interface{}); ok {
+					if _, ok := subfield["include_length"]; !ok {
+						subfield["include_length"] = "NONE"
+					}
+					rdata = append(rdata, fmt.Sprint(subfield["field_value"]))
+				}
+			}
+			obj["display_rdata"] = strings.Join(rdata, " ")
+		},
+	},
+	"record:svcb":  fakeSVCBType,
+	"record:https": fakeSVCBType,
+	"network":      fakeNetworkType,
+	"ipv6network":  fakeNetworkType,
+	"range":        fakeRangeType,
+	"ipv6range":    fakeRangeType,
+	"zone_forward": {
+		Fields: []string{"comment", "disable", "display_domain", "dns_fqdn", "extattrs", "forward_to", "forwarders_only",
+			"forwarding_servers", "fqdn", "ns_group", "view", "zone_format"},
+		BaseFields: []string{"forward_to", "fqdn", "view"},
+		Required:   []string{"forward_to", "fqdn"},
+		Unique:     []string{"fqdn", "view"},
+		Defaults: map[string]interface{}{
+			"disable":         false,
+			"forwarders_only": false,
+			"view":            "default",
+			"zone_format":     "FORWARD",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeZoneComputed(obj)
+			fakeExtServers(obj, "forward_to")
+			servers, _ := obj["forwarding_servers"].([]interface{})
+			for _, s := range servers {
+				if server, ok := s.(map[string]interface{}); ok {
+					fakeExtServers(server, "forward_to")
+					for _, flag := range []string{"forwarders_only", "use_override_forwarders"} {
+						if _, ok := server[flag]; !ok {
+							server[flag] = false
+						}
+					}
+				}
+			}
+		},
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v", obj["fqdn"], obj["view"])
+		},
+	},
+	"zone_delegated": {
+		Fields: []string{"comment", "delegate_to", "delegated_ttl", "disable", "display_domain", "dns_fqdn", "extattrs",
+			"fqdn", "ns_group", "use_delegated_ttl", "view", "zone_format"},
+		BaseFields: []string{"delegate_to", "fqdn", "view"},
+		Required:   []string{"fqdn"},
+		Unique:     []string{"fqdn", "view"},
+		Defaults: map[string]interface{}{
+			"disable":           false,
+			"use_delegated_ttl": false,
+			"view":              "default",
+			"zone_format":       "FORWARD",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeZoneComputed(obj)
+			fakeExtServers(obj, "delegate_to")
+		},
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v", obj["fqdn"], obj["view"])
+		},
+	},
+	"zone_stub": {
+		Fields: []string{"comment", "disable", "display_domain", "dns_fqdn", "extattrs", "fqdn", "ns_group", "stub_from",
+			"stub_members", "view", "zone_format"},
+		BaseFields: []string{"fqdn", "stub_from", "view"},
+		Required:   []string{"fqdn", "stub_from"},
+		Unique:     []string{"fqdn", "view"},
+		Defaults: map[string]interface{}{
+			"disable":     false,
+			"view":        "default",
+			"zone_format": "FORWARD",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeZoneComputed(obj)
+			fakeExtServers(obj, "stub_from")
+			fakeMemberServers(obj, "stub_members")
+		},
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v", obj["fqdn"], obj["view"])
+		},
+	},
+	"zone_auth": {
+		Fields: []string{"allow_transfer", "allow_update", "comment", "disable", "display_domain", "dns_fqdn",
+			"dnssec_key_params", "dnssec_keys", "dnssec_ksk_rollover_date", "dnssec_zsk_rollover_date", "extattrs",
+			"fqdn", "grid_primary", "grid_secondaries", "is_dnssec_enabled", "is_dnssec_signed", "ns_group", "prefix",
+			"primary_type", "restart_if_needed", "soa_default_ttl", "soa_email", "soa_expire", "soa_negative_ttl",
+			"soa_refresh", "soa_retry", "soa_serial_number", "use_allow_transfer", "use_allow_update",
+			"use_dnssec_key_params", "use_grid_zone_timer", "use_soa_email", "view", "zone_format"},
+		BaseFields: []string{"fqdn", "view"},
+		Required:   []string{"fqdn"},
+		Unique:     []string{"fqdn", "view"},
+		Defaults: map[string]interface{}{
+			"disable":             false,
+			"soa_default_ttl":     28800,
+			"soa_expire":          2419200,
+			"soa_negative_ttl":    900,
+			"soa_refresh":         10800,
+			"soa_retry":           3600,
+			"soa_serial_number":   0,
+			"use_allow_transfer":  false,
+			"use_allow_update":    false,
+			"use_grid_zone_timer": false,
+			"use_soa_email":       false,
+			"view":                "default",
+			"zone_format":         "FORWARD",
+			// DNSSEC
+			"is_dnssec_enabled":     false,
+			"is_dnssec_signed":      false,
+			"use_dnssec_key_params": false,
+		},
+		Computed: fakeZoneAuthComputed,
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v", obj["fqdn"], obj["view"])
+		},
+		Functions: map[string]func(f *FakeWAPI, obj, args map[string]interface{}) (interface{}, *fakeWAPIError){
+			"dnssec_operation": fakeDnssecOperation,
+		},
+	},
+	"grid": {
+		Fields:     []string{"name"},
+		BaseFields: []string{"name"},
+		Defaults: map[string]interface{}{
+			"name": "Infoblox",
+		},
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v", obj["name"])
+		},
+		Functions: map[string]func(f *FakeWAPI, obj, args map[string]interface{}) (interface{}, *fakeWAPIError){
+			"dnssecgetzonekeys": fakeDnssecGetZoneKeys,
+		},
+	},
+	"zone_rp": {
+		Fields: []string{"comment", "disable", "display_domain", "extattrs", "external_primaries", "fqdn",
+			"grid_primary", "grid_secondaries", "ns_group", "rpz_policy", "rpz_priority", "rpz_severity", "rpz_type",
+			"substitute_name", "view"},
+		BaseFields: []string{"fqdn", "view"},
+		Required:   []string{"fqdn"},
+		Unique:     []string{"fqdn", "view"},
+		Defaults: map[string]interface{}{
+			"disable":      false,
+			"rpz_policy":   "GIVEN",
+			"rpz_priority": 0,
+			"rpz_severity": "MAJOR",
+			"rpz_type":     "LOCAL",
+			"view":         "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			fakeZoneComputed(obj)
+			delete(obj, "dns_fqdn")
+			fakeMemberServers(obj, "grid_primary", "grid_secondaries")
+			fakeExtServers(obj, "external_primaries")
+		},
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v", obj["fqdn"], obj["view"])
+		},
+	},
+	"view": {
+		Fields: []string{"comment", "disable", "dns64_enabled", "dns64_groups", "extattrs", "forward_only",
+			"forwarders", "is_default", "match_clients", "match_destinations", "name", "network_view", "recursion",
+			"response_rate_limiting", "use_dns64", "use_forwarders", "use_recursion", "use_response_rate_limiting"},
+		BaseFields: []string{"is_default", "name"},
+		Required:   []string{"name"},
+		Unique:     []string{"name"},
+		Defaults: map[string]interface{}{
+			"disable":                    false,
+			"dns64_enabled":              false,
+			"forward_only":               false,
+			"is_default":                 false,
+			"network_view":               "default",
+			"recursion":                  false,
+			"use_dns64":                  false,
+			"use_forwarders":             false,
+			"use_recursion":              false,
+			"use_response_rate_limiting": false,
+		},
+		Computed: fakeViewComputed,
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v", obj["name"], obj["is_default"])
+		},
+	},
+	// The grid creates a DNS view with every network view, which the fake WAPI server only reports in
+	// associated_dns_views.
+	"networkview": {
+		Fields:     []string{"associated_dns_views", "comment", "extattrs", "is_default", "name"},
+		BaseFields: []string{"is_default", "name"},
+		Required:   []string{"name"},
+		Unique:     []string{"name"},
+		Defaults: map[string]interface{}{
+			"is_default": false,
+		},
+		Computed: func(obj map[string]interface{}) {
+			obj["associated_dns_views"] = []interface{}{fmt.Sprintf("default.%v", obj["name"])}
+		},
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v", obj["name"], obj["is_default"])
+		},
+	},
+}
+
+// fakeNetworkType and fakeRangeType describe the networks and the ranges, IPv4 or IPv6, from which the fake WAPI
+// server allocates the next available addresses.
+var (
+	fakeNetworkType = FakeObjectType{
+		Fields:     []string{"comment", "extattrs", "network", "network_view"},
+		BaseFields: []string{"network", "network_view"},
+		Required:   []string{"network"},
+		Unique:     []string{"network", "network_view"},
+		Defaults: map[string]interface{}{
+			"network_view": "default",
+		},
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v", obj["network"], obj["network_view"])
+		},
+	}
+	fakeRangeType = FakeObjectType{
+		Fields:     []string{"comment", "end_addr", "extattrs", "network", "network_view", "start_addr"},
+		BaseFields: []string{"end_addr", "network", "network_view", "start_addr"},
+		Required:   []string{"start_addr", "end_addr"},
+		Unique:     []string{"start_addr", "end_addr", "network_view"},
+		Defaults: map[string]interface{}{
+			"network_view": "default",
+		},
+		RefName: func(obj map[string]interface{}) string {
+			return fmt.Sprintf("%v/%v/%v", obj["start_addr"], obj["end_addr"], obj["network_view"])
+		},
+	}
+)
+
+// fakeSVCBType describes the SVCB and the HTTPS records, which have the same fields.
+var fakeSVCBType = FakeObjectType{
+	Fields: []string{"aws_rte53_record_info", "cloud_info", "comment", "creation_time", "creator", "ddns_principal",
+		"ddns_protected", "disable", "extattrs", "forbid_reclamation", "last_queried", "name", "priority",
+		"reclaimable", "svc_parameters", "target_name", "ttl", "use_ttl", "view", "zone"},
+	BaseFields: []string{"name", "priority", "target_name", "view"},
+	Required:   []string{"name", "priority", "target_name"},
+	Unique:     []string{"name", "priority", "target_name", "view"},
+	Defaults: map[string]interface{}{
+		"creator":            "STATIC",
+		"ddns_protected":     false,
+		"disable":            false,
+		"forbid_reclamation": false,
+		"reclaimable":        false,
+		"use_ttl":            false,
+		"view":               "default",
+	},
+	Computed: func(obj map[string]interface{}) {
+		fakeRecordComputed(obj)
+		params, _ := obj["svc_parameters"].([]interface{})
+		for _, p := range params {
+			if param, ok := p.(map[string]interface{}); ok {
+				if _, ok := param["mandatory"]; !ok {
+					param["mandatory"] = false
+				}
+			}
+		}
+	},
+}
+
+// canonicalIP rewrites the address in the given field in its canonical form, like WAPI does.
+func canonicalIP(obj map[string]interface{}, field string) {
+	if s, ok := obj[field].(string); ok {
+		if addr, err := netip.ParseAddr(s); err == nil {
+			obj[field] = addr.String()
+		}
+	}
+}
+
+// fakeHostAddresses sets the fields the grid computes for the addresses of a host record: their reference, host and
+// network. The grid returns the addresses sorted, whatever the order they were set in.
+func fakeHostAddresses(obj map[string]interface{}, field, addrField string, bits int) {
+	addrs, ok := obj[field].([]interface{})
+	if !ok {
+		return
+	}
+	for _, a := range addrs {
+		addr, ok := a.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		canonicalIP(addr, addrField)
+		addr["host"] = obj["name"]
+		addr["_ref"] = fmt.Sprintf("record:host_%s/ZG5zLmhvc3RfYWRkcmVzcw:%v/%v", addrField, addr[addrField], obj["name"])
+		if s, ok := addr[addrField].(string); ok {
+			if ip, err := netip.ParseAddr(s); err == nil {
+				prefix, _ := ip.Prefix(bits)
+				addr["network"] = prefix.String()
+			}
+		}
+		if _, ok := addr["configure_for_dhcp"]; !ok {
+			addr["configure_for_dhcp"] = false
+		}
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return fmt.Sprint(addrs[i].(map[string]interface{})[addrField]) < fmt.Sprint(addrs[j].(map[string]interface{})[addrField])
+	})
+}
+
+// fakeRPZRuleType describes a rule of a response policy zone with the given fields besides the common ones. The
+// zone of a rule is its response policy zone, and the rules on IP addresses tell whether their trigger is IPv4.
+func fakeRPZRuleType(fields ...string) FakeObjectType {
+	return FakeObjectType{
+		Fields:     append([]string{"comment", "disable", "extattrs", "name", "rp_zone", "ttl", "use_ttl", "view", "zone"}, fields...),
+		BaseFields: append([]string{"name", "view"}, fields[0]),
+		Required:   []string{"name", "rp_zone"},
+		Unique:     []string{"name", "view"},
+		Defaults: map[string]interface{}{
+			"disable": false,
+			"use_ttl": false,
+			"view":    "default",
+		},
+		Computed: func(obj map[string]interface{}) {
+			obj["zone"] = obj["rp_zone"]
+			if len(fields) > 1 {
+				name, _ := obj["name"].(string)
+				trigger := strings.TrimSuffix(name, fmt.Sprintf(".%v", obj["rp_zone"]))
+				obj["is_ipv4"] = !strings.Contains(trigger, ":")
+			}
+		},
+	}
+}
+
+// fakeRecordComputed sets the fields the grid computes for all DNS records.
+func fakeRecordComputed(obj map[string]interface{}) {
+	if name, ok := obj["name"].(string); ok {
+		obj["dns_name"] = name
+		if _, zone, found := strings.Cut(name, "."); found {
+			obj["zone"] = zone
+		}
+	}
+	if _, ok := obj["creation_time"]; !ok {
+		obj["creation_time"] = time.Now().Unix()
+	}
+}
+
+// fakeZoneAuthComputed sets the fields the grid computes for an authoritative zone. The serial number of the zone
+// increases with every change.
+func fakeZoneAuthComputed(obj map[string]interface{}) {
+	fakeZoneComputed(obj)
+	obj["primary_type"] = "None"
+	if primaries, ok := obj["grid_primary"].([]interface{}); ok && len(primaries) > 0 {
+		obj["primary_type"] = "Grid"
+	}
+	fakeMemberServers(obj, "grid_primary", "grid_secondaries")
+	fakeAddressACs(obj, "allow_transfer", "allow_update")
+	fakeDnssecKeyParams(obj)
+
+	serial, _ := obj["soa_serial_number"].(float64)
+	obj["soa_serial_number"] = serial + 1
+}
+
+// fakeAddressACs sets the fields the grid computes for the rules of the given ACLs: the permission of an address
+// rule and the TSIG fields of a TSIG key rule.
+func fakeAddressACs(obj map[string]interface{}, fields ...string) {
+	for _, field := range fields {
+		rules, _ := obj[field].([]interface{})
+		for _, r := range rules {
+			if rule, ok := r.(map[string]interface{}); ok {
+				canonicalIP(rule, "address")
+				if _, ok := rule["address"]; ok {
+					if _, ok := rule["permission"]; !ok {
+						rule["permission"] = "ALLOW"
+					}
+					continue
+				}
+				if _, ok := rule["tsig_key_alg"]; !ok {
+					rule["tsig_key_alg"] = "HMAC-MD5"
+				}
+				if _, ok := rule["use_tsig_key_name"]; !ok {
+					rule["use_tsig_key_name"] = false
+				}
+			}
+		}
+	}
+}
+
+// fakeViewComputed sets the fields the grid computes for a DNS view: the addresses of its forwarders in their
+// canonical form, and the settings of the grid for the response rate limiting settings that are not set.
+func fakeViewComputed(obj map[string]interface{}) {
+	fakeAddressACs(obj, "match_clients", "match_destinations")
+	forwarders, _ := obj["forwarders"].([]interface{})
+	for i, f := range forwarders {
+		if s, ok := f.(string); ok {
+			if addr, err := netip.ParseAddr(s); err == nil {
+				forwarders[i] = addr.String()
+			}
+		}
+	}
+	rrl, ok := obj["response_rate_limiting"].(map[string]interface{})
+	if !ok {
+		rrl = map[string]interface{}{}
+		obj["response_rate_limiting"] = rrl
+	}
+	for field, value := range map[string]interface{}{
+		"enable_rrl_logging":       false,
+		"enable_rrl_rate_limiting": false,
+		"log_only":                 false,
+		"responses_per_second":     100,
+		"slip":                     2,
+		"window":                   15,
+	} {
+		if _, ok := rrl[field]; !ok {
+			rrl[field] = value
+		}
+	}
+}
+
+// fakeExtServers sets the addresses of the external name servers of a zone in their canonical form.
+func fakeExtServers(obj map[string]interface{}, field string) {
+	servers, _ := obj[field].([]interface{})
+	for _, s := range servers {
+		if server, ok := s.(map[string]interface{}); ok {
+			canonicalIP(server, "address")
+		}
+	}
+}
+
+// fakeMemberServers sets the flags the grid defaults for the member servers of the given fields.
+func fakeMemberServers(obj map[string]interface{}, fields ...string) {
+	for _, field := range fields {
+		members, _ := obj[field].([]interface{})
+		for _, m := range members {
+			if member, ok := m.(map[string]interface{}); ok {
+				for _, flag := range []string{"grid_replicate", "lead", "stealth"} {
+					if _, ok := member[flag]; !ok {
+						member[flag] = false
+					}
+				}
+			}
+		}
+	}
+}
+
+// fakeZoneComputed sets the names the grid computes for a zone of any type. The domain of a reverse zone is the
+// reverse name of its network.
+func fakeZoneComputed(obj map[string]interface{}) {
+	fqdn, _ := obj["fqdn"].(string)
+	domain := strings.ToLower(fqdn)
+	if prefix, err := netip.ParsePrefix(fqdn); err == nil {
+		if prefix.Addr().Is4() && prefix.Bits() > 24 {
+			if _, ok := obj["prefix"]; !ok {
+				obj["prefix"] = fmt.Sprintf("%d/%d", prefix.Addr().As4()[3], prefix.Bits())
+			}
+		}
+		domain = utils.ReverseZoneName(prefix)
+		if p, ok := obj["prefix"].(string); ok && p != "" {
+			_, parent, _ := strings.Cut(domain, ".")
+			domain = p + "." + parent
+		}
+	}
+	obj["display_domain"] = domain
+	obj["dns_fqdn"] = domain
+}
+
+// fakeDnssecAlgorithms are the numbers of the DNSSEC algorithms supported by the grid.
+var fakeDnssecAlgorithms = map[string]int{
+	"RSASHA1":         5,
+	"NSEC3RSASHA1":    7,
+	"RSASHA256":       8,
+	"RSASHA512":       10,
+	"ECDSAP256SHA256": 13,
+	"ECDSAP384SHA384": 14,
+}
+
+// fakeGridDnssecKeyParams returns the DNSSEC key parameters of the grid, which are the parameters of the zones that
+// do not override them.
+func fakeGridDnssecKeyParams() map[string]interface{} {
+	return map[string]interface{}{
+		"enable_ksk_auto_rollover":         false,
+		"ksk_algorithms":                   []interface{}{map[string]interface{}{"algorithm": "RSASHA256", "size": float64(2048)}},
+		"ksk_email_notification_enabled":   false,
+		"ksk_rollover":                     float64(31536000),
+		"ksk_rollover_notification_config": "REQUIRE_MANUAL_INTERVENTION",
+		"ksk_snmp_notification_enabled":    true,
+		"next_secure_type":                 "NSEC3",
+		"nsec3_iterations":                 float64(10),
+		"nsec3_salt_max_length":            float64(15),
+		"nsec3_salt_min_length":            float64(1),
+		"signature_expiration":             float64(345600),
+		"zsk_algorithms":                   []interface{}{map[string]interface{}{"algorithm": "RSASHA256", "size": float64(1024)}},
+		"zsk_rollover":                     float64(2592000),
+		"zsk_rollover_mechanism":           "PRE_PUBLISH",
+	}
+}
+
+// fakeDnssecKeyParams sets the DNSSEC key parameters of a zone: the parameters of the grid unless the zone overrides
+// them, in which case the parameters it does not set get the values of the grid.
+func fakeDnssecKeyParams(obj map[string]interface{}) {
+	params := fakeGridDnssecKeyParams()
+	if use, _ := obj["use_dnssec_key_params"].(bool); use {
+		zoneParams, _ := obj["dnssec_key_params"].(map[string]interface{})
+		for k, v := range zoneParams {
+			params[k] = v
+		}
+	}
+	obj["dnssec_key_params"] = params
+}
+
+// fakeDnssecOperation signs a zone, unsigns it or rolls its key-signing key over, depending on the `operation`
+// argument. Signing a zone generates a KSK and a ZSK with the algorithms of its DNSSEC key parameters.
+func fakeDnssecOperation(f *FakeWAPI, obj, args map[string]interface{}) (interface{}, *fakeWAPIError) {
+	signed, _ := obj["is_dnssec_signed"].(bool)
+	operation, _ := args["operation"].(string)
+	switch {
+	case operation == "SIGN" && signed:
+		return nil, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Data", fmt.Sprintf("Zone %v is already signed", obj["fqdn"]))
+	case (operation == "UNSIGN" || operation == "ROLLOVER_KSK") && !signed:
+		return nil, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Data", fmt.Sprintf("Zone %v is not signed", obj["fqdn"]))
+	}
+
+	params, _ := obj["dnssec_key_params"].(map[string]interface{})
+	now := time.Now().Unix()
+	switch operation {
+	case "SIGN":
+		kskRollover, _ := params["ksk_rollover"].(float64)
+		zskRollover, _ := params["zsk_rollover"].(float64)
+		obj["dnssec_keys"] = []interface{}{
+			f.dnssecKey(obj, "KSK", params["ksk_algorithms"], now+int64(kskRollover)),
+			f.dnssecKey(obj, "ZSK", params["zsk_algorithms"], now+int64(zskRollover)),
+		}
+		obj["dnssec_ksk_rollover_date"] = float64(now + int64(kskRollover))
+		obj["dnssec_zsk_rollover_date"] = float64(now + int64(zskRollover))
+		obj["is_dnssec_enabled"] = true
+		obj["is_dnssec_signed"] = true
+	case "UNSIGN":
+		delete(obj, "dnssec_keys")
+		delete(obj, "dnssec_ksk_rollover_date")
+		delete(obj, "dnssec_zsk_rollover_date")
+		obj["is_dnssec_enabled"] = false
+		obj["is_dnssec_signed"] = false
+	case "ROLLOVER_KSK":
+		// The new KSK is published while the DS record of the current one is replaced in the parent zone
+		kskRollover, _ := params["ksk_rollover"].(float64)
+		keys, _ := obj["dnssec_keys"].([]interface{})
+		obj["dnssec_keys"] = append(keys, f.dnssecKey(obj, "KSK", params["ksk_algorithms"], now+int64(kskRollover)))
+		for _, k := range keys {
+			if key := k.(map[string]interface{}); key["type"] == "KSK" && key["status"] == "ACTIVE" {
+				key["status"] = "ROLLED"
+			}
+		}
+		obj["dnssec_ksk_rollover_date"] = float64(now + int64(kskRollover))
+	default:
+		return nil, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Invalid value for operation: %q", operation))
+	}
+	return map[string]interface{}{}, nil
+}
+
+// dnssecKey returns a new DNSSEC key of the zone, with the first of the given algorithms. The public key is random
+// data, its tag is computed as defined by RFC 4034.
+func (f *FakeWAPI) dnssecKey(obj map[string]interface{}, keyType string, algorithms interface{}, nextEventDate int64) map[string]interface{} {
+	algorithm := "RSASHA256"
+	if list, _ := algorithms.([]interface{}); len(list) > 0 {
+		if a, ok := list[0].(map[string]interface{})["algorithm"].(string); ok {
+			algorithm = a
+		}
+	}
+	f.nextID++
+	publicKey := sha256.Sum256([]byte(fmt.Sprintf("%v/%d", obj["fqdn"], f.nextID)))
+
+	flags := 256
+	if keyType == "KSK" {
+		flags = 257
+	}
+	rdata := append([]byte{byte(flags >> 8), byte(flags), 3, byte(fakeDnssecAlgorithms[algorithm])}, publicKey[:]...)
+	var tag int
+	for i, b := range rdata {
+		if i%2 == 0 {
+			tag += int(b) << 8
+		} else {
+			tag += int(b)
+		}
+	}
+	tag += tag >> 16 & 0xFFFF
+
+	return map[string]interface{}{
+		"algorithm":       strconv.Itoa(fakeDnssecAlgorithms[algorithm]),
+		"next_event_date": float64(nextEventDate),
+		"public_key":      base64.StdEncoding.EncodeToString(publicKey[:]),
+		"status":          "ACTIVE",
+		"tag":             float64(tag & 0xFFFF),
+		"type":            keyType,
+	}
+}
+
+// fakeDnssecGetZoneKeys returns the DNSSEC keys of the zones with the references given in the `zones` argument.
+func fakeDnssecGetZoneKeys(f *FakeWAPI, obj, args map[string]interface{}) (interface{}, *fakeWAPIError) {
+	zones, _ := args["zones"].([]interface{})
+	var zoneKeys []interface{}
+	for _, z := range zones {
+		ref, _ := z.(string)
+		zone, wapiErr := f.lookup(ref)
+		if wapiErr != nil {
+			return nil, wapiErr
+		}
+		keys, _ := zone["dnssec_keys"].([]interface{})
+		zoneKeys = append(zoneKeys, map[string]interface{}{"zone": zone["_ref"], "keys": keys})
+	}
+	return map[string]interface{}{"dnssec_zone_keys": zoneKeys}, nil
+}
+
+// FakeWAPI is an in-process WAPI server, for testing the provider without a grid.
+// It keeps the objects in memory and implements the parts of WAPI the provider relies on:
+// references, `_return_fields`, `_return_fields+`, `_return_as_object`, filtering, paging and `_schema`.
+type FakeWAPI struct {
+	server *httptest.Server
+
+	mu      sync.Mutex
+	nextID  int
+	objects map[string]map[string]interface{}
+	// order holds the ids of the objects in creation order, so that results are stable.
+	order []string
+}
+
+// NewFakeWAPI starts a fake WAPI server, which is closed at the end of the test.
+func NewFakeWAPI(t *testing.T) *FakeWAPI {
+	t.Helper()
+	f := &FakeWAPI{objects: map[string]map[string]interface{}{}}
+	// The grid object always exists, it holds the functions that are not specific to an object
+	if _, wapiErr := f.create("grid", map[string]interface{}{}); wapiErr != nil {
+		t.Fatalf("unable to create the grid object: %s", wapiErr.Text)
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// URL returns the base URL of the server, to be used as `nios_host_url`.
+func (f *FakeWAPI) URL() string {
+	return f.server.URL
+}
+
+// ProviderConfig returns the configuration of a provider talking to the server.
+func (f *FakeWAPI) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "nios" {
+	nios_host_url = %q
+	username      = %q
+	password      = %q
+}
+`, f.URL(), FakeWAPIUsername, FakeWAPIPassword)
+}
+
+// Create stores an object as if it had been created through WAPI and returns its reference.
+// It is meant to set up objects not managed by the tested configuration.
+func (f *FakeWAPI) Create(objectType string, fields map[string]interface{}) (string, error) {
+	// Store the fields as they would be decoded from a request
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return "", err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := FakeObjectTypes[objectType]; !ok {
+		return "", fmt.Errorf("unsupported object type %s", objectType)
+	}
+	obj, wapiErr := f.create(objectType, decoded)
+	if wapiErr != nil {
+		return "", fmt.Errorf("%s", wapiErr.Text)
+	}
+	return obj["_ref"].(string), nil
+}
+
+// Objects returns a copy of the objects of the given type.
+func (f *FakeWAPI) Objects(objectType string) []map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var objs []map[string]interface{}
+	for _, id := range f.order {
+		if obj := f.objects[id]; obj["_type"] == objectType {
+			objs = append(objs, copyFields(obj, nil))
+		}
+	}
+	return objs
+}
+
+// CheckDestroy returns a check verifying that no object of the given type is left.
+func (f *FakeWAPI) CheckDestroy(objectType string) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		if objs := f.Objects(objectType); len(objs) > 0 {
+			return fmt.Errorf("expected all the %s objects to be deleted, %d left", objectType, len(objs))
+		}
+		return nil
+	}
+}
+
+// fakeWAPIError is the body of the errors returned by WAPI.
+type fakeWAPIError struct {
+	Status int    `json:"-"`
+	Error  string `json:"Error"`
+	Code   string `json:"code"`
+	Text   string `json:"text"`
+}
+
+func newFakeWAPIError(status int, code, text string) *fakeWAPIError {
+	return &fakeWAPIError{Status: status, Error: "AdmConProtoError: " + text, Code: code, Text: text}
+}
+
+func (f *FakeWAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !f.authenticate(w, r) {
+		writeFakeWAPIError(w, newFakeWAPIError(http.StatusUnauthorized, "Client.Ibap.Auth", "Authorization Required"))
+		return
+	}
+
+	m := fakeWAPIPath.FindStringSubmatch(r.URL.Path)
+	if m == nil {
+		writeFakeWAPIError(w, newFakeWAPIError(http.StatusNotFound, "Client.Ibap.Proto", "Unknown WAPI path "+r.URL.Path))
+		return
+	}
+	version, path := m[1], m[2]
+	query := r.URL.Query()
+
+	if path == "logout" {
+		http.SetCookie(w, &http.Cookie{Name: fakeWAPISessionCookie, Value: "", MaxAge: -1})
+		writeFakeWAPIResult(w, http.StatusOK, "")
+		return
+	}
+	if query.Get("_schema") != "" {
+		f.serveSchema(w, version, path)
+		return
+	}
+
+	objectType, _, isRef := strings.Cut(path, "/")
+	t, ok := FakeObjectTypes[objectType]
+	if !ok {
+		writeFakeWAPIError(w, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Unknown object type (%s)", objectType)))
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeFakeWAPIError(w, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", "Invalid JSON: "+err.Error()))
+			return
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var (
+		result  interface{}
+		wapiErr *fakeWAPIError
+	)
+	switch {
+	case !isRef && r.Method == http.MethodGet:
+		f.serveList(w, t, objectType, query)
+		return
+	case !isRef && r.Method == http.MethodPost:
+		var obj map[string]interface{}
+		if obj, wapiErr = f.create(objectType, body); wapiErr == nil {
+			result = returnObject(t, obj, query)
+		}
+	case isRef && r.Method == http.MethodPost && query.Get("_function") != "":
+		result, wapiErr = f.callFunction(t, path, query.Get("_function"), body)
+	case isRef && r.Method == http.MethodGet:
+		var obj map[string]interface{}
+		if obj, wapiErr = f.lookup(path); wapiErr == nil {
+			result = copyFields(obj, returnFields(t, query))
+		}
+	case isRef && r.Method == http.MethodPut:
+		var obj map[string]interface{}
+		if obj, wapiErr = f.update(t, path, body); wapiErr == nil {
+			result = returnObject(t, obj, query)
+		}
+	case isRef && r.Method == http.MethodDelete:
+		var obj map[string]interface{}
+		if obj, wapiErr = f.lookup(path); wapiErr == nil {
+			f.delete(obj)
+			result = obj["_ref"]
+		}
+	default:
+		wapiErr = newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Unsupported method %s", r.Method))
+	}
+	if wapiErr != nil {
+		writeFakeWAPIError(w, wapiErr)
+		return
+	}
+
+	status := http.StatusOK
+	if r.Method == http.MethodPost && !isRef {
+		status = http.StatusCreated
+	}
+	if query.Get("_return_as_object") == "1" {
+		result = map[string]interface{}{"result": result}
+	}
+	writeFakeWAPIResult(w, status, result)
+}
+
+// authenticate accepts the requests with the basic authentication credentials of the server or a session
+// cookie it issued. The session cookie is issued on the first authenticated request, like WAPI does.
+func (f *FakeWAPI) authenticate(w http.ResponseWriter, r *http.Request) bool {
+	if c, err := r.Cookie(fakeWAPISessionCookie); err == nil && c.Value == f.sessionToken() {
+		return true
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok || username != FakeWAPIUsername || password != FakeWAPIPassword {
+		return false
+	}
+	http.SetCookie(w, &http.Cookie{Name: fakeWAPISessionCookie, Value: f.sessionToken(), Path: "/", HttpOnly: true})
+	return true
+}
+
+func (f *FakeWAPI) sessionToken() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(f.server.URL))
+}
+
+func (f *FakeWAPI) serveSchema(w http.ResponseWriter, version, objectType string) {
+	if objectType == "" {
+		objects := make([]string, 0, len(FakeObjectTypes))
+		for name := range FakeObjectTypes {
+			objects = append(objects, name)
+		}
+		sort.Strings(objects)
+		writeFakeWAPIResult(w, http.StatusOK, map[string]interface{}{
+			"requested_version":  version,
+			"supported_objects":  objects,
+			"supported_versions": []string{"2.9", "2.10", "2.11", "2.12", "2.12.3", "2.13.6"},
+		})
+		return
+	}
+
+	t, ok := FakeObjectTypes[objectType]
+	if !ok {
+		writeFakeWAPIError(w, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Unknown object type (%s)", objectType)))
+		return
+	}
+	fields := make([]map[string]string, 0, len(t.Fields))
+	for _, name := range t.Fields {
+		fields = append(fields, map[string]string{"name": name, "supports": "rwus"})
+	}
+	writeFakeWAPIResult(w, http.StatusOK, map[string]interface{}{
+		"type":    objectType,
+		"version": version,
+		"fields":  fields,
+	})
+}
+
+func (f *FakeWAPI) serveList(w http.ResponseWriter, t FakeObjectType, objectType string, query map[string][]string) {
+	var matches []map[string]interface{}
+	for _, id := range f.order {
+		obj := f.objects[id]
+		if obj["_type"] != objectType {
+			continue
+		}
+		ok, wapiErr := matchFilters(t, obj, query)
+		if wapiErr != nil {
+			writeFakeWAPIError(w, wapiErr)
+			return
+		}
+		if ok {
+			matches = append(matches, copyFields(obj, returnFields(t, query)))
+		}
+	}
+
+	get := func(key string) string {
+		if v := query[key]; len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	maxResults, _ := strconv.Atoi(get("_max_results"))
+
+	if get("_paging") == "1" {
+		if maxResults <= 0 || get("_return_as_object") != "1" {
+			writeFakeWAPIError(w, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto",
+				"_max_results and _return_as_object must be set when _paging is set"))
+			return
+		}
+		offset := 0
+		if pageID := get("_page_id"); pageID != "" {
+			b, err := base64.RawURLEncoding.DecodeString(pageID)
+			if offset, err = strconv.Atoi(string(b)); err != nil || offset > len(matches) {
+				writeFakeWAPIError(w, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", "Invalid _page_id"))
+				return
+			}
+		}
+		end := offset + maxResults
+		res := map[string]interface{}{}
+		if end < len(matches) {
+			res["next_page_id"] = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+		} else {
+			end = len(matches)
+		}
+		res["result"] = nonNil(matches[offset:end])
+		writeFakeWAPIResult(w, http.StatusOK, res)
+		return
+	}
+
+	// Like WAPI, refuse more than 1000 results when no limit is given
+	if maxResults == 0 {
+		maxResults = 1000
+	}
+	switch {
+	case maxResults > 0 && len(matches) > maxResults:
+		writeFakeWAPIError(w, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto",
+			fmt.Sprintf("Result set too large (> %d)", maxResults)))
+		return
+	case maxResults < 0 && len(matches) > -maxResults:
+		matches = matches[:-maxResults]
+	}
+
+	var result interface{} = nonNil(matches)
+	if get("_return_as_object") == "1" {
+		result = map[string]interface{}{"result": result}
+	}
+	writeFakeWAPIResult(w, http.StatusOK, result)
+}
+
+func (f *FakeWAPI) create(objectType string, fields map[string]interface{}) (map[string]interface{}, *fakeWAPIError) {
+	t := FakeObjectTypes[objectType]
+	if wapiErr := checkFields(t, fields); wapiErr != nil {
+		return nil, wapiErr
+	}
+	for _, name := range t.Required {
+		if _, ok := fields[name]; !ok {
+			return nil, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", "Required field missing: "+name)
+		}
+	}
+
+	obj := map[string]interface{}{}
+	for k, v := range t.Defaults {
+		obj[k] = v
+	}
+	for k, v := range fields {
+		obj[k] = v
+	}
+	if wapiErr := f.callFunctions(obj); wapiErr != nil {
+		return nil, wapiErr
+	}
+	if t.Computed != nil {
+		t.Computed(obj)
+	}
+	if wapiErr := f.checkConflict(t, objectType, obj, ""); wapiErr != nil {
+		return nil, wapiErr
+	}
+
+	f.nextID++
+	id := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s$%d", objectType, f.nextID)))
+	obj["_type"] = objectType
+	obj["_id"] = id
+	obj["_ref"] = objectRef(t, objectType, id, obj)
+	f.objects[id] = obj
+	f.order = append(f.order, id)
+	return obj, nil
+}
+
+func (f *FakeWAPI) update(t FakeObjectType, ref string, fields map[string]interface{}) (map[string]interface{}, *fakeWAPIError) {
+	obj, wapiErr := f.lookup(ref)
+	if wapiErr != nil {
+		return nil, wapiErr
+	}
+	if wapiErr := checkFields(t, fields); wapiErr != nil {
+		return nil, wapiErr
+	}
+	if view, ok := fields["view"]; ok && view != obj["view"] {
+		return nil, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", "Field is not writable: view")
+	}
+
+	updated := copyFields(obj, nil)
+	for _, field := range t.Interchangeable {
+		if _, ok := fields[field]; ok {
+			for _, other := range t.Interchangeable {
+				delete(updated, other)
+			}
+			break
+		}
+	}
+	for k, v := range fields {
+		updated[k] = v
+	}
+	if wapiErr := f.callFunctions(updated); wapiErr != nil {
+		return nil, wapiErr
+	}
+	if t.Computed != nil {
+		t.Computed(updated)
+	}
+	objectType, id := obj["_type"].(string), obj["_id"].(string)
+	if wapiErr := f.checkConflict(t, objectType, updated, id); wapiErr != nil {
+		return nil, wapiErr
+	}
+	updated["_type"] = objectType
+	updated["_id"] = id
+	updated["_ref"] = objectRef(t, objectType, id, updated)
+	f.objects[id] = updated
+	return updated, nil
+}
+
+func (f *FakeWAPI) delete(obj map[string]interface{}) {
+	id := obj["_id"].(string)
+	delete(f.objects, id)
+	for i, v := range f.order {
+		if v == id {
+			f.order = append(f.order[:i], f.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// lookup returns the object with the given reference. Only the id part of the reference is checked, so
+// references are still valid after an object is renamed.
+func (f *FakeWAPI) lookup(ref string) (map[string]interface{}, *fakeWAPIError) {
+	_, rest, _ := strings.Cut(ref, "/")
+	id, _, _ := strings.Cut(rest, ":")
+	obj, ok := f.objects[id]
+	if !ok {
+		return nil, newFakeWAPIError(http.StatusNotFound, "Client.Ibap.Data.NotFound", fmt.Sprintf("Reference %s not found", ref))
+	}
+	return obj, nil
+}
+
+// callFunction calls the function of the object with the given reference, as a POST on the reference does.
+func (f *FakeWAPI) callFunction(t FakeObjectType, ref, name string, args map[string]interface{}) (interface{}, *fakeWAPIError) {
+	obj, wapiErr := f.lookup(ref)
+	if wapiErr != nil {
+		return nil, wapiErr
+	}
+	function, ok := t.Functions[name]
+	if !ok {
+		return nil, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Function %s is not valid for this object type", name))
+	}
+	return function(f, obj, args)
+}
+
+// callFunctions replaces the function calls set in the fields of obj, or in the fields of the structures it holds such
+// as the addresses of a host record, with their result. Only `next_available_ip` is supported.
+func (f *FakeWAPI) callFunctions(obj map[string]interface{}) *fakeWAPIError {
+	// The addresses set in obj are not allocated either
+	allocated := map[netip.Addr]bool{}
+	addRecordAddresses(allocated, obj)
+	var call func(fields map[string]interface{}) *fakeWAPIError
+	call = func(fields map[string]interface{}) *fakeWAPIError {
+		// The fields are sorted so that the addresses are allocated in a stable order
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			switch v := fields[name].(type) {
+			case map[string]interface{}:
+				if _, ok := v["_object_function"]; !ok {
+					continue
+				}
+				addr, wapiErr := f.nextAvailableIP(v, allocated)
+				if wapiErr != nil {
+					return wapiErr
+				}
+				allocated[addr] = true
+				fields[name] = addr.String()
+			case []interface{}:
+				for _, e := range v {
+					if m, ok := e.(map[string]interface{}); ok {
+						if wapiErr := call(m); wapiErr != nil {
+							return wapiErr
+						}
+					}
+				}
+			}
+		}
+		return nil
+	}
+	return call(obj)
+}
+
+// nextAvailableIP returns the first address of the network or the range searched by a function call that is neither
+// used by a record, nor allocated, nor excluded by the function call.
+func (f *FakeWAPI) nextAvailableIP(funcCall map[string]interface{}, allocated map[netip.Addr]bool) (netip.Addr, *fakeWAPIError) {
+	if function := funcCall["_object_function"]; function != "next_available_ip" {
+		return netip.Addr{}, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Function %v is not supported", function))
+	}
+	objectType, _ := funcCall["_object"].(string)
+	t, ok := FakeObjectTypes[objectType]
+	if !ok || !containsString([]string{"network", "ipv6network", "range", "ipv6range"}, objectType) {
+		return netip.Addr{}, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Function next_available_ip is not supported by %v", objectType))
+	}
+
+	query := map[string][]string{}
+	if parameters, ok := funcCall["_object_parameters"].(map[string]interface{}); ok {
+		for k, v := range parameters {
+			query[k] = []string{fmt.Sprint(v)}
+		}
+	}
+	var matches []map[string]interface{}
+	for _, id := range f.order {
+		obj := f.objects[id]
+		if obj["_type"] != objectType {
+			continue
+		}
+		ok, wapiErr := matchFilters(t, obj, query)
+		if wapiErr != nil {
+			return netip.Addr{}, wapiErr
+		}
+		if ok {
+			matches = append(matches, obj)
+		}
+	}
+	if len(matches) != 1 {
+		return netip.Addr{}, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Data",
+			fmt.Sprintf("The function call search for %s objects returned %d objects, expected 1", objectType, len(matches)))
+	}
+
+	first, last, ok := addressBounds(matches[0])
+	if !ok {
+		return netip.Addr{}, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Data", "Invalid "+objectType)
+	}
+	used := f.usedAddresses()
+	if parameters, ok := funcCall["_parameters"].(map[string]interface{}); ok {
+		if exclude, ok := parameters["exclude"].([]interface{}); ok {
+			for _, e := range exclude {
+				if addr, err := netip.ParseAddr(fmt.Sprint(e)); err == nil {
+					used[addr] = true
+				}
+			}
+		}
+	}
+	for addr := first; addr.IsValid() && addr.Compare(last) <= 0; addr = addr.Next() {
+		if !used[addr] && !allocated[addr] {
+			return addr, nil
+		}
+	}
+	return netip.Addr{}, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Data",
+		fmt.Sprintf("Cannot find 1 available IP address(es) in this %s", objectType))
+}
+
+// addressBounds returns the first and the last assignable addresses of a network or a range. The network and
+// broadcast addresses of an IPv4 network are not assignable.
+func addressBounds(obj map[string]interface{}) (netip.Addr, netip.Addr, bool) {
+	if obj["_type"] == "range" || obj["_type"] == "ipv6range" {
+		first, err1 := netip.ParseAddr(fmt.Sprint(obj["start_addr"]))
+		last, err2 := netip.ParseAddr(fmt.Sprint(obj["end_addr"]))
+		return first, last, err1 == nil && err2 == nil
+	}
+	prefix, err := netip.ParsePrefix(fmt.Sprint(obj["network"]))
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, false
+	}
+	prefix = prefix.Masked()
+	b := prefix.Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << (7 - i%8)
+	}
+	first := prefix.Addr()
+	last, _ := netip.AddrFromSlice(b)
+	if first.Is4() {
+		first, last = first.Next(), last.Prev()
+	}
+	return first, last, true
+}
+
+// usedAddresses returns the addresses of the records, including the addresses of the host records.
+func (f *FakeWAPI) usedAddresses() map[netip.Addr]bool {
+	used := map[netip.Addr]bool{}
+	for _, obj := range f.objects {
+		addRecordAddresses(used, obj)
+	}
+	return used
+}
+
+// addRecordAddresses adds the addresses of a record to used, including the addresses of a host record.
+func addRecordAddresses(used map[netip.Addr]bool, obj map[string]interface{}) {
+	add := func(fields map[string]interface{}) {
+		for _, field := range []string{"ipv4addr", "ipv6addr"} {
+			if s, ok := fields[field].(string); ok {
+				if addr, err := netip.ParseAddr(s); err == nil {
+					used[addr] = true
+				}
+			}
+		}
+	}
+	add(obj)
+	for _, field := range []string{"ipv4addrs", "ipv6addrs"} {
+		addrs, _ := obj[field].([]interface{})
+		for _, a := range addrs {
+			if fields, ok := a.(map[string]interface{}); ok {
+				add(fields)
+			}
+		}
+	}
+}
+
+func (f *FakeWAPI) checkConflict(t FakeObjectType, objectType string, obj map[string]interface{}, id string) *fakeWAPIError {
+	if len(t.Unique) == 0 {
+		return nil
+	}
+	for otherID, other := range f.objects {
+		if otherID == id || other["_type"] != objectType {
+			continue
+		}
+		same := true
+		for _, k := range t.Unique {
+			if fmt.Sprint(other[k]) != fmt.Sprint(obj[k]) {
+				same = false
+				break
+			}
+		}
+		if same {
+			return newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Data.Conflict",
+				fmt.Sprintf("The record '%v' already exists.", obj["name"]))
+		}
+	}
+	return nil
+}
+
+// returnObject returns what WAPI returns for a created or updated object: its reference,
+// or the object itself when return fields are requested.
+func returnObject(t FakeObjectType, obj map[string]interface{}, query map[string][]string) interface{} {
+	if _, ok := query["_return_fields"]; !ok {
+		if _, ok := query["_return_fields+"]; !ok {
+			return obj["_ref"]
+		}
+	}
+	return copyFields(obj, returnFields(t, query))
+}
+
+func checkFields(t FakeObjectType, fields map[string]interface{}) *fakeWAPIError {
+	for name := range fields {
+		if !containsString(t.Fields, name) {
+			return newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", "Unknown argument/field: '"+name+"'")
+		}
+	}
+	return nil
+}
+
+// returnFields returns the fields to return for a request, `_ref` excluded.
+func returnFields(t FakeObjectType, query map[string][]string) []string {
+	if v, ok := query["_return_fields"]; ok {
+		return splitFields(v)
+	}
+	return append(append([]string(nil), t.BaseFields...), splitFields(query["_return_fields+"])...)
+}
+
+func splitFields(values []string) []string {
+	var fields []string
+	for _, v := range values {
+		for _, f := range strings.Split(v, ",") {
+			if f = strings.TrimSpace(f); f != "" {
+				fields = append(fields, f)
+			}
+		}
+	}
+	return fields
+}
+
+// matchFilters reports whether obj matches the search arguments of query, e.g. `name~=^a` or `*Site=blr`.
+// The supported modifiers are `~` (regular expression), `:` (case insensitive) and `!` (negation).
+func matchFilters(t FakeObjectType, obj map[string]interface{}, query map[string][]string) (bool, *fakeWAPIError) {
+	for key, values := range query {
+		if strings.HasPrefix(key, "_") {
+			continue
+		}
+		field := strings.TrimRight(key, "~:!")
+		modifiers := key[len(field):]
+
+		var actual interface{}
+		if ea, ok := strings.CutPrefix(field, "*"); ok {
+			if extattrs, ok := obj["extattrs"].(map[string]interface{}); ok {
+				if attr, ok := extattrs[ea].(map[string]interface{}); ok {
+					actual = attr["value"]
+				}
+			}
+		} else {
+			if !containsString(t.Fields, field) {
+				return false, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", "Field is not searchable: "+field)
+			}
+			actual = obj[field]
+		}
+
+		for _, expected := range values {
+			ok, err := matchValue(actual, expected, modifiers)
+			if err != nil {
+				return false, newFakeWAPIError(http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Invalid regular expression for field %s", field))
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+func matchValue(actual interface{}, expected, modifiers string) (bool, error) {
+	if actual == nil {
+		return strings.Contains(modifiers, "!"), nil
+	}
+	s := fmt.Sprint(actual)
+	if strings.Contains(modifiers, ":") {
+		s, expected = strings.ToLower(s), strings.ToLower(expected)
+	}
+
+	var match bool
+	if strings.Contains(modifiers, "~") {
+		re, err := regexp.Compile(expected)
+		if err != nil {
+			return false, err
+		}
+		match = re.MatchString(s)
+	} else {
+		match = s == expected
+	}
+	if strings.Contains(modifiers, "!") {
+		match = !match
+	}
+	return match, nil
+}
+
+// objectRef returns the reference of an object, e.g. `record:a/cmVjb3JkOmEkMQ:a.example.com/default`.
+func objectRef(t FakeObjectType, objectType, id string, obj map[string]interface{}) string {
+	var name string
+	if t.RefName != nil {
+		name = t.RefName(obj)
+	} else {
+		name = fmt.Sprintf("%v/%v", obj["name"], obj["view"])
+	}
+	return objectType + "/" + id + ":" + name
+}
+
+// copyFields returns a copy of obj restricted to `_ref` and the given fields, or all the WAPI fields if fields is nil.
+func copyFields(obj map[string]interface{}, fields []string) map[string]interface{} {
+	res := map[string]interface{}{"_ref": obj["_ref"]}
+	for k, v := range obj {
+		if strings.HasPrefix(k, "_") {
+			continue
+		}
+		if fields == nil || containsString(fields, k) {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func nonNil(objs []map[string]interface{}) []map[string]interface{} {
+	if objs == nil {
+		return []map[string]interface{}{}
+	}
+	return objs
+}
+
+func writeFakeWAPIResult(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeWAPIError(w http.ResponseWriter, e *fakeWAPIError) {
+	writeFakeWAPIResult(w, e.Status, e)
+}
@@ -0,0 +1,296 @@
+package acctest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/nios-go-client/option"
+
+	"github.com/unasra/terraform-provider-nios/internal/transport"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+const fakeRecordAFields = "comment,creator,disable,dns_name,extattrs,ipv4addr,name,ttl,use_ttl,view,zone"
+
+func newFakeWAPIClient(t *testing.T, f *FakeWAPI, auth string) *niosclient.APIClient {
+	t.Helper()
+	httpClient, err := transport.NewHTTPClient(transport.Config{Session: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return niosclient.NewAPIClient(
+		option.WithClientName("fake-wapi-test"),
+		option.WithNIOSHostUrl(f.URL()),
+		option.WithNIOSAuth(auth),
+		option.WithHTTPClient(httpClient),
+	)
+}
+
+func TestFakeWAPILifecycle(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeWAPI(t)
+	client := newFakeWAPIClient(t, f, FakeWAPIUsername+":"+FakeWAPIPassword)
+
+	created, _, err := client.DNSAPI.RecordaAPI.
+		Post(ctx).
+		RecordA(dns.RecordA{
+			Name:     "a.example.com",
+			Ipv4addr: dns.StringAsRecordAIpv4addr(dns.PtrString("10.0.0.1")),
+			Extattrs: map[string]interface{}{"Site": map[string]string{"value": "blr"}},
+		}).
+		ReturnFields2(fakeRecordAFields).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	record := created.GetResult()
+	if record.GetZone() != "example.com" || record.GetView() != "default" || record.GetDisable() {
+		t.Errorf("unexpected computed fields and defaults: %+v", record)
+	}
+
+	_, httpRes, err := client.DNSAPI.RecordaAPI.
+		Post(ctx).
+		RecordA(dns.RecordA{Name: "a.example.com", Ipv4addr: dns.StringAsRecordAIpv4addr(dns.PtrString("10.0.0.1"))}).
+		Execute()
+	if e := utils.ParseWAPIError(err, httpRes); e == nil || e.Kind() != utils.WAPIErrorConflict {
+		t.Errorf("expected a conflict when creating a duplicate record, got %v", err)
+	}
+
+	updated, _, err := client.DNSAPI.RecordaAPI.
+		RecordaReferencePut(ctx, utils.ExtractResourceRef(record.GetRef())).
+		RecordA(dns.RecordA{Name: "b.example.com", Ipv4addr: dns.StringAsRecordAIpv4addr(dns.PtrString("10.0.0.1")), Comment: dns.PtrString("renamed")}).
+		ReturnFields2(fakeRecordAFields).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+	record = updated.GetResult()
+	if record.GetComment() != "renamed" || record.GetDnsName() != "b.example.com" {
+		t.Errorf("unexpected updated record: %+v", record)
+	}
+
+	got, _, err := client.DNSAPI.RecordaAPI.
+		RecordaReferenceGet(ctx, utils.ExtractResourceRef(record.GetRef())).
+		ReturnFields2(fakeRecordAFields).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if r := got.GetResult(); r.GetRef() != record.GetRef() || r.GetComment() != "renamed" {
+		t.Errorf("unexpected read record: %+v", r)
+	}
+
+	if _, err := client.DNSAPI.RecordaAPI.RecordaReferenceDelete(ctx, utils.ExtractResourceRef(record.GetRef())).Execute(); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+	_, httpRes, err = client.DNSAPI.RecordaAPI.RecordaReferenceGet(ctx, utils.ExtractResourceRef(record.GetRef())).Execute()
+	if err == nil || httpRes == nil || httpRes.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the deleted record to be not found, got %v", err)
+	}
+}
+
+func TestFakeWAPISearch(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeWAPI(t)
+	client := newFakeWAPIClient(t, f, FakeWAPIUsername+":"+FakeWAPIPassword)
+
+	for _, r := range []struct{ name, ip, site string }{
+		{"a.example.com", "10.0.0.1", "blr"},
+		{"b.example.com", "10.0.0.2", "blr"},
+		{"c.example.org", "10.0.0.3", "sjc"},
+	} {
+		if _, err := f.Create("record:a", map[string]interface{}{
+			"name": r.name, "ipv4addr": r.ip, "extattrs": map[string]interface{}{"Site": map[string]string{"value": r.site}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		filters map[string]interface{}
+		want    int
+	}{
+		{name: "exact", filters: map[string]interface{}{"name": "a.example.com"}, want: 1},
+		{name: "regex", filters: map[string]interface{}{"name~": `\.example\.com$`}, want: 2},
+		{name: "extensible attribute", filters: map[string]interface{}{"*Site": "blr"}, want: 2},
+		{name: "combined", filters: map[string]interface{}{"*Site": "blr", "ipv4addr": "10.0.0.2"}, want: 1},
+		{name: "no match", filters: map[string]interface{}{"zone": "example.net"}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, _, err := client.DNSAPI.RecordaAPI.Get(ctx).Filters(tt.filters).ReturnAsObject(1).Execute()
+			if err != nil {
+				t.Fatalf("search: %s", err)
+			}
+			if got := len(res.ListRecordAResponseObject.GetResult()); got != tt.want {
+				t.Errorf("expected %d records, got %d", tt.want, got)
+			}
+		})
+	}
+
+	var names []string
+	pageID := ""
+	for pages := 0; pages < 5; pages++ {
+		req := client.DNSAPI.RecordaAPI.Get(ctx).Paging(1).MaxResults(2).ReturnAsObject(1)
+		if pageID != "" {
+			req = req.PageId(pageID)
+		}
+		res, _, err := req.Execute()
+		if err != nil {
+			t.Fatalf("paging: %s", err)
+		}
+		for _, r := range res.ListRecordAResponseObject.GetResult() {
+			names = append(names, r.GetName())
+		}
+		next, ok := res.ListRecordAResponseObject.AdditionalProperties["next_page_id"].(string)
+		if !ok {
+			break
+		}
+		pageID = next
+	}
+	if len(names) != 3 || names[0] != "a.example.com" || names[2] != "c.example.org" {
+		t.Errorf("unexpected paged records: %v", names)
+	}
+}
+
+func TestFakeWAPINextAvailableIP(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeWAPI(t)
+	client := newFakeWAPIClient(t, f, FakeWAPIUsername+":"+FakeWAPIPassword)
+
+	if _, err := f.Create("network", map[string]interface{}{
+		"network": "10.1.0.0/30", "extattrs": map[string]interface{}{"Site": map[string]string{"value": "blr"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Create("range", map[string]interface{}{"start_addr": "10.2.0.10", "end_addr": "10.2.0.20"}); err != nil {
+		t.Fatal(err)
+	}
+
+	allocate := func(name, object string, parameters map[string]interface{}, exclude ...string) (string, error) {
+		funcCall := &dns.RecordAIpv4addrOneOf{
+			ObjectFunction:   dns.PtrString("next_available_ip"),
+			ResultField:      dns.PtrString("ips"),
+			Object:           dns.PtrString(object),
+			ObjectParameters: parameters,
+		}
+		if len(exclude) > 0 {
+			funcCall.Parameters = map[string]interface{}{"exclude": exclude}
+		}
+		res, _, err := client.DNSAPI.RecordaAPI.
+			Post(ctx).
+			RecordA(dns.RecordA{Name: name, Ipv4addr: dns.RecordAIpv4addrOneOfAsRecordAIpv4addr(funcCall)}).
+			ReturnFields2(fakeRecordAFields).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return "", err
+		}
+		record := res.GetResult()
+		return *record.Ipv4addr.String, nil
+	}
+
+	tests := []struct {
+		name       string
+		object     string
+		parameters map[string]interface{}
+		exclude    []string
+		want       string
+	}{
+		{name: "a.example.com", object: "network", parameters: map[string]interface{}{"network": "10.1.0.0/30"}, want: "10.1.0.1"},
+		{name: "b.example.com", object: "network", parameters: map[string]interface{}{"*Site": "blr"}, want: "10.1.0.2"},
+		{name: "c.example.com", object: "range", parameters: map[string]interface{}{"start_addr": "10.2.0.10"}, exclude: []string{"10.2.0.10"}, want: "10.2.0.11"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := allocate(tt.name, tt.object, tt.parameters, tt.exclude...)
+			if err != nil {
+				t.Fatalf("allocate: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %s to be allocated, got %s", tt.want, got)
+			}
+		})
+	}
+
+	// The network and broadcast addresses are not allocated, the network is full
+	if _, err := allocate("d.example.com", "network", map[string]interface{}{"network": "10.1.0.0/30"}); err == nil {
+		t.Error("expected an error when the network is full")
+	}
+	if _, err := allocate("e.example.com", "network", map[string]interface{}{"network": "10.9.0.0/24"}); err == nil {
+		t.Error("expected an error when no network matches the search")
+	}
+}
+
+func TestFakeWAPIFunctions(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeWAPI(t)
+	client := newFakeWAPIClient(t, f, FakeWAPIUsername+":"+FakeWAPIPassword)
+
+	zoneRef, err := f.Create("zone_auth", map[string]interface{}{"fqdn": "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	grids, _, err := client.SearchObjects(ctx, "grid", nil, "")
+	if err != nil || len(grids) != 1 {
+		t.Fatalf("expected the grid object, got %v, %v", grids, err)
+	}
+	gridRef := grids[0]["_ref"].(string)
+
+	sign := map[string]interface{}{"operation": "SIGN"}
+	if _, err := client.CallFunction(ctx, zoneRef, "dnssec_operation", sign, nil); err != nil {
+		t.Fatalf("sign: %s", err)
+	}
+	_, err = client.CallFunction(ctx, zoneRef, "dnssec_operation", sign, nil)
+	if e := utils.ParseWAPIError(err, nil); e == nil || e.Message() != "Zone example.com is already signed (Client.Ibap.Data)" {
+		t.Errorf("expected an error when signing a signed zone, got %v", err)
+	}
+	_, err = client.CallFunction(ctx, gridRef, "dnssec_operation", sign, nil)
+	if e := utils.ParseWAPIError(err, nil); e == nil || e.Kind() != utils.WAPIErrorOther {
+		t.Errorf("expected an error when calling a function of another object type, got %v", err)
+	}
+
+	var result struct {
+		DnssecZoneKeys []struct {
+			Zone string                   `json:"zone"`
+			Keys []dns.ZoneAuthDnssecKeys `json:"keys"`
+		} `json:"dnssec_zone_keys"`
+	}
+	if _, err := client.CallFunction(ctx, gridRef, "dnssecgetzonekeys", map[string]interface{}{"zones": []string{zoneRef}}, &result); err != nil {
+		t.Fatalf("get zone keys: %s", err)
+	}
+	if len(result.DnssecZoneKeys) != 1 || result.DnssecZoneKeys[0].Zone != zoneRef {
+		t.Fatalf("expected the keys of %s, got %+v", zoneRef, result)
+	}
+	keys := result.DnssecZoneKeys[0].Keys
+	if len(keys) != 2 || keys[0].GetType() != "KSK" || keys[1].GetType() != "ZSK" || keys[0].GetAlgorithm() != "8" {
+		t.Errorf("expected a RSASHA256 KSK and ZSK, got %+v", keys)
+	}
+}
+
+func TestFakeWAPISchemaAndAuth(t *testing.T) {
+	ctx := context.Background()
+	f := NewFakeWAPI(t)
+
+	client := newFakeWAPIClient(t, f, FakeWAPIUsername+":"+FakeWAPIPassword)
+	schema, err := client.GetObjectSchema(ctx, "record:a")
+	if err != nil {
+		t.Fatalf("schema: %s", err)
+	}
+	if !schema.HasField("ipv4addr") || schema.HasField("ipv6addr") {
+		t.Errorf("unexpected record:a schema: %+v", schema)
+	}
+
+	client = newFakeWAPIClient(t, f, FakeWAPIUsername+":wrong")
+	_, httpRes, err := client.DNSAPI.RecordaAPI.Get(ctx).Execute()
+	if e := utils.ParseWAPIError(err, httpRes); e == nil || e.Kind() != utils.WAPIErrorAuth {
+		t.Errorf("expected an authentication error, got %v", err)
+	}
+}
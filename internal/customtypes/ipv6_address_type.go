@@ -0,0 +1,62 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*IPv6AddressType)(nil)
+
+// IPv6AddressType is an attribute type that represents an IPv6 address.
+// Addresses are compared in their canonical form, so that `2001:db8::1` and `2001:0db8:0:0::1` are equal.
+type IPv6AddressType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t IPv6AddressType) String() string {
+	return "customtypes.IPv6AddressType"
+}
+
+// ValueType returns the Value type.
+func (t IPv6AddressType) ValueType(ctx context.Context) attr.Value {
+	return IPv6Address{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IPv6AddressType) Equal(o attr.Type) bool {
+	other, ok := o.(IPv6AddressType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IPv6AddressType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IPv6Address{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t IPv6AddressType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
@@ -0,0 +1,105 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = (*IPv6Address)(nil)
+	_ xattr.ValidateableAttribute                = (*IPv6Address)(nil)
+)
+
+// IPv6Address is the value of an IPv6AddressType attribute.
+type IPv6Address struct {
+	basetypes.StringValue
+}
+
+// NewIPv6AddressNull returns a null IPv6Address.
+func NewIPv6AddressNull() IPv6Address {
+	return IPv6Address{StringValue: basetypes.NewStringNull()}
+}
+
+// NewIPv6AddressUnknown returns an unknown IPv6Address.
+func NewIPv6AddressUnknown() IPv6Address {
+	return IPv6Address{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewIPv6AddressValue returns a known IPv6Address.
+func NewIPv6AddressValue(value string) IPv6Address {
+	return IPv6Address{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewIPv6AddressPointerValue returns a known IPv6Address, or a null one if value is nil.
+func NewIPv6AddressPointerValue(value *string) IPv6Address {
+	return IPv6Address{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+// Type returns an IPv6AddressType.
+func (v IPv6Address) Type(_ context.Context) attr.Type {
+	return IPv6AddressType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IPv6Address) Equal(o attr.Value) bool {
+	other, ok := o.(IPv6Address)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both values are the same IPv6 address, whatever their notation.
+func (v IPv6Address) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IPv6Address)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\nExpected Value Type: %T\nGot Value Type: %T", v, newValuable),
+		)
+		return false, diags
+	}
+
+	// Invalid addresses were already reported by ValidateAttribute
+	old, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	updated, err := netip.ParseAddr(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return old == updated, diags
+}
+
+// ValidateAttribute checks that the value is a valid IPv6 address.
+func (v IPv6Address) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	addr, err := netip.ParseAddr(v.ValueString())
+	if err != nil || !addr.Is6() || addr.Zone() != "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv6 Address",
+			fmt.Sprintf("A string value was provided that is not a valid IPv6 address, e.g. 2001:db8::1.\n\nGiven Value: %s", v.ValueString()))
+	}
+}
+
+// ValueIPv6Address returns the address in its canonical form, e.g. `2001:db8::1`.
+// It returns the value as is if it is not a valid address.
+func (v IPv6Address) ValueIPv6Address() string {
+	addr, err := netip.ParseAddr(v.ValueString())
+	if err != nil {
+		return v.ValueString()
+	}
+	return addr.String()
+}
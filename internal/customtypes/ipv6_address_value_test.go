@@ -0,0 +1,47 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestIPv6AddressStringSemanticEquals(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "2001:db8::1", b: "2001:0db8:0:0::1", want: true},
+		{a: "2001:DB8::1", b: "2001:db8:0:0:0:0:0:1", want: true},
+		{a: "2001:db8::1", b: "2001:db8::2", want: false},
+		{a: "2001:db8::1", b: "not an address", want: false},
+	}
+	for _, tt := range tests {
+		got, diags := NewIPv6AddressValue(tt.a).StringSemanticEquals(context.Background(), NewIPv6AddressValue(tt.b))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got != tt.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestIPv6AddressValidateAttribute(t *testing.T) {
+	tests := map[string]bool{
+		"2001:db8::1":      true,
+		"::ffff:10.0.0.1":  true,
+		"10.0.0.1":         false,
+		"2001:db8::1%eth0": false,
+		"2001:db8::g":      false,
+	}
+	for value, valid := range tests {
+		resp := xattr.ValidateAttributeResponse{}
+		NewIPv6AddressValue(value).ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("ipv6addr")}, &resp)
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("ValidateAttribute(%q): got errors %v, want valid=%t", value, resp.Diagnostics, valid)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = (*TXTTextType)(nil)
+
+// TXTTextType is an attribute type that represents the text of a TXT record, in the WAPI syntax.
+// Texts are compared by their character-strings, so that `v=spf1 -all` and `"v=spf1 -all"` are equal.
+type TXTTextType struct {
+	basetypes.StringType
+}
+
+// String returns a human-readable string of the type name.
+func (t TXTTextType) String() string {
+	return "customtypes.TXTTextType"
+}
+
+// ValueType returns the Value type.
+func (t TXTTextType) ValueType(ctx context.Context) attr.Value {
+	return TXTText{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t TXTTextType) Equal(o attr.Type) bool {
+	other, ok := o.(TXTTextType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t TXTTextType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TXTText{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t TXTTextType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
@@ -0,0 +1,77 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var _ basetypes.StringValuableWithSemanticEquals = (*TXTText)(nil)
+
+// TXTText is the value of a TXTTextType attribute.
+type TXTText struct {
+	basetypes.StringValue
+}
+
+// NewTXTTextNull returns a null TXTText.
+func NewTXTTextNull() TXTText {
+	return TXTText{StringValue: basetypes.NewStringNull()}
+}
+
+// NewTXTTextUnknown returns an unknown TXTText.
+func NewTXTTextUnknown() TXTText {
+	return TXTText{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewTXTTextValue returns a known TXTText.
+func NewTXTTextValue(value string) TXTText {
+	return TXTText{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewTXTTextPointerValue returns a known TXTText, or a null one if value is nil.
+func NewTXTTextPointerValue(value *string) TXTText {
+	return TXTText{StringValue: basetypes.NewStringPointerValue(value)}
+}
+
+// Type returns a TXTTextType.
+func (v TXTText) Type(_ context.Context) attr.Type {
+	return TXTTextType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v TXTText) Equal(o attr.Value) bool {
+	other, ok := o.(TXTText)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if both texts have the same character-strings, whatever their quoting and
+// escaping, and whether the long character-strings are split or not.
+func (v TXTText) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TXTText)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\nExpected Value Type: %T\nGot Value Type: %T", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return slices.Equal(v.ValueCharacterStrings(), newValue.ValueCharacterStrings()), diags
+}
+
+// ValueCharacterStrings returns the character-strings of the text, split at 255 bytes.
+func (v TXTText) ValueCharacterStrings() []string {
+	return utils.ParseTXTText(v.ValueString())
+}
@@ -0,0 +1,31 @@
+package customtypes
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestTXTTextStringSemanticEquals(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "v=spf1 mx -all", b: `"v=spf1 mx -all"`, want: true},
+		{a: `"part 1" "part 2"`, b: `"part 1"  "part 2"`, want: true},
+		{a: `"say \"hi\""`, b: `"say \034hi\034"`, want: true},
+		{a: long, b: `"` + long[:255] + `" "` + long[255:] + `"`, want: true},
+		{a: `"part 1" "part 2"`, b: `"part 1part 2"`, want: false},
+		{a: "v=spf1 mx -all", b: "v=spf1 -all", want: false},
+	}
+	for _, tt := range tests {
+		got, diags := NewTXTTextValue(tt.a).StringSemanticEquals(context.Background(), NewTXTTextValue(tt.b))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got != tt.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -171,6 +171,24 @@ func FlattenFrameworkListStringNotNull(ctx context.Context, l []string, diags *d
 	return tfList
 }
 
+func ExpandFrameworkSetString(ctx context.Context, tfSet types.Set, diags *diag.Diagnostics) []string {
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil
+	}
+	var data []string
+	diags.Append(tfSet.ElementsAs(ctx, &data, false)...)
+	return data
+}
+
+func FlattenFrameworkSetString(ctx context.Context, l []string, diags *diag.Diagnostics) types.Set {
+	if len(l) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	tfSet, d := types.SetValueFrom(ctx, types.StringType, l)
+	diags.Append(d...)
+	return tfSet
+}
+
 func FlattenFrameworkListInt32(ctx context.Context, l []int32, diags *diag.Diagnostics) types.List {
 	if len(l) == 0 {
 		return types.ListNull(types.Int32Type)
@@ -231,6 +249,21 @@ func FlattenFrameworkListsNestedBlock[T any, U any, V any](ctx context.Context,
 	return tfList
 }
 
+func FlattenFrameworkSetNestedBlock[T any, U any](ctx context.Context, data []T, attrTypes map[string]attr.Type, diags *diag.Diagnostics, f FrameworkElementFlExFunc[*T, U]) types.Set {
+	if len(data) == 0 {
+		return types.SetNull(types.ObjectType{AttrTypes: attrTypes})
+	}
+
+	tfData := ApplyToAll(data, func(t T) U {
+		return f(ctx, &t, diags)
+	})
+
+	tfSet, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: attrTypes}, tfData)
+
+	diags.Append(d...)
+	return tfSet
+}
+
 func FlattenFrameworkNestedBlock[T any, U any](ctx context.Context, data *T, attrTypes map[string]attr.Type, diags *diag.Diagnostics, f FrameworkElementFlExFunc[*T, U]) types.Object {
 	if data == nil {
 		return types.ObjectNull(attrTypes)
@@ -276,10 +309,10 @@ func ExpandFrameworkMapOfMapString(ctx context.Context, tfMap types.Map, diags *
 	}
 	elements := make(map[string]map[string]string, len(tfMap.Elements()))
 	diags.Append(tfMap.ElementsAs(ctx, &elements, false)...)
-	elems := make(map[string]string, len(tfMap.Elements()))
 
 	elementsNew := make(map[string]interface{}, len(tfMap.Elements()))
 	for k, v := range elements {
+		elems := make(map[string]string, len(v))
 		for k1, v1 := range v {
 			elems[k1] = v1
 		}
@@ -306,6 +339,20 @@ func ExpandFrameworkListNestedBlock[T any, U any](ctx context.Context, tfList in
 
 }
 
+func ExpandFrameworkSetNestedBlock[T any, U any](ctx context.Context, tfSet types.Set, diags *diag.Diagnostics, f FrameworkElementFlExFunc[T, *U]) []U {
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil
+	}
+
+	var data []T
+
+	diags.Append(tfSet.ElementsAs(ctx, &data, false)...)
+
+	return ApplyToAll(data, func(t T) U {
+		return *f(ctx, t, diags)
+	})
+}
+
 func ExpandFrameworkMapFilterString(ctx context.Context, tfMap types.Map, diags *diag.Diagnostics) string {
 	if tfMap.IsNull() || tfMap.IsUnknown() {
 		return ""
@@ -2,15 +2,44 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	niosclient "github.com/unasra/nios-go-client/client"
 	"github.com/unasra/nios-go-client/option"
+
 	"github.com/unasra/terraform-provider-nios/internal/service/dns"
+	"github.com/unasra/terraform-provider-nios/internal/transport"
+)
+
+const (
+	envUsername          = "NIOS_USERNAME"
+	envPassword          = "NIOS_PASSWORD"
+	envAuth              = "NIOS_AUTH"
+	envSSLMode           = "NIOS_SSL_MODE"
+	envCACertificate     = "NIOS_CA_CERTIFICATE"
+	envCACertificateFile = "NIOS_CA_CERTIFICATE_FILE"
+	envClientCertificate = "NIOS_CLIENT_CERTIFICATE"
+	envClientKey         = "NIOS_CLIENT_KEY"
+	envWAPIVersion       = "NIOS_WAPI_VERSION"
+
+	// wapiVersionAuto makes the provider use the most recent WAPI version supported by the grid.
+	wapiVersionAuto = "auto"
 )
 
 // Ensure NIOSProvider satisfies various provider interfaces.
@@ -24,8 +53,23 @@ type NIOSProvider struct {
 
 // NIOSProviderModel describes the provider data model.
 type NIOSProviderModel struct {
-	NIOSHostURL types.String `tfsdk:"nios_host_url"`
-	NIOSAuth    types.String `tfsdk:"nios_auth"`
+	NIOSHostURL           types.String         `tfsdk:"nios_host_url"`
+	NIOSAuth              types.String         `tfsdk:"nios_auth"`
+	Username              types.String         `tfsdk:"username"`
+	Password              types.String         `tfsdk:"password"`
+	WAPIVersion           types.String         `tfsdk:"wapi_version"`
+	SSLMode               types.String         `tfsdk:"ssl_mode"`
+	CACertificate         types.String         `tfsdk:"ca_certificate"`
+	CACertificateFile     types.String         `tfsdk:"ca_certificate_file"`
+	ClientCertificate     types.String         `tfsdk:"client_certificate"`
+	ClientKey             types.String         `tfsdk:"client_key"`
+	MaxRetries            types.Int32          `tfsdk:"max_retries"`
+	RetryWaitMin          timetypes.GoDuration `tfsdk:"retry_wait_min"`
+	RetryWaitMax          timetypes.GoDuration `tfsdk:"retry_wait_max"`
+	RequestTimeout        timetypes.GoDuration `tfsdk:"request_timeout"`
+	MaxConcurrentRequests types.Int32          `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Int32          `tfsdk:"requests_per_second"`
+	DefaultExtattrs       types.Map            `tfsdk:"default_extattrs"`
 }
 
 func (p *NIOSProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,7 +85,118 @@ func (p *NIOSProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional: true,
 			},
 			"nios_auth": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("username"), path.MatchRoot("password")),
+				},
+				MarkdownDescription: "Credentials in the `username:password` form. " +
+					"Can also be set with the `" + envAuth + "` environment variable.",
+				DeprecationMessage: "Use the username and password attributes instead.",
+			},
+			"username": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Name of the admin user used to authenticate with the grid. " +
+					"Can also be set with the `" + envUsername + "` environment variable.",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "Password of the admin user used to authenticate with the grid. " +
+					"Can also be set with the `" + envPassword + "` environment variable.",
+			},
+			"wapi_version": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(auto|v?[0-9]+(\.[0-9]+)*)$`), "must be a WAPI version such as `2.12.3`, or `auto`"),
+				},
+				MarkdownDescription: "WAPI version used to talk to the grid, e.g. `2.12.3`. " +
+					"Set to `auto` to use the most recent version supported by the grid. Defaults to `2.12.3`. " +
+					"Can also be set with the `" + envWAPIVersion + "` environment variable.",
+			},
+			"ssl_mode": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(transport.SSLModes...),
+				},
+				MarkdownDescription: "How the certificate of the Grid Master is verified. " +
+					"`verify_full` verifies the certificate chain and the host name, `verify_ca` only verifies the certificate chain and `insecure` disables the verification. " +
+					"Defaults to `verify_full`. Can also be set with the `" + envSSLMode + "` environment variable.",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "PEM encoded CA certificates used to verify the Grid Master certificate instead of the system certificate pool. " +
+					"Can also be set with the `" + envCACertificate + "` environment variable.",
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Path to a file of PEM encoded CA certificates used to verify the Grid Master certificate instead of the system certificate pool. " +
+					"Can also be set with the `" + envCACertificateFile + "` environment variable.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+				MarkdownDescription: "PEM encoded certificate presented to the Grid Master for client certificate authentication. " +
+					"Can also be set with the `" + envClientCertificate + "` environment variable.",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate")),
+				},
+				MarkdownDescription: "PEM encoded private key of `client_certificate`. " +
+					"Can also be set with the `" + envClientKey + "` environment variable.",
+			},
+			"max_retries": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+				MarkdownDescription: fmt.Sprintf("Maximum number of retries of a request failing with a transient error. "+
+					"Reads are retried on connection errors and when the Grid Master is unavailable, writes are only retried when they did not reach the Grid Master. "+
+					"Set to `0` to disable the retries. Defaults to `%d`.", transport.DefaultMaxRetries),
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional:   true,
+				CustomType: timetypes.GoDurationType{},
+				MarkdownDescription: fmt.Sprintf("Minimum time to wait before retrying a request, e.g. `500ms`. "+
+					"The wait doubles at each retry. Defaults to `%s`.", transport.DefaultRetryWaitMin),
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait before retrying a request, e.g. `1m`. Defaults to `%s`.", transport.DefaultRetryWaitMax),
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:            true,
+				CustomType:          timetypes.GoDurationType{},
+				MarkdownDescription: "Timeout of a single attempt of a request, e.g. `30s`. By default there is no timeout.",
+			},
+			"max_concurrent_requests": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+				MarkdownDescription: "Maximum number of requests sent concurrently to the grid by the provider, whatever the parallelism of Terraform. " +
+					"Set to `0`, the default, for no limit.",
+			},
+			"requests_per_second": schema.Int32Attribute{
 				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+				MarkdownDescription: "Maximum number of requests sent to the grid per second by the provider, retries included. " +
+					"Set to `0`, the default, for no limit.",
+			},
+			"default_extattrs": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Extensible attributes set on every object managed by the provider, e.g. `{ Owner = \"netops\" }`. " +
+					"The extensible attributes set on an object take precedence. " +
+					"The effective extensible attributes of an object are exposed in its `extattrs_all` attribute.",
 			},
 		},
 	}
@@ -56,11 +211,69 @@ func (p *NIOSProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	client := niosclient.NewAPIClient(
+	auth, err := credentials(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid NIOS credentials", err.Error())
+		return
+	}
+
+	httpClient, err := transport.NewHTTPClient(transport.Config{
+		TLS: transport.TLSConfig{
+			SSLMode:           stringValueOrEnv(data.SSLMode, envSSLMode),
+			CACertificate:     stringValueOrEnv(data.CACertificate, envCACertificate),
+			CACertificateFile: stringValueOrEnv(data.CACertificateFile, envCACertificateFile),
+			ClientCertificate: stringValueOrEnv(data.ClientCertificate, envClientCertificate),
+			ClientKey:         stringValueOrEnv(data.ClientKey, envClientKey),
+		},
+		Session: true,
+		Retry: transport.RetryConfig{
+			MaxRetries:     int(int32ValueOrDefault(data.MaxRetries, transport.DefaultMaxRetries)),
+			WaitMin:        durationValueOrDefault(data.RetryWaitMin, transport.DefaultRetryWaitMin, &resp.Diagnostics),
+			WaitMax:        durationValueOrDefault(data.RetryWaitMax, transport.DefaultRetryWaitMax, &resp.Diagnostics),
+			RequestTimeout: durationValueOrDefault(data.RequestTimeout, 0, &resp.Diagnostics),
+		},
+		Limit: transport.LimitConfig{
+			MaxConcurrentRequests: int(int32ValueOrDefault(data.MaxConcurrentRequests, 0)),
+			RequestsPerSecond:     int(int32ValueOrDefault(data.RequestsPerSecond, 0)),
+		},
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid TLS configuration", err.Error())
+		return
+	}
+
+	options := []option.ClientOption{
 		option.WithClientName(fmt.Sprintf("terraform/%s#%s", p.version, p.commit)),
-		option.WithNIOSAuth(data.NIOSAuth.ValueString()),
+		option.WithNIOSAuth(auth),
 		option.WithNIOSHostUrl(data.NIOSHostURL.ValueString()),
-	)
+		option.WithHTTPClient(httpClient),
+	}
+
+	if !data.DefaultExtattrs.IsNull() && !data.DefaultExtattrs.IsUnknown() {
+		defaultExtattrs := map[string]string{}
+		resp.Diagnostics.Append(data.DefaultExtattrs.ElementsAs(ctx, &defaultExtattrs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		options = append(options, option.WithDefaultTags(defaultExtattrs))
+	}
+
+	wapiVersion := stringValueOrEnv(data.WAPIVersion, envWAPIVersion)
+	if wapiVersion == wapiVersionAuto {
+		wapiSchema, err := niosclient.NewAPIClient(options...).GetWAPISchema(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to detect the WAPI version",
+				fmt.Sprintf("Unable to retrieve the WAPI versions supported by the grid: %s", err))
+			return
+		}
+		wapiVersion = niosclient.LatestWAPIVersion(wapiSchema.SupportedVersions)
+		tflog.Info(ctx, "Detected the WAPI version of the grid", map[string]interface{}{"wapi_version": wapiVersion})
+	}
+
+	client := niosclient.NewAPIClient(append(options, option.WithWAPIVersion(wapiVersion))...)
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -69,12 +282,64 @@ func (p *NIOSProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 func (p *NIOSProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		dns.NewRecordaResource,
+		dns.NewRecordaaaaResource,
+		dns.NewRecordcnameResource,
+		dns.NewRecordptrResource,
+		dns.NewRecordmxResource,
+		dns.NewRecordsrvResource,
+		dns.NewRecordnaptrResource,
+		dns.NewRecordtxtResource,
+		dns.NewRecordhostResource,
+		dns.NewRecordcaaResource,
+		dns.NewRecordtlsaResource,
+		dns.NewRecordsvcbResource,
+		dns.NewRecordhttpsResource,
+		dns.NewRecordaliasResource,
+		dns.NewRecordnsResource,
+		dns.NewRecorddnameResource,
+		dns.NewRecordunknownResource,
+		dns.NewZoneAuthResource,
+		dns.NewZoneForwardResource,
+		dns.NewZoneDelegatedResource,
+		dns.NewZoneStubResource,
+		dns.NewViewResource,
+		dns.NewNetworkviewResource,
+		dns.NewZoneRpResource,
+		dns.NewRecordrpzcnameResource,
+		dns.NewRecordrpzcnameipaddressResource,
+		dns.NewRecordrpzcnameclientipaddressResource,
+		dns.NewRecordrpzaResource,
+		dns.NewRecordrpzaaaaResource,
+		dns.NewZoneDnssecResource,
+		dns.NewZoneDnssecOperationResource,
 	}
 }
 
 func (p *NIOSProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		dns.NewRecordaDataSource,
+		dns.NewRecordaaaaDataSource,
+		dns.NewRecordcnameDataSource,
+		dns.NewRecordptrDataSource,
+		dns.NewRecordmxDataSource,
+		dns.NewRecordsrvDataSource,
+		dns.NewRecordnaptrDataSource,
+		dns.NewRecordtxtDataSource,
+		dns.NewRecordhostDataSource,
+		dns.NewRecordcaaDataSource,
+		dns.NewRecordtlsaDataSource,
+		dns.NewRecordsvcbDataSource,
+		dns.NewRecordhttpsDataSource,
+		dns.NewRecordaliasDataSource,
+		dns.NewRecordnsDataSource,
+		dns.NewRecorddnameDataSource,
+		dns.NewRecordunknownDataSource,
+		dns.NewZoneAuthDataSource,
+		dns.NewZoneForwardDataSource,
+		dns.NewZoneDelegatedDataSource,
+		dns.NewZoneStubDataSource,
+		dns.NewRPZRulesDataSource,
+		dns.NewZoneDnssecDsRecordsDataSource,
 	}
 }
 
@@ -86,3 +351,50 @@ func New(version, commit string) func() provider.Provider {
 		}
 	}
 }
+
+// credentials returns the credentials in the `username:password` form expected by the NIOS client.
+// The username and password attributes take precedence over the deprecated nios_auth attribute.
+func credentials(data NIOSProviderModel) (string, error) {
+	username := stringValueOrEnv(data.Username, envUsername)
+	password := stringValueOrEnv(data.Password, envPassword)
+	if username != "" || password != "" {
+		if username == "" || password == "" {
+			return "", errors.New("both username and password must be set, either in the provider configuration or with the " +
+				envUsername + " and " + envPassword + " environment variables")
+		}
+		return username + ":" + password, nil
+	}
+
+	if auth := stringValueOrEnv(data.NIOSAuth, envAuth); auth != "" {
+		return auth, nil
+	}
+
+	return "", errors.New("no credentials were provided, set the username and password attributes or the " +
+		envUsername + " and " + envPassword + " environment variables")
+}
+
+// stringValueOrEnv returns the configured value of v, falling back to the environment variable env when v is not set.
+func stringValueOrEnv(v types.String, env string) string {
+	if v.IsNull() || v.IsUnknown() {
+		return os.Getenv(env)
+	}
+	return v.ValueString()
+}
+
+// int32ValueOrDefault returns the configured value of v, or def when v is not set.
+func int32ValueOrDefault(v types.Int32, def int32) int32 {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+	return v.ValueInt32()
+}
+
+// durationValueOrDefault returns the configured value of v, or def when v is not set.
+func durationValueOrDefault(v timetypes.GoDuration, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+	d, durationDiags := v.ValueGoDuration()
+	diags.Append(durationDiags...)
+	return d
+}
@@ -0,0 +1,103 @@
+package dns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+// lookupZoneAuth returns the authoritative zone with the given name in the DNS view, with the given fields. An
+// error diagnostic is added when there is no such zone, as the DNSSEC resources and data sources apply to an
+// existing zone.
+func lookupZoneAuth(ctx context.Context, client *niosclient.APIClient, fqdn, view, returnFields string, diags *diag.Diagnostics) *dns.ZoneAuth {
+	apiRes, httpRes, err := client.DNSAPI.
+		ZoneAuthAPI.
+		Get(ctx).
+		Filters(map[string]interface{}{"fqdn": fqdn, "view": view}).
+		ReturnAsObject(1).
+		ReturnFields2(returnFields).
+		Execute()
+	if err != nil {
+		utils.AddWAPIError(diags, "read", "ZoneAuth", err, httpRes)
+		return nil
+	}
+	zones := apiRes.ListZoneAuthResponseObject.GetResult()
+	if len(zones) == 0 {
+		diags.AddError("Zone not found",
+			fmt.Sprintf("There is no authoritative zone %s in the DNS view %s. Create the zone before managing its DNSSEC settings and keys.", fqdn, view))
+		return nil
+	}
+	return &zones[0]
+}
+
+// dnssecZoneKeys returns the DNSSEC keys of the zone with the given reference, as returned by the
+// `dnssecgetzonekeys` function of the grid.
+func dnssecZoneKeys(ctx context.Context, client *niosclient.APIClient, zoneRef string, diags *diag.Diagnostics) []dns.ZoneAuthDnssecKeys {
+	grids, httpRes, err := client.SearchObjects(ctx, "grid", nil, "")
+	if err != nil {
+		utils.AddWAPIError(diags, "read", "Grid", err, httpRes)
+		return nil
+	}
+	if len(grids) == 0 {
+		diags.AddError("Client Error", "Unable to read Grid, WAPI returned no grid object.")
+		return nil
+	}
+	gridRef, _ := grids[0]["_ref"].(string)
+
+	var result struct {
+		DnssecZoneKeys []struct {
+			Keys []dns.ZoneAuthDnssecKeys `json:"keys"`
+		} `json:"dnssec_zone_keys"`
+	}
+	args := map[string]interface{}{"zones": []string{zoneRef}}
+	httpRes, err = client.CallFunction(ctx, gridRef, "dnssecgetzonekeys", args, &result)
+	if err != nil {
+		utils.AddWAPIError(diags, "read", "DnssecZoneKeys", err, httpRes)
+		return nil
+	}
+	// The keys of the only zone requested
+	if len(result.DnssecZoneKeys) == 0 {
+		return nil
+	}
+	return result.DnssecZoneKeys[0].Keys
+}
+
+// dnssecKeyFlags are the flags of the DNSKEY record of a key-signing key: zone key and secure entry point.
+const dnssecKeyFlags = 257
+
+// dsDigestTypeSHA256 is the digest type of the DS records computed by dsRecordDigest.
+const dsDigestTypeSHA256 = 2
+
+// dsRecordDigest returns the SHA-256 digest of the DS record of a DNSKEY record, as defined by RFC 4509: the hash of
+// the owner name of the key in canonical wire format followed by the RDATA of the key.
+func dsRecordDigest(owner string, flags uint16, algorithm uint8, publicKey []byte) (string, error) {
+	var wire []byte
+	owner = strings.ToLower(strings.TrimSuffix(owner, "."))
+	if owner != "" {
+		for _, label := range strings.Split(owner, ".") {
+			if label == "" || len(label) > 63 {
+				return "", fmt.Errorf("invalid owner name %q", owner)
+			}
+			wire = append(wire, byte(len(label)))
+			wire = append(wire, label...)
+		}
+	}
+	wire = append(wire, 0)
+
+	// The protocol of a DNSKEY record is always 3
+	wire = binary.BigEndian.AppendUint16(wire, flags)
+	wire = append(wire, 3, algorithm)
+	wire = append(wire, publicKey...)
+
+	digest := sha256.Sum256(wire)
+	return strings.ToUpper(hex.EncodeToString(digest[:])), nil
+}
@@ -0,0 +1,31 @@
+package dns
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestDsRecordDigest(t *testing.T) {
+	// The example of RFC 4509, section 2.3
+	publicKey, err := base64.StdEncoding.DecodeString("AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"
+
+	// The owner name is case insensitive and may be fully qualified
+	for _, owner := range []string{"dskey.example.com", "dskey.example.com.", "DSKEY.Example.COM"} {
+		got, err := dsRecordDigest(owner, 256, 5, publicKey)
+		if err != nil {
+			t.Errorf("dsRecordDigest(%q) returned error: %s", owner, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("dsRecordDigest(%q) = %s, want %s", owner, got, want)
+		}
+	}
+
+	if _, err := dsRecordDigest("dskey..example.com", 256, 5, publicKey); err == nil {
+		t.Error("dsRecordDigest with an empty label returned no error")
+	}
+}
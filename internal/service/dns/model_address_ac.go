@@ -0,0 +1,195 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type AddressACModel struct {
+	Address        types.String `tfsdk:"address"`
+	Permission     types.String `tfsdk:"permission"`
+	TsigKey        types.String `tfsdk:"tsig_key"`
+	TsigKeyAlg     types.String `tfsdk:"tsig_key_alg"`
+	TsigKeyName    types.String `tfsdk:"tsig_key_name"`
+	UseTsigKeyName types.Bool   `tfsdk:"use_tsig_key_name"`
+}
+
+var AddressACAttrTypes = map[string]attr.Type{
+	"address":           types.StringType,
+	"permission":        types.StringType,
+	"tsig_key":          types.StringType,
+	"tsig_key_alg":      types.StringType,
+	"tsig_key_name":     types.StringType,
+	"use_tsig_key_name": types.BoolType,
+}
+
+var AddressACResourceSchemaAttributes = map[string]schema.Attribute{
+	"address": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			aclAddressValidator(),
+		},
+		MarkdownDescription: "The IPv4 or IPv6 address or network the rule applies to, or `Any`. A rule has either an address or a TSIG key.",
+	},
+	"permission": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("ALLOW", "DENY"),
+		},
+		MarkdownDescription: "The permission of the address: `ALLOW` or `DENY`. Defaults to `ALLOW` for an address rule.",
+	},
+	"tsig_key": schema.StringAttribute{
+		Optional:            true,
+		Sensitive:           true,
+		MarkdownDescription: "The secret of the TSIG key, in base64.",
+	},
+	"tsig_key_alg": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("HMAC-MD5", "HMAC-SHA256"),
+		},
+		MarkdownDescription: "The algorithm of the TSIG key: `HMAC-MD5` or `HMAC-SHA256`.",
+	},
+	"tsig_key_name": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The name of the TSIG key. The requests signed with the key are allowed.",
+	},
+	"use_tsig_key_name": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Set this to true to match the requests on the name of the TSIG key only, for the keys the grid members share with Microsoft servers.",
+	},
+}
+
+func ExpandAddressAC(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneAuthAllowTransfer {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m AddressACModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+// Expand returns the rule of the zone transfer ACL of an authoritative zone. The rules of the dynamic update ACL and
+// of the match lists of a DNS view have the same fields and are converted from it.
+func (m *AddressACModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.ZoneAuthAllowTransfer {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneAuthAllowTransfer{
+		Address:        flex.ExpandStringPointer(m.Address),
+		Permission:     flex.ExpandStringPointer(m.Permission),
+		TsigKey:        flex.ExpandStringPointer(m.TsigKey),
+		TsigKeyAlg:     flex.ExpandStringPointer(m.TsigKeyAlg),
+		TsigKeyName:    flex.ExpandStringPointer(m.TsigKeyName),
+		UseTsigKeyName: flex.ExpandBoolPointer(m.UseTsigKeyName),
+	}
+	return to
+}
+
+func ExpandZoneAuthAllowUpdate(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneAuthAllowUpdate {
+	return (*dns.ZoneAuthAllowUpdate)(ExpandAddressAC(ctx, o, diags))
+}
+
+func ExpandViewMatchClients(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ViewMatchClients {
+	return (*dns.ViewMatchClients)(ExpandAddressAC(ctx, o, diags))
+}
+
+func ExpandViewMatchDestinations(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ViewMatchDestinations {
+	return (*dns.ViewMatchDestinations)(ExpandAddressAC(ctx, o, diags))
+}
+
+func FlattenAddressAC(ctx context.Context, from *dns.ZoneAuthAllowTransfer, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(AddressACAttrTypes)
+	}
+	m := AddressACModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, AddressACAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func FlattenZoneAuthAllowUpdate(ctx context.Context, from *dns.ZoneAuthAllowUpdate, diags *diag.Diagnostics) types.Object {
+	return FlattenAddressAC(ctx, (*dns.ZoneAuthAllowTransfer)(from), diags)
+}
+
+func FlattenViewMatchClients(ctx context.Context, from *dns.ViewMatchClients, diags *diag.Diagnostics) types.Object {
+	return FlattenAddressAC(ctx, (*dns.ZoneAuthAllowTransfer)(from), diags)
+}
+
+func FlattenViewMatchDestinations(ctx context.Context, from *dns.ViewMatchDestinations, diags *diag.Diagnostics) types.Object {
+	return FlattenAddressAC(ctx, (*dns.ZoneAuthAllowTransfer)(from), diags)
+}
+
+func (m *AddressACModel) Flatten(ctx context.Context, from *dns.ZoneAuthAllowTransfer, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = AddressACModel{}
+	}
+	m.Address = flex.FlattenStringPointer(from.Address)
+	m.Permission = flex.FlattenStringPointer(from.Permission)
+	m.TsigKey = flex.FlattenStringPointer(from.TsigKey)
+	m.TsigKeyAlg = flex.FlattenStringPointer(from.TsigKeyAlg)
+	m.TsigKeyName = flex.FlattenStringPointer(from.TsigKeyName)
+	m.UseTsigKeyName = types.BoolPointerValue(from.UseTsigKeyName)
+}
+
+// checkAddressACs rejects the rules of an ACL that do not have exactly one of an address and a TSIG key name: WAPI
+// tells an address rule from a TSIG rule by their fields. It checks the configuration, where the fields computed by
+// the grid are not set.
+func checkAddressACs(ctx context.Context, name string, acl types.List, diags *diag.Diagnostics) {
+	if acl.IsNull() || acl.IsUnknown() {
+		return
+	}
+	var rules []AddressACModel
+	diags.Append(acl.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	for i, rule := range rules {
+		if rule.Address.IsUnknown() || rule.TsigKeyName.IsUnknown() {
+			continue
+		}
+		p := path.Root(name).AtListIndex(i)
+		switch {
+		case rule.Address.IsNull() == rule.TsigKeyName.IsNull():
+			diags.AddAttributeError(p, "Invalid ACL rule",
+				fmt.Sprintf("A rule of %s must have either an address or a TSIG key name.", name))
+		case !rule.Address.IsNull() && (isSet(rule.TsigKey) || isSet(rule.TsigKeyAlg) || isSet(rule.UseTsigKeyName)):
+			diags.AddAttributeError(p, "Invalid ACL rule",
+				fmt.Sprintf("A rule of %s with an address cannot have TSIG key attributes.", name))
+		case !rule.TsigKeyName.IsNull() && isSet(rule.Permission):
+			diags.AddAttributeError(p.AtName("permission"), "Invalid ACL rule",
+				fmt.Sprintf("A rule of %s with a TSIG key has no permission, it always allows the signed requests.", name))
+		}
+	}
+}
+
+// isSet returns whether v is known and not null.
+func isSet(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
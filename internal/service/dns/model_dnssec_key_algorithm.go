@@ -0,0 +1,99 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type DnssecKeyAlgorithmModel struct {
+	Algorithm types.String `tfsdk:"algorithm"`
+	Size      types.Int32  `tfsdk:"size"`
+}
+
+var DnssecKeyAlgorithmAttrTypes = map[string]attr.Type{
+	"algorithm": types.StringType,
+	"size":      types.Int32Type,
+}
+
+var DnssecKeyAlgorithmResourceSchemaAttributes = map[string]schema.Attribute{
+	"algorithm": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("RSASHA1", "NSEC3RSASHA1", "RSASHA256", "RSASHA512", "ECDSAP256SHA256", "ECDSAP384SHA384"),
+		},
+		MarkdownDescription: "The signing key algorithm.",
+	},
+	"size": schema.Int32Attribute{
+		Required: true,
+		Validators: []validator.Int32{
+			int32validator.Between(256, 4096),
+		},
+		MarkdownDescription: "The signing key size, in bits. The size of an ECDSA key is set by its algorithm, 256 or 384.",
+	},
+}
+
+func ExpandDnssecKeyAlgorithm(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneauthdnsseckeyparamsKskAlgorithms {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m DnssecKeyAlgorithmModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+// Expand returns a key-signing key algorithm. The zone-signing key algorithms have the same fields and are
+// converted from it.
+func (m *DnssecKeyAlgorithmModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.ZoneauthdnsseckeyparamsKskAlgorithms {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneauthdnsseckeyparamsKskAlgorithms{
+		Algorithm: flex.ExpandString(m.Algorithm),
+		Size:      flex.ExpandInt32(m.Size),
+	}
+	return to
+}
+
+func ExpandZoneauthdnsseckeyparamsZskAlgorithms(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneauthdnsseckeyparamsZskAlgorithms {
+	return (*dns.ZoneauthdnsseckeyparamsZskAlgorithms)(ExpandDnssecKeyAlgorithm(ctx, o, diags))
+}
+
+func FlattenDnssecKeyAlgorithm(ctx context.Context, from *dns.ZoneauthdnsseckeyparamsKskAlgorithms, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(DnssecKeyAlgorithmAttrTypes)
+	}
+	m := DnssecKeyAlgorithmModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, DnssecKeyAlgorithmAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func FlattenZoneauthdnsseckeyparamsZskAlgorithms(ctx context.Context, from *dns.ZoneauthdnsseckeyparamsZskAlgorithms, diags *diag.Diagnostics) types.Object {
+	return FlattenDnssecKeyAlgorithm(ctx, (*dns.ZoneauthdnsseckeyparamsKskAlgorithms)(from), diags)
+}
+
+func (m *DnssecKeyAlgorithmModel) Flatten(ctx context.Context, from *dns.ZoneauthdnsseckeyparamsKskAlgorithms, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = DnssecKeyAlgorithmModel{}
+	}
+	m.Algorithm = flex.FlattenString(from.Algorithm)
+	m.Size = flex.FlattenInt32(from.Size)
+}
@@ -0,0 +1,123 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ExtServerModel struct {
+	Address types.String `tfsdk:"address"`
+	Name    types.String `tfsdk:"name"`
+}
+
+var ExtServerAttrTypes = map[string]attr.Type{
+	"address": types.StringType,
+	"name":    types.StringType,
+}
+
+var ExtServerResourceSchemaAttributes = map[string]schema.Attribute{
+	"address": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.Any(ipv4AddressValidator{}, ipv6AddressValidator{}),
+		},
+		MarkdownDescription: "The IPv4 or IPv6 address of the external name server.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The host name of the external name server.",
+	},
+}
+
+func ExpandExtServer(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneForwardForwardTo {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m ExtServerModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+// Expand returns a forwarder of a forward zone. The other external name servers, such as the forwarders of a grid
+// member, the name servers of a delegated zone and the primary servers of a stub zone or of a response policy zone
+// feed, have the same fields and are converted from it.
+func (m *ExtServerModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.ZoneForwardForwardTo {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneForwardForwardTo{
+		Address: flex.ExpandString(m.Address),
+		Name:    flex.ExpandString(m.Name),
+	}
+	return to
+}
+
+func ExpandZoneForwardForwardingServersForwardTo(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneForwardForwardingServersForwardTo {
+	return (*dns.ZoneForwardForwardingServersForwardTo)(ExpandExtServer(ctx, o, diags))
+}
+
+func ExpandZoneDelegatedDelegateTo(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneDelegatedDelegateTo {
+	return (*dns.ZoneDelegatedDelegateTo)(ExpandExtServer(ctx, o, diags))
+}
+
+func ExpandZoneStubStubFrom(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneStubStubFrom {
+	return (*dns.ZoneStubStubFrom)(ExpandExtServer(ctx, o, diags))
+}
+
+func ExpandZoneRpExternalPrimaries(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneRpExternalPrimaries {
+	return (*dns.ZoneRpExternalPrimaries)(ExpandExtServer(ctx, o, diags))
+}
+
+func FlattenExtServer(ctx context.Context, from *dns.ZoneForwardForwardTo, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ExtServerAttrTypes)
+	}
+	m := ExtServerModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ExtServerAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func FlattenZoneForwardForwardingServersForwardTo(ctx context.Context, from *dns.ZoneForwardForwardingServersForwardTo, diags *diag.Diagnostics) types.Object {
+	return FlattenExtServer(ctx, (*dns.ZoneForwardForwardTo)(from), diags)
+}
+
+func FlattenZoneDelegatedDelegateTo(ctx context.Context, from *dns.ZoneDelegatedDelegateTo, diags *diag.Diagnostics) types.Object {
+	return FlattenExtServer(ctx, (*dns.ZoneForwardForwardTo)(from), diags)
+}
+
+func FlattenZoneStubStubFrom(ctx context.Context, from *dns.ZoneStubStubFrom, diags *diag.Diagnostics) types.Object {
+	return FlattenExtServer(ctx, (*dns.ZoneForwardForwardTo)(from), diags)
+}
+
+func FlattenZoneRpExternalPrimaries(ctx context.Context, from *dns.ZoneRpExternalPrimaries, diags *diag.Diagnostics) types.Object {
+	return FlattenExtServer(ctx, (*dns.ZoneForwardForwardTo)(from), diags)
+}
+
+func (m *ExtServerModel) Flatten(ctx context.Context, from *dns.ZoneForwardForwardTo, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ExtServerModel{}
+	}
+	m.Address = flex.FlattenString(from.Address)
+	m.Name = flex.FlattenString(from.Name)
+}
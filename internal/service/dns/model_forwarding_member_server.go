@@ -0,0 +1,118 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ForwardingMemberServerModel struct {
+	ForwardTo             types.List   `tfsdk:"forward_to"`
+	ForwardersOnly        types.Bool   `tfsdk:"forwarders_only"`
+	Name                  types.String `tfsdk:"name"`
+	UseOverrideForwarders types.Bool   `tfsdk:"use_override_forwarders"`
+}
+
+var ForwardingMemberServerAttrTypes = map[string]attr.Type{
+	"forward_to":              types.ListType{ElemType: types.ObjectType{AttrTypes: ExtServerAttrTypes}},
+	"forwarders_only":         types.BoolType,
+	"name":                    types.StringType,
+	"use_override_forwarders": types.BoolType,
+}
+
+var ForwardingMemberServerResourceSchemaAttributes = map[string]schema.Attribute{
+	"forward_to": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ExtServerResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("use_override_forwarders")),
+		},
+		MarkdownDescription: "The forwarders of the member, which override the ones of the zone when use_override_forwarders is true.",
+	},
+	"forwarders_only": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Set this to true for the member to send the queries to the forwarders only, and not to resolve them itself when the forwarders do not answer.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The host name of the grid member.",
+	},
+	"use_override_forwarders": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("forward_to")),
+		},
+		MarkdownDescription: "Flag to indicate whether the forward_to of the member overrides the one of the zone.",
+	},
+}
+
+func ExpandForwardingMemberServer(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneForwardForwardingServers {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m ForwardingMemberServerModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *ForwardingMemberServerModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.ZoneForwardForwardingServers {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneForwardForwardingServers{
+		ForwardTo:             flex.ExpandFrameworkListNestedBlock(ctx, m.ForwardTo, diags, ExpandZoneForwardForwardingServersForwardTo),
+		ForwardersOnly:        flex.ExpandBoolPointer(m.ForwardersOnly),
+		Name:                  flex.ExpandString(m.Name),
+		UseOverrideForwarders: flex.ExpandBoolPointer(m.UseOverrideForwarders),
+	}
+	return to
+}
+
+func FlattenForwardingMemberServer(ctx context.Context, from *dns.ZoneForwardForwardingServers, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ForwardingMemberServerAttrTypes)
+	}
+	m := ForwardingMemberServerModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ForwardingMemberServerAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ForwardingMemberServerModel) Flatten(ctx context.Context, from *dns.ZoneForwardForwardingServers, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ForwardingMemberServerModel{}
+	}
+	m.ForwardTo = flex.FlattenFrameworkListNestedBlock(ctx, from.ForwardTo, ExtServerAttrTypes, diags, FlattenZoneForwardForwardingServersForwardTo)
+	m.ForwardersOnly = types.BoolPointerValue(from.ForwardersOnly)
+	m.Name = flex.FlattenString(from.Name)
+	m.UseOverrideForwarders = types.BoolPointerValue(from.UseOverrideForwarders)
+}
@@ -0,0 +1,178 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+// nextAvailableIP is the WAPI function allocating the next available address of a network or a range.
+const nextAvailableIP = "next_available_ip"
+
+type FuncCallModel struct {
+	ObjectFunction   types.String `tfsdk:"object_function"`
+	Object           types.String `tfsdk:"object"`
+	ObjectParameters types.Map    `tfsdk:"object_parameters"`
+	Exclude          types.List   `tfsdk:"exclude"`
+}
+
+var FuncCallAttrTypes = map[string]attr.Type{
+	"object_function":   types.StringType,
+	"object":            types.StringType,
+	"object_parameters": types.MapType{ElemType: types.StringType},
+	"exclude":           types.ListType{ElemType: types.StringType},
+}
+
+// funcCallResourceSchemaAttributes returns the attributes of the func_call of an address allocated from one of the
+// given objects: "network" and "range" for IPv4 addresses, "ipv6network" and "ipv6range" for IPv6 addresses.
+func funcCallResourceSchemaAttributes(objects []string, addressValidator validator.String) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"object_function": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(nextAvailableIP),
+			},
+			MarkdownDescription: "The function to call. Only `next_available_ip` is supported, it is also used when not set.",
+		},
+		"object": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(objects...),
+			},
+			MarkdownDescription: fmt.Sprintf("The type of the object the address is allocated from, one of `%s`.", strings.Join(objects, "`, `")),
+		},
+		"object_parameters": schema.MapAttribute{
+			Required:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Map{
+				mapvalidator.SizeAtLeast(1),
+			},
+			MarkdownDescription: "The search parameters of the object, such as `network` and `network_view` for a network, " +
+				"`start_addr` and `end_addr` for a range, or extensible attributes prefixed with `*`. " +
+				"The search must match a single object.",
+		},
+		"exclude": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.ValueStringsAre(addressValidator),
+			},
+			MarkdownDescription: "The addresses not to allocate.",
+		},
+	}
+}
+
+func ExpandFuncCall(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordAIpv4addrOneOf {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m FuncCallModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+// Expand returns the function call object of an A record address. The function call objects of the other addresses
+// have the same fields and are converted from it.
+func (m *FuncCallModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordAIpv4addrOneOf {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordAIpv4addrOneOf{
+		ObjectFunction: utils.Ptr(nextAvailableIP),
+		ResultField:    utils.Ptr("ips"),
+		Object:         flex.ExpandStringPointer(m.Object),
+	}
+	to.ObjectParameters = flex.ExpandFrameworkMapString(ctx, m.ObjectParameters, diags)
+	if exclude := flex.ExpandFrameworkListString(ctx, m.Exclude, diags); len(exclude) > 0 {
+		to.Parameters = map[string]interface{}{"exclude": exclude}
+	}
+	return to
+}
+
+// funcCallLockKey returns the key serializing the allocations of a function call: concurrent allocations from the
+// same network could return the same address. A range lies in a network, and a network can be searched by its
+// extensible attributes, so the key is the network the address is allocated from, looked up when it is not one of
+// the search parameters.
+//
+// When the search does not match a single object, the allocations are serialized per address family: the function
+// call fails anyway in that case. An error of the search is added to diags.
+func funcCallLockKey(ctx context.Context, client *niosclient.APIClient, fc *dns.RecordAIpv4addrOneOf, diags *diag.Diagnostics) string {
+	family := "ipv4"
+	if strings.HasPrefix(fc.GetObject(), "ipv6") {
+		family = "ipv6"
+	}
+	global := fc.GetObjectFunction() + ":" + family
+
+	parameters := map[string]string{}
+	for k, v := range fc.ObjectParameters {
+		parameters[k] = fmt.Sprint(v)
+	}
+	network, networkView := parameters["network"], parameters["network_view"]
+	if network == "" || !strings.HasSuffix(fc.GetObject(), "network") {
+		objects, httpRes, err := client.SearchObjects(ctx, fc.GetObject(), parameters, "network,network_view")
+		if err != nil {
+			utils.AddWAPIError(diags, "read", strings.ToUpper(fc.GetObject()[:1])+fc.GetObject()[1:], err, httpRes)
+			return ""
+		}
+		if len(objects) != 1 {
+			return global
+		}
+		network, _ = objects[0]["network"].(string)
+		networkView, _ = objects[0]["network_view"].(string)
+		if network == "" {
+			return global
+		}
+	}
+	if networkView == "" {
+		networkView = "default"
+	}
+	if prefix, err := netip.ParsePrefix(network); err == nil {
+		network = prefix.Masked().String()
+	}
+	return fc.GetObjectFunction() + ":" + networkView + ":" + network
+}
+
+// lockFuncCalls locks the allocations of the given function calls, in a stable order so that two resources
+// allocating addresses from several networks cannot deadlock. It returns the function unlocking them. Nothing is
+// locked when the networks of the allocations cannot be looked up, the error is added to diags.
+func lockFuncCalls(ctx context.Context, client *niosclient.APIClient, diags *diag.Diagnostics, funcCalls ...*dns.RecordAIpv4addrOneOf) func() {
+	var keys []string
+	for _, fc := range funcCalls {
+		if fc != nil {
+			keys = append(keys, funcCallLockKey(ctx, client, fc, diags))
+		}
+	}
+	if diags.HasError() {
+		return func() {}
+	}
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+	for _, key := range keys {
+		utils.GlobalMutexStore.Lock(key)
+	}
+	return func() {
+		for i := len(keys) - 1; i >= 0; i-- {
+			utils.GlobalMutexStore.Unlock(keys[i])
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/nios-go-client/option"
+
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+func TestFuncCallLockKey(t *testing.T) {
+	// The server knows a range of 10.1.0.0/24 and a network of the view "internal" with the extensible attribute Site
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		var objects []map[string]string
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/range") && query.Get("start_addr") == "10.1.0.10":
+			objects = append(objects, map[string]string{"network": "10.1.0.0/24", "network_view": "default"})
+		case strings.HasSuffix(r.URL.Path, "/ipv6network") && query.Get("*Site") == "Paris":
+			objects = append(objects, map[string]string{"network": "2001:db8::/64", "network_view": "internal"})
+		case strings.HasSuffix(r.URL.Path, "/network") && query.Get("*Site") == "down":
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(objects)
+	}))
+	defer server.Close()
+	client := niosclient.NewAPIClient(option.WithNIOSHostUrl(server.URL), option.WithNIOSAuth("token"))
+
+	tests := []struct {
+		name       string
+		object     string
+		parameters map[string]interface{}
+		want       string
+		wantErr    bool
+	}{
+		{name: "network", object: "network", parameters: map[string]interface{}{"network": "10.1.0.0/24"}, want: "next_available_ip:default:10.1.0.0/24"},
+		{name: "non canonical network", object: "ipv6network", parameters: map[string]interface{}{"network": "2001:db8:0::/64", "network_view": "internal"}, want: "next_available_ip:internal:2001:db8::/64"},
+		// A range shares the key of its network
+		{name: "range", object: "range", parameters: map[string]interface{}{"start_addr": "10.1.0.10", "end_addr": "10.1.0.20"}, want: "next_available_ip:default:10.1.0.0/24"},
+		{name: "extensible attributes", object: "ipv6network", parameters: map[string]interface{}{"*Site": "Paris"}, want: "next_available_ip:internal:2001:db8::/64"},
+		{name: "no match", object: "network", parameters: map[string]interface{}{"*Site": "London"}, want: "next_available_ip:ipv4"},
+		{name: "search failure", object: "network", parameters: map[string]interface{}{"*Site": "down"}, wantErr: true},
+		{name: "no match in IPv6", object: "ipv6range", parameters: map[string]interface{}{"start_addr": "2001:db8::10"}, want: "next_available_ip:ipv6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := &dns.RecordAIpv4addrOneOf{
+				ObjectFunction:   utils.Ptr(nextAvailableIP),
+				Object:           &tt.object,
+				ObjectParameters: tt.parameters,
+			}
+			var diags diag.Diagnostics
+			got := funcCallLockKey(context.Background(), client, fc, &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("funcCallLockKey() diagnostics = %v, want error %t", diags, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("funcCallLockKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type MemberServerModel struct {
+	GridReplicate types.Bool   `tfsdk:"grid_replicate"`
+	Lead          types.Bool   `tfsdk:"lead"`
+	Name          types.String `tfsdk:"name"`
+	Stealth       types.Bool   `tfsdk:"stealth"`
+}
+
+var MemberServerAttrTypes = map[string]attr.Type{
+	"grid_replicate": types.BoolType,
+	"lead":           types.BoolType,
+	"name":           types.StringType,
+	"stealth":        types.BoolType,
+}
+
+var MemberServerResourceSchemaAttributes = map[string]schema.Attribute{
+	"grid_replicate": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Set this to true to transfer the zone to the member with the grid replication, instead of DNS zone transfers.",
+	},
+	"lead": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Set this to true for the lead secondary server, which transfers the zone to the secondary servers that do not use the grid replication.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The host name of the grid member.",
+	},
+	"stealth": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Set this to true to hide the member: it serves the zone but has no NS record in it.",
+	},
+}
+
+func ExpandMemberServer(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneAuthGridPrimary {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m MemberServerModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+// Expand returns the grid primary server of an authoritative zone. The grid secondary servers, the members of a stub
+// zone and the servers of a response policy zone have the same fields and are converted from it.
+func (m *MemberServerModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.ZoneAuthGridPrimary {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneAuthGridPrimary{
+		GridReplicate: flex.ExpandBoolPointer(m.GridReplicate),
+		Lead:          flex.ExpandBoolPointer(m.Lead),
+		Name:          flex.ExpandString(m.Name),
+		Stealth:       flex.ExpandBoolPointer(m.Stealth),
+	}
+	return to
+}
+
+func ExpandZoneAuthGridSecondaries(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneAuthGridSecondaries {
+	return (*dns.ZoneAuthGridSecondaries)(ExpandMemberServer(ctx, o, diags))
+}
+
+func ExpandZoneStubStubMembers(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneStubStubMembers {
+	return (*dns.ZoneStubStubMembers)(ExpandMemberServer(ctx, o, diags))
+}
+
+func ExpandZoneRpGridPrimary(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneRpGridPrimary {
+	return (*dns.ZoneRpGridPrimary)(ExpandMemberServer(ctx, o, diags))
+}
+
+func ExpandZoneRpGridSecondaries(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneRpGridSecondaries {
+	return (*dns.ZoneRpGridSecondaries)(ExpandMemberServer(ctx, o, diags))
+}
+
+func FlattenMemberServer(ctx context.Context, from *dns.ZoneAuthGridPrimary, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(MemberServerAttrTypes)
+	}
+	m := MemberServerModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, MemberServerAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func FlattenZoneAuthGridSecondaries(ctx context.Context, from *dns.ZoneAuthGridSecondaries, diags *diag.Diagnostics) types.Object {
+	return FlattenMemberServer(ctx, (*dns.ZoneAuthGridPrimary)(from), diags)
+}
+
+func FlattenZoneStubStubMembers(ctx context.Context, from *dns.ZoneStubStubMembers, diags *diag.Diagnostics) types.Object {
+	return FlattenMemberServer(ctx, (*dns.ZoneAuthGridPrimary)(from), diags)
+}
+
+func FlattenZoneRpGridPrimary(ctx context.Context, from *dns.ZoneRpGridPrimary, diags *diag.Diagnostics) types.Object {
+	return FlattenMemberServer(ctx, (*dns.ZoneAuthGridPrimary)(from), diags)
+}
+
+func FlattenZoneRpGridSecondaries(ctx context.Context, from *dns.ZoneRpGridSecondaries, diags *diag.Diagnostics) types.Object {
+	return FlattenMemberServer(ctx, (*dns.ZoneAuthGridPrimary)(from), diags)
+}
+
+func (m *MemberServerModel) Flatten(ctx context.Context, from *dns.ZoneAuthGridPrimary, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = MemberServerModel{}
+	}
+	m.GridReplicate = types.BoolPointerValue(from.GridReplicate)
+	m.Lead = types.BoolPointerValue(from.Lead)
+	m.Name = flex.FlattenString(from.Name)
+	m.Stealth = types.BoolPointerValue(from.Stealth)
+}
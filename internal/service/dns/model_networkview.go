@@ -0,0 +1,128 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type NetworkviewModel struct {
+	Ref                types.String `tfsdk:"ref"`
+	AssociatedDnsViews types.List   `tfsdk:"associated_dns_views"`
+	Comment            types.String `tfsdk:"comment"`
+	Extattrs           types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll        types.Map    `tfsdk:"extattrs_all"`
+	ForceDelete        types.Bool   `tfsdk:"force_delete"`
+	IsDefault          types.Bool   `tfsdk:"is_default"`
+	Name               types.String `tfsdk:"name"`
+}
+
+var NetworkviewAttrTypes = map[string]attr.Type{
+	"ref":                  types.StringType,
+	"associated_dns_views": types.ListType{ElemType: types.StringType},
+	"comment":              types.StringType,
+	"extattrs":             types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":         types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"force_delete":         types.BoolType,
+	"is_default":           types.BoolType,
+	"name":                 types.StringType,
+}
+
+var NetworkviewResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"associated_dns_views": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "The DNS views of the network view, including the one the grid creates with the network view.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtMost(256),
+		},
+		MarkdownDescription: "Comment for the network view; maximum 256 characters.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"force_delete": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Set this to true to delete the network view with its DNS views and the zones they contain. Otherwise the network view is only deleted when its DNS views have no zones. WAPI does not have it, it is kept as configured.",
+	},
+	"is_default": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the network view is the default network view of the grid.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The name of the network view.",
+	},
+}
+
+func (m *NetworkviewModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.Networkview {
+	if m == nil {
+		return nil
+	}
+	to := &dns.Networkview{
+		Comment:  flex.ExpandStringPointer(m.Comment),
+		Extattrs: flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Name:     flex.ExpandString(m.Name),
+	}
+	return to
+}
+
+func FlattenNetworkview(ctx context.Context, from *dns.Networkview, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(NetworkviewAttrTypes)
+	}
+	m := NetworkviewModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, NetworkviewAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+// Flatten updates m with from. force_delete is not a field of WAPI, it is left as is.
+func (m *NetworkviewModel) Flatten(ctx context.Context, from *dns.Networkview, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = NetworkviewModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AssociatedDnsViews = flex.FlattenFrameworkListStringNotNull(ctx, from.AssociatedDnsViews, diags)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.IsDefault = types.BoolPointerValue(from.IsDefault)
+	m.Name = flex.FlattenString(from.Name)
+}
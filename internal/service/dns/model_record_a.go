@@ -34,7 +34,9 @@ type RecordAModel struct {
 	DiscoveredData      types.String `tfsdk:"discovered_data"`
 	DnsName             types.String `tfsdk:"dns_name"`
 	Extattrs            types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll         types.Map    `tfsdk:"extattrs_all"`
 	ForbidReclamation   types.Bool   `tfsdk:"forbid_reclamation"`
+	FuncCall            types.Object `tfsdk:"func_call"`
 	Ipv4addr            types.String `tfsdk:"ipv4addr"`
 	LastQueried         types.String `tfsdk:"last_queried"`
 	MsAdUserData        types.String `tfsdk:"ms_ad_user_data"`
@@ -61,7 +63,9 @@ var RecordAAttrTypes = map[string]attr.Type{
 	"discovered_data":       types.StringType,
 	"dns_name":              types.StringType,
 	"extattrs":              types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":          types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
 	"forbid_reclamation":    types.BoolType,
+	"func_call":             types.ObjectType{AttrTypes: FuncCallAttrTypes},
 	"ipv4addr":              types.StringType,
 	"last_queried":          types.StringType,
 	"ms_ad_user_data":       types.StringType,
@@ -140,15 +144,34 @@ var RecordAResourceSchemaAttributes = map[string]schema.Attribute{
 		//Default:             mapdefault.StaticValue(types.MapNull(types.MapType{ElemType: types.StringType})),
 		MarkdownDescription: "Extensible attributes associated with the object.",
 	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
 	"forbid_reclamation": schema.BoolAttribute{
 		Optional:            true,
 		Computed:            true,
 		Default:             booldefault.StaticBool(false),
 		MarkdownDescription: "Determines if the reclamation is allowed for the record or not.",
 	},
+	"func_call": schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: funcCallResourceSchemaAttributes([]string{"network", "range"}, ipv4AddressValidator{}),
+		MarkdownDescription: "Allocates the IPv4 Address of the record from a network or a range. " +
+			"The address is only allocated when the record is created, it is kept on update.",
+	},
 	"ipv4addr": schema.StringAttribute{
-		Required:            true,
-		MarkdownDescription: "The IPv4 Address of the record.",
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			ipv4AddressValidator{},
+			stringvalidator.ExactlyOneOf(path.MatchRoot("func_call")),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The IPv4 Address of the record. Exactly one of `ipv4addr` and `func_call` must be set.",
 	},
 	"last_queried": schema.StringAttribute{
 		Computed:            true,
@@ -191,8 +214,13 @@ var RecordAResourceSchemaAttributes = map[string]schema.Attribute{
 		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the A record.",
 	},
 	"view": schema.StringAttribute{
-		Optional:            true,
-		MarkdownDescription: "View that this record is part of.",
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
 	},
 	"zone": schema.StringAttribute{
 		Computed:            true,
@@ -212,7 +240,7 @@ func (m *RecordAModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCr
 		Disable:             flex.ExpandBoolPointer(m.Disable),
 		Extattrs:            flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
 		ForbidReclamation:   flex.ExpandBoolPointer(m.ForbidReclamation),
-		Ipv4addr:            flex.ExpandString(m.Ipv4addr),
+		Ipv4addr:            expandRecordAIpv4addr(ctx, m.Ipv4addr, m.FuncCall, diags),
 		Name:                flex.ExpandString(m.Name),
 		RemoveAssociatedPtr: flex.ExpandBoolPointer(m.RemoveAssociatedPtr),
 		Ttl:                 flex.ExpandInt32Pointer(m.Ttl),
@@ -224,6 +252,17 @@ func (m *RecordAModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCr
 	return to
 }
 
+// expandRecordAIpv4addr returns the address of an A record, or the function call allocating it while it is unknown.
+// Once allocated, the address is sent as is so that it is never allocated again.
+func expandRecordAIpv4addr(ctx context.Context, ipv4addr types.String, funcCall types.Object, diags *diag.Diagnostics) dns.RecordAIpv4addr {
+	if ipv4addr.IsUnknown() || ipv4addr.IsNull() {
+		if fc := ExpandFuncCall(ctx, funcCall, diags); fc != nil {
+			return dns.RecordAIpv4addrOneOfAsRecordAIpv4addr(fc)
+		}
+	}
+	return dns.StringAsRecordAIpv4addr(flex.ExpandStringPointer(ipv4addr))
+}
+
 func FlattenRecordA(ctx context.Context, from *dns.RecordA, diags *diag.Diagnostics) types.Object {
 	if from == nil {
 		return types.ObjectNull(RecordAAttrTypes)
@@ -254,8 +293,13 @@ func (m *RecordAModel) Flatten(ctx context.Context, from *dns.RecordA, diags *di
 	m.DiscoveredData = flex.FlattenStringPointer(from.DiscoveredData)
 	m.DnsName = flex.FlattenStringPointer(from.DnsName)
 	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
 	m.ForbidReclamation = types.BoolPointerValue(from.ForbidReclamation)
-	m.Ipv4addr = flex.FlattenString(from.Ipv4addr)
+	// WAPI does not return the function call, it is kept as configured
+	if m.FuncCall.IsNull() {
+		m.FuncCall = types.ObjectNull(FuncCallAttrTypes)
+	}
+	m.Ipv4addr = flex.FlattenStringPointer(from.Ipv4addr.String)
 	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
 	m.MsAdUserData = flex.FlattenStringPointer(from.MsAdUserData)
 	m.Name = flex.FlattenString(from.Name)
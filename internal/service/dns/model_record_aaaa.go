@@ -0,0 +1,315 @@
+package dns
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/customtypes"
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+type RecordAAAAModel struct {
+	Ref                 types.String            `tfsdk:"ref"`
+	AwsRte53RecordInfo  types.String            `tfsdk:"aws_rte53_record_info"`
+	CloudInfo           types.String            `tfsdk:"cloud_info"`
+	Comment             types.String            `tfsdk:"comment"`
+	CreationTime        types.Int32             `tfsdk:"creation_time"`
+	Creator             types.String            `tfsdk:"creator"`
+	DdnsPrincipal       types.String            `tfsdk:"ddns_principal"`
+	DdnsProtected       types.Bool              `tfsdk:"ddns_protected"`
+	Disable             types.Bool              `tfsdk:"disable"`
+	DiscoveredData      types.String            `tfsdk:"discovered_data"`
+	DnsName             types.String            `tfsdk:"dns_name"`
+	Extattrs            types.Map               `tfsdk:"extattrs"`
+	ExtattrsAll         types.Map               `tfsdk:"extattrs_all"`
+	ForbidReclamation   types.Bool              `tfsdk:"forbid_reclamation"`
+	FuncCall            types.Object            `tfsdk:"func_call"`
+	Ipv6addr            customtypes.IPv6Address `tfsdk:"ipv6addr"`
+	LastQueried         types.String            `tfsdk:"last_queried"`
+	MsAdUserData        types.String            `tfsdk:"ms_ad_user_data"`
+	Name                types.String            `tfsdk:"name"`
+	Reclaimable         types.Bool              `tfsdk:"reclaimable"`
+	RemoveAssociatedPtr types.Bool              `tfsdk:"remove_associated_ptr"`
+	SharedRecordGroup   types.String            `tfsdk:"shared_record_group"`
+	Ttl                 types.Int32             `tfsdk:"ttl"`
+	UseTtl              types.Bool              `tfsdk:"use_ttl"`
+	View                types.String            `tfsdk:"view"`
+	Zone                types.String            `tfsdk:"zone"`
+}
+
+var RecordAAAAAttrTypes = map[string]attr.Type{
+	"ref":                   types.StringType,
+	"aws_rte53_record_info": types.StringType,
+	"cloud_info":            types.StringType,
+	"comment":               types.StringType,
+	"creation_time":         types.Int32Type,
+	"creator":               types.StringType,
+	"ddns_principal":        types.StringType,
+	"ddns_protected":        types.BoolType,
+	"disable":               types.BoolType,
+	"discovered_data":       types.StringType,
+	"dns_name":              types.StringType,
+	"extattrs":              types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":          types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"forbid_reclamation":    types.BoolType,
+	"func_call":             types.ObjectType{AttrTypes: FuncCallAttrTypes},
+	"ipv6addr":              customtypes.IPv6AddressType{},
+	"last_queried":          types.StringType,
+	"ms_ad_user_data":       types.StringType,
+	"name":                  types.StringType,
+	"reclaimable":           types.BoolType,
+	"remove_associated_ptr": types.BoolType,
+	"shared_record_group":   types.StringType,
+	"ttl":                   types.Int32Type,
+	"use_ttl":               types.BoolType,
+	"view":                  types.StringType,
+	"zone":                  types.StringType,
+}
+
+var RecordAAAAResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"aws_rte53_record_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Aws Route 53 record information.",
+	},
+	"cloud_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the record; maximum 256 characters.",
+	},
+	"creation_time": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the record creation in Epoch seconds format.",
+	},
+	"creator": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("STATIC", "DYNAMIC"),
+		},
+		Default:             stringdefault.StaticString("STATIC"),
+		MarkdownDescription: "The record creator.",
+	},
+	"ddns_principal": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The GSS-TSIG principal that owns this record.",
+	},
+	"ddns_protected": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the DDNS updates for this record are allowed or not.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the record is disabled or not. False means that the record is enabled.",
+	},
+	"discovered_data": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The discovered data for this AAAA record.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name for an AAAA record in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.MapType{ElemType: types.StringType},
+		//Default:             mapdefault.StaticValue(types.MapNull(types.MapType{ElemType: types.StringType})),
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"forbid_reclamation": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the reclamation is allowed for the record or not.",
+	},
+	"func_call": schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: funcCallResourceSchemaAttributes([]string{"ipv6network", "ipv6range"}, ipv6AddressValidator{}),
+		MarkdownDescription: "Allocates the IPv6 Address of the record from an IPv6 network or an IPv6 range. " +
+			"The address is only allocated when the record is created, it is kept on update.",
+	},
+	"ipv6addr": schema.StringAttribute{
+		CustomType: customtypes.IPv6AddressType{},
+		Optional:   true,
+		Computed:   true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("func_call")),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The IPv6 Address of the record. Exactly one of `ipv6addr` and `func_call` must be set.",
+	},
+	"last_queried": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"ms_ad_user_data": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Microsoft Active Directory user related information.",
+	},
+	"name": schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The Name of the record.",
+	},
+	"reclaimable": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the record is reclaimable or not.",
+	},
+	"remove_associated_ptr": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Whether to remove associated PTR records while deleting the AAAA record.",
+	},
+	"shared_record_group": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The shared record group this record belongs to.",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the record, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the AAAA record.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the record resides.",
+	},
+}
+
+func (m *RecordAAAAModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordAAAA {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordAAAA{
+		Comment:             flex.ExpandStringPointer(m.Comment),
+		Creator:             flex.ExpandStringPointer(m.Creator),
+		DdnsPrincipal:       flex.ExpandStringPointer(m.DdnsPrincipal),
+		DdnsProtected:       flex.ExpandBoolPointer(m.DdnsProtected),
+		Disable:             flex.ExpandBoolPointer(m.Disable),
+		Extattrs:            flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		ForbidReclamation:   flex.ExpandBoolPointer(m.ForbidReclamation),
+		Ipv6addr:            expandRecordAAAAIpv6addr(ctx, m.Ipv6addr, m.FuncCall, diags),
+		Name:                flex.ExpandString(m.Name),
+		RemoveAssociatedPtr: flex.ExpandBoolPointer(m.RemoveAssociatedPtr),
+		Ttl:                 flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:              flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+// expandRecordAAAAIpv6addr returns the address of an AAAA record, or the function call allocating it while it is
+// unknown. Once allocated, the address is sent as is so that it is never allocated again.
+func expandRecordAAAAIpv6addr(ctx context.Context, ipv6addr customtypes.IPv6Address, funcCall types.Object, diags *diag.Diagnostics) dns.RecordAAAAIpv6addr {
+	if ipv6addr.IsUnknown() || ipv6addr.IsNull() {
+		if fc := ExpandFuncCall(ctx, funcCall, diags); fc != nil {
+			return dns.RecordAAAAIpv6addrOneOfAsRecordAAAAIpv6addr((*dns.RecordAAAAIpv6addrOneOf)(fc))
+		}
+	}
+	return dns.StringAsRecordAAAAIpv6addr(utils.Ptr(ipv6addr.ValueIPv6Address()))
+}
+
+func FlattenRecordAAAA(ctx context.Context, from *dns.RecordAAAA, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordAAAAAttrTypes)
+	}
+	m := RecordAAAAModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordAAAAAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordAAAAModel) Flatten(ctx context.Context, from *dns.RecordAAAA, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordAAAAModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AwsRte53RecordInfo = flex.FlattenStringPointer(from.AwsRte53RecordInfo)
+	m.CloudInfo = flex.FlattenStringPointer(from.CloudInfo)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.CreationTime = flex.FlattenInt32Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DdnsPrincipal = flex.FlattenStringPointer(from.DdnsPrincipal)
+	m.DdnsProtected = types.BoolPointerValue(from.DdnsProtected)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DiscoveredData = flex.FlattenStringPointer(from.DiscoveredData)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ForbidReclamation = types.BoolPointerValue(from.ForbidReclamation)
+	// WAPI does not return the function call, it is kept as configured
+	if m.FuncCall.IsNull() {
+		m.FuncCall = types.ObjectNull(FuncCallAttrTypes)
+	}
+	m.Ipv6addr = customtypes.NewIPv6AddressPointerValue(from.Ipv6addr.String)
+	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
+	m.MsAdUserData = flex.FlattenStringPointer(from.MsAdUserData)
+	m.Name = flex.FlattenString(from.Name)
+	m.Reclaimable = types.BoolPointerValue(from.Reclaimable)
+	m.RemoveAssociatedPtr = types.BoolPointerValue(from.RemoveAssociatedPtr)
+	m.SharedRecordGroup = flex.FlattenStringPointer(from.SharedRecordGroup)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,237 @@
+package dns
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordAliasModel struct {
+	Ref                types.String `tfsdk:"ref"`
+	AwsRte53RecordInfo types.String `tfsdk:"aws_rte53_record_info"`
+	CloudInfo          types.String `tfsdk:"cloud_info"`
+	Comment            types.String `tfsdk:"comment"`
+	Creator            types.String `tfsdk:"creator"`
+	Disable            types.Bool   `tfsdk:"disable"`
+	DnsName            types.String `tfsdk:"dns_name"`
+	DnsTargetName      types.String `tfsdk:"dns_target_name"`
+	Extattrs           types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll        types.Map    `tfsdk:"extattrs_all"`
+	LastQueried        types.String `tfsdk:"last_queried"`
+	Name               types.String `tfsdk:"name"`
+	TargetName         types.String `tfsdk:"target_name"`
+	TargetType         types.String `tfsdk:"target_type"`
+	Ttl                types.Int32  `tfsdk:"ttl"`
+	UseTtl             types.Bool   `tfsdk:"use_ttl"`
+	View               types.String `tfsdk:"view"`
+	Zone               types.String `tfsdk:"zone"`
+}
+
+var RecordAliasAttrTypes = map[string]attr.Type{
+	"ref":                   types.StringType,
+	"aws_rte53_record_info": types.StringType,
+	"cloud_info":            types.StringType,
+	"comment":               types.StringType,
+	"creator":               types.StringType,
+	"disable":               types.BoolType,
+	"dns_name":              types.StringType,
+	"dns_target_name":       types.StringType,
+	"extattrs":              types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":          types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"last_queried":          types.StringType,
+	"name":                  types.StringType,
+	"target_name":           types.StringType,
+	"target_type":           types.StringType,
+	"ttl":                   types.Int32Type,
+	"use_ttl":               types.BoolType,
+	"view":                  types.StringType,
+	"zone":                  types.StringType,
+}
+
+var RecordAliasResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"aws_rte53_record_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Aws Route 53 record information.",
+	},
+	"cloud_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the record; maximum 256 characters.",
+	},
+	"creator": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("STATIC", "DYNAMIC"),
+		},
+		Default:             stringdefault.StaticString("STATIC"),
+		MarkdownDescription: "The record creator.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the record is disabled or not. False means that the record is enabled.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name for an Alias record in punycode format.",
+	},
+	"dns_target_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The target name in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"last_queried": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The name for an Alias record in FQDN format. Unlike a CNAME record, an Alias record can be placed at the apex of a zone.",
+	},
+	"target_name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The target name in FQDN format, such as the name of a cloud load balancer. The grid answers the queries for the alias with the records of the target type of this name.",
+	},
+	"target_type": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(aliasTargetTypes...),
+		},
+		MarkdownDescription: "The type of the records of the target the alias resolves to: `A`, `AAAA`, `MX`, `NAPTR`, `PTR`, `SPF`, `SRV` or `TXT`.",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the record, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the Alias record.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the record resides.",
+	},
+}
+
+// aliasTargetTypes are the record types an Alias record can resolve to.
+var aliasTargetTypes = []string{"A", "AAAA", "MX", "NAPTR", "PTR", "SPF", "SRV", "TXT"}
+
+func (m *RecordAliasModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordAlias {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordAlias{
+		Comment:    flex.ExpandStringPointer(m.Comment),
+		Creator:    flex.ExpandStringPointer(m.Creator),
+		Disable:    flex.ExpandBoolPointer(m.Disable),
+		Extattrs:   flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Name:       flex.ExpandString(m.Name),
+		TargetName: flex.ExpandString(m.TargetName),
+		TargetType: flex.ExpandString(m.TargetType),
+		Ttl:        flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:     flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+func FlattenRecordAlias(ctx context.Context, from *dns.RecordAlias, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordAliasAttrTypes)
+	}
+	m := RecordAliasModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordAliasAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordAliasModel) Flatten(ctx context.Context, from *dns.RecordAlias, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordAliasModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AwsRte53RecordInfo = flex.FlattenStringPointer(from.AwsRte53RecordInfo)
+	m.CloudInfo = flex.FlattenStringPointer(from.CloudInfo)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.DnsTargetName = flex.FlattenStringPointer(from.DnsTargetName)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
+	m.Name = flex.FlattenString(from.Name)
+	m.TargetName = flex.FlattenString(from.TargetName)
+	m.TargetType = flex.FlattenString(from.TargetType)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,360 @@
+package dns
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordHostModel struct {
+	Ref               types.String `tfsdk:"ref"`
+	Aliases           types.Set    `tfsdk:"aliases"`
+	CloudInfo         types.String `tfsdk:"cloud_info"`
+	Comment           types.String `tfsdk:"comment"`
+	ConfigureForDns   types.Bool   `tfsdk:"configure_for_dns"`
+	CreationTime      types.Int32  `tfsdk:"creation_time"`
+	DdnsProtected     types.Bool   `tfsdk:"ddns_protected"`
+	DeviceDescription types.String `tfsdk:"device_description"`
+	DeviceLocation    types.String `tfsdk:"device_location"`
+	DeviceType        types.String `tfsdk:"device_type"`
+	DeviceVendor      types.String `tfsdk:"device_vendor"`
+	Disable           types.Bool   `tfsdk:"disable"`
+	DisableDiscovery  types.Bool   `tfsdk:"disable_discovery"`
+	DnsAliases        types.Set    `tfsdk:"dns_aliases"`
+	DnsName           types.String `tfsdk:"dns_name"`
+	Extattrs          types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll       types.Map    `tfsdk:"extattrs_all"`
+	Ipv4addrs         types.Set    `tfsdk:"ipv4addrs"`
+	Ipv6addrs         types.Set    `tfsdk:"ipv6addrs"`
+	LastQueried       types.String `tfsdk:"last_queried"`
+	MsAdUserData      types.String `tfsdk:"ms_ad_user_data"`
+	Name              types.String `tfsdk:"name"`
+	NetworkView       types.String `tfsdk:"network_view"`
+	RrsetOrder        types.String `tfsdk:"rrset_order"`
+	Ttl               types.Int32  `tfsdk:"ttl"`
+	UseTtl            types.Bool   `tfsdk:"use_ttl"`
+	View              types.String `tfsdk:"view"`
+	Zone              types.String `tfsdk:"zone"`
+}
+
+var RecordHostAttrTypes = map[string]attr.Type{
+	"ref":                types.StringType,
+	"aliases":            types.SetType{ElemType: types.StringType},
+	"cloud_info":         types.StringType,
+	"comment":            types.StringType,
+	"configure_for_dns":  types.BoolType,
+	"creation_time":      types.Int32Type,
+	"ddns_protected":     types.BoolType,
+	"device_description": types.StringType,
+	"device_location":    types.StringType,
+	"device_type":        types.StringType,
+	"device_vendor":      types.StringType,
+	"disable":            types.BoolType,
+	"disable_discovery":  types.BoolType,
+	"dns_aliases":        types.SetType{ElemType: types.StringType},
+	"dns_name":           types.StringType,
+	"extattrs":           types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":       types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"ipv4addrs":          types.SetType{ElemType: types.ObjectType{AttrTypes: RecordHostIpv4addrAttrTypes}},
+	"ipv6addrs":          types.SetType{ElemType: types.ObjectType{AttrTypes: RecordHostIpv6addrAttrTypes}},
+	"last_queried":       types.StringType,
+	"ms_ad_user_data":    types.StringType,
+	"name":               types.StringType,
+	"network_view":       types.StringType,
+	"rrset_order":        types.StringType,
+	"ttl":                types.Int32Type,
+	"use_ttl":            types.BoolType,
+	"view":               types.StringType,
+	"zone":               types.StringType,
+}
+
+var RecordHostResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"aliases": schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(domainNameValidator()),
+		},
+		MarkdownDescription: "The aliases of the host, in FQDN format. The aliases require configure_for_dns.",
+	},
+	"cloud_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the record; maximum 256 characters.",
+	},
+	"configure_for_dns": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "When configure_for_dns is false, the host is not in DNS: it has no zone and its view is ignored.",
+	},
+	"creation_time": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the record creation in Epoch seconds format.",
+	},
+	"ddns_protected": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the DDNS updates for this record are allowed or not.",
+	},
+	"device_description": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The description of the device.",
+	},
+	"device_location": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The location of the device.",
+	},
+	"device_type": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The type of the device.",
+	},
+	"device_vendor": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The vendor of the device.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the record is disabled or not. False means that the record is enabled.",
+	},
+	"disable_discovery": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the discovery for the record is disabled or not.",
+	},
+	"dns_aliases": schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The aliases of the host in punycode format.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name for a host record in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"ipv4addrs": schema.SetNestedAttribute{
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: RecordHostIpv4addrResourceSchemaAttributes,
+		},
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.AtLeastOneOf(path.MatchRoot("ipv6addrs")),
+		},
+		MarkdownDescription: "The IPv4 addresses of the host. A host record has at least one IPv4 or IPv6 address.",
+	},
+	"ipv6addrs": schema.SetNestedAttribute{
+		Optional: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: RecordHostIpv6addrResourceSchemaAttributes,
+		},
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The IPv6 addresses of the host. A host record has at least one IPv4 or IPv6 address.",
+	},
+	"last_queried": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"ms_ad_user_data": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Microsoft Active Directory user related information.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The host name in FQDN format.",
+	},
+	"network_view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The network view of the host addresses. The record is recreated when the network view changes.",
+	},
+	"rrset_order": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("cyclic", "fixed", "random"),
+		},
+		Default:             stringdefault.StaticString("cyclic"),
+		MarkdownDescription: "The order in which the resource record sets of the host are returned: \"cyclic\", \"fixed\" or \"random\".",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the record, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the host record.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the record resides.",
+	},
+}
+
+func (m *RecordHostModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordHost {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordHost{
+		Aliases:           flex.ExpandFrameworkSetString(ctx, m.Aliases, diags),
+		Comment:           flex.ExpandStringPointer(m.Comment),
+		ConfigureForDns:   flex.ExpandBoolPointer(m.ConfigureForDns),
+		DdnsProtected:     flex.ExpandBoolPointer(m.DdnsProtected),
+		DeviceDescription: flex.ExpandStringPointer(m.DeviceDescription),
+		DeviceLocation:    flex.ExpandStringPointer(m.DeviceLocation),
+		DeviceType:        flex.ExpandStringPointer(m.DeviceType),
+		DeviceVendor:      flex.ExpandStringPointer(m.DeviceVendor),
+		Disable:           flex.ExpandBoolPointer(m.Disable),
+		DisableDiscovery:  flex.ExpandBoolPointer(m.DisableDiscovery),
+		Extattrs:          flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Ipv4addrs:         flex.ExpandFrameworkSetNestedBlock(ctx, m.Ipv4addrs, diags, ExpandRecordHostIpv4addr),
+		Ipv6addrs:         flex.ExpandFrameworkSetNestedBlock(ctx, m.Ipv6addrs, diags, ExpandRecordHostIpv6addr),
+		Name:              flex.ExpandString(m.Name),
+		RrsetOrder:        flex.ExpandStringPointer(m.RrsetOrder),
+		Ttl:               flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:            flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.NetworkView = flex.ExpandStringPointer(m.NetworkView)
+		// A host that is not in DNS has no view
+		if m.ConfigureForDns.ValueBool() {
+			to.View = flex.ExpandStringPointer(m.View)
+		}
+	} else {
+		// Unset lists are not sent, empty ones remove the aliases or the addresses of the host
+		if to.Aliases == nil {
+			to.Aliases = []string{}
+		}
+		if to.Ipv4addrs == nil {
+			to.Ipv4addrs = []dns.RecordHostIpv4addr{}
+		}
+		if to.Ipv6addrs == nil {
+			to.Ipv6addrs = []dns.RecordHostIpv6addr{}
+		}
+	}
+	return to
+}
+
+func FlattenRecordHost(ctx context.Context, from *dns.RecordHost, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordHostAttrTypes)
+	}
+	m := RecordHostModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordHostAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordHostModel) Flatten(ctx context.Context, from *dns.RecordHost, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordHostModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Aliases = flex.FlattenFrameworkSetString(ctx, from.Aliases, diags)
+	m.CloudInfo = flex.FlattenStringPointer(from.CloudInfo)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.ConfigureForDns = types.BoolPointerValue(from.ConfigureForDns)
+	m.CreationTime = flex.FlattenInt32Pointer(from.CreationTime)
+	m.DdnsProtected = types.BoolPointerValue(from.DdnsProtected)
+	m.DeviceDescription = flex.FlattenStringPointerWithNilAsEmpty(from.DeviceDescription)
+	m.DeviceLocation = flex.FlattenStringPointerWithNilAsEmpty(from.DeviceLocation)
+	m.DeviceType = flex.FlattenStringPointerWithNilAsEmpty(from.DeviceType)
+	m.DeviceVendor = flex.FlattenStringPointerWithNilAsEmpty(from.DeviceVendor)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DisableDiscovery = types.BoolPointerValue(from.DisableDiscovery)
+	m.DnsAliases = flex.FlattenFrameworkSetString(ctx, from.DnsAliases, diags)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.Ipv4addrs = flex.FlattenFrameworkSetNestedBlock(ctx, from.Ipv4addrs, RecordHostIpv4addrAttrTypes, diags, FlattenRecordHostIpv4addr)
+	m.Ipv6addrs = flex.FlattenFrameworkSetNestedBlock(ctx, from.Ipv6addrs, RecordHostIpv6addrAttrTypes, diags, FlattenRecordHostIpv6addr)
+	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
+	m.MsAdUserData = flex.FlattenStringPointer(from.MsAdUserData)
+	m.Name = flex.FlattenString(from.Name)
+	m.NetworkView = flex.FlattenStringPointer(from.NetworkView)
+	m.RrsetOrder = flex.FlattenStringPointer(from.RrsetOrder)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,219 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+type RecordHostIpv4addrModel struct {
+	Ref                 types.String `tfsdk:"ref"`
+	Bootfile            types.String `tfsdk:"bootfile"`
+	Bootserver          types.String `tfsdk:"bootserver"`
+	ConfigureForDhcp    types.Bool   `tfsdk:"configure_for_dhcp"`
+	DenyBootp           types.Bool   `tfsdk:"deny_bootp"`
+	FuncCall            types.Object `tfsdk:"func_call"`
+	Host                types.String `tfsdk:"host"`
+	Ipv4addr            types.String `tfsdk:"ipv4addr"`
+	Mac                 types.String `tfsdk:"mac"`
+	Network             types.String `tfsdk:"network"`
+	Nextserver          types.String `tfsdk:"nextserver"`
+	UseBootfile         types.Bool   `tfsdk:"use_bootfile"`
+	UseBootserver       types.Bool   `tfsdk:"use_bootserver"`
+	UseDenyBootp        types.Bool   `tfsdk:"use_deny_bootp"`
+	UseForEaInheritance types.Bool   `tfsdk:"use_for_ea_inheritance"`
+	UseNextserver       types.Bool   `tfsdk:"use_nextserver"`
+}
+
+var RecordHostIpv4addrAttrTypes = map[string]attr.Type{
+	"ref":                    types.StringType,
+	"bootfile":               types.StringType,
+	"bootserver":             types.StringType,
+	"configure_for_dhcp":     types.BoolType,
+	"deny_bootp":             types.BoolType,
+	"func_call":              types.ObjectType{AttrTypes: FuncCallAttrTypes},
+	"host":                   types.StringType,
+	"ipv4addr":               types.StringType,
+	"mac":                    types.StringType,
+	"network":                types.StringType,
+	"nextserver":             types.StringType,
+	"use_bootfile":           types.BoolType,
+	"use_bootserver":         types.BoolType,
+	"use_deny_bootp":         types.BoolType,
+	"use_for_ea_inheritance": types.BoolType,
+	"use_nextserver":         types.BoolType,
+}
+
+var RecordHostIpv4addrResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the host address.",
+	},
+	"bootfile": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The name of the boot file the client must download.",
+	},
+	"bootserver": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The name or IP address of the boot server from which the client must download the boot file.",
+	},
+	"configure_for_dhcp": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Set this to true to enable the DHCP configuration for this host address. The mac attribute is then required.",
+	},
+	"deny_bootp": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Set this to true to disable the BOOTP settings and deny BOOTP boot requests.",
+	},
+	"func_call": schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: funcCallResourceSchemaAttributes([]string{"network", "range"}, ipv4AddressValidator{}),
+		MarkdownDescription: "Allocates the IPv4 Address of the host from a network or a range. " +
+			"The address is only allocated when it is added to the host, it is kept on update.",
+	},
+	"host": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The host to which the host address belongs, in FQDN format.",
+	},
+	"ipv4addr": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			ipv4AddressValidator{},
+			stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("func_call")),
+		},
+		MarkdownDescription: "The IPv4 Address of the host. Exactly one of `ipv4addr` and `func_call` must be set.",
+	},
+	"mac": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			macAddressValidator(),
+		},
+		MarkdownDescription: "The MAC address for this host address.",
+	},
+	"network": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The network of the host address, in FQDN/CIDR format.",
+	},
+	"nextserver": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The name in FQDN and/or IPv4 Address format of the next server in the host network boot.",
+	},
+	"use_bootfile": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Use flag for: bootfile",
+	},
+	"use_bootserver": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Use flag for: bootserver",
+	},
+	"use_deny_bootp": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Use flag for: deny_bootp",
+	},
+	"use_for_ea_inheritance": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Set this to true when using this host address for EA inheritance.",
+	},
+	"use_nextserver": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Use flag for: nextserver",
+	},
+}
+
+func ExpandRecordHostIpv4addr(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordHostIpv4addr {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m RecordHostIpv4addrModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *RecordHostIpv4addrModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordHostIpv4addr {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordHostIpv4addr{
+		Bootfile:            flex.ExpandStringPointer(m.Bootfile),
+		Bootserver:          flex.ExpandStringPointer(m.Bootserver),
+		ConfigureForDhcp:    flex.ExpandBoolPointer(m.ConfigureForDhcp),
+		DenyBootp:           flex.ExpandBoolPointer(m.DenyBootp),
+		Ipv4addr:            expandRecordHostIpv4addrIpv4addr(ctx, m.Ipv4addr, m.FuncCall, diags),
+		Nextserver:          flex.ExpandStringPointer(m.Nextserver),
+		UseBootfile:         flex.ExpandBoolPointer(m.UseBootfile),
+		UseBootserver:       flex.ExpandBoolPointer(m.UseBootserver),
+		UseDenyBootp:        flex.ExpandBoolPointer(m.UseDenyBootp),
+		UseForEaInheritance: flex.ExpandBoolPointer(m.UseForEaInheritance),
+		UseNextserver:       flex.ExpandBoolPointer(m.UseNextserver),
+	}
+	// WAPI rejects an empty MAC address, an address without MAC address has none
+	if m.Mac.ValueString() != "" {
+		to.Mac = flex.ExpandStringPointer(m.Mac)
+	}
+	return to
+}
+
+// expandRecordHostIpv4addrIpv4addr returns the address of a host address, or the function call allocating it while it
+// is unknown. Once allocated, the address is sent as is so that it is never allocated again.
+func expandRecordHostIpv4addrIpv4addr(ctx context.Context, ipv4addr types.String, funcCall types.Object, diags *diag.Diagnostics) *dns.RecordHostIpv4addrIpv4addr {
+	if ipv4addr.IsUnknown() || ipv4addr.IsNull() {
+		if fc := ExpandFuncCall(ctx, funcCall, diags); fc != nil {
+			return utils.Ptr(dns.RecordHostIpv4addrIpv4addrOneOfAsRecordHostIpv4addrIpv4addr((*dns.RecordHostIpv4addrIpv4addrOneOf)(fc)))
+		}
+	}
+	return utils.Ptr(dns.StringAsRecordHostIpv4addrIpv4addr(flex.ExpandStringPointer(ipv4addr)))
+}
+
+func FlattenRecordHostIpv4addr(ctx context.Context, from *dns.RecordHostIpv4addr, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordHostIpv4addrAttrTypes)
+	}
+	m := RecordHostIpv4addrModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordHostIpv4addrAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordHostIpv4addrModel) Flatten(ctx context.Context, from *dns.RecordHostIpv4addr, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordHostIpv4addrModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Bootfile = flex.FlattenStringPointer(from.Bootfile)
+	m.Bootserver = flex.FlattenStringPointer(from.Bootserver)
+	m.ConfigureForDhcp = types.BoolPointerValue(from.ConfigureForDhcp)
+	m.DenyBootp = types.BoolPointerValue(from.DenyBootp)
+	// WAPI does not return the function call, it is kept as configured
+	if m.FuncCall.IsNull() {
+		m.FuncCall = types.ObjectNull(FuncCallAttrTypes)
+	}
+	m.Host = flex.FlattenStringPointer(from.Host)
+	m.Ipv4addr = flex.FlattenStringPointer(from.GetIpv4addr().String)
+	m.Mac = flex.FlattenStringPointer(from.Mac)
+	m.Network = flex.FlattenStringPointer(from.Network)
+	m.Nextserver = flex.FlattenStringPointer(from.Nextserver)
+	m.UseBootfile = types.BoolPointerValue(from.UseBootfile)
+	m.UseBootserver = types.BoolPointerValue(from.UseBootserver)
+	m.UseDenyBootp = types.BoolPointerValue(from.UseDenyBootp)
+	m.UseForEaInheritance = types.BoolPointerValue(from.UseForEaInheritance)
+	m.UseNextserver = types.BoolPointerValue(from.UseNextserver)
+}
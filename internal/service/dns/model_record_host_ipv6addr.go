@@ -0,0 +1,172 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/customtypes"
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+type RecordHostIpv6addrModel struct {
+	Ref                 types.String            `tfsdk:"ref"`
+	ConfigureForDhcp    types.Bool              `tfsdk:"configure_for_dhcp"`
+	DomainName          types.String            `tfsdk:"domain_name"`
+	Duid                types.String            `tfsdk:"duid"`
+	FuncCall            types.Object            `tfsdk:"func_call"`
+	Host                types.String            `tfsdk:"host"`
+	Ipv6addr            customtypes.IPv6Address `tfsdk:"ipv6addr"`
+	Network             types.String            `tfsdk:"network"`
+	UseDomainName       types.Bool              `tfsdk:"use_domain_name"`
+	UseForEaInheritance types.Bool              `tfsdk:"use_for_ea_inheritance"`
+}
+
+var RecordHostIpv6addrAttrTypes = map[string]attr.Type{
+	"ref":                    types.StringType,
+	"configure_for_dhcp":     types.BoolType,
+	"domain_name":            types.StringType,
+	"duid":                   types.StringType,
+	"func_call":              types.ObjectType{AttrTypes: FuncCallAttrTypes},
+	"host":                   types.StringType,
+	"ipv6addr":               customtypes.IPv6AddressType{},
+	"network":                types.StringType,
+	"use_domain_name":        types.BoolType,
+	"use_for_ea_inheritance": types.BoolType,
+}
+
+var RecordHostIpv6addrResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the IPv6 host address.",
+	},
+	"configure_for_dhcp": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Set this to true to enable the DHCP configuration for this IPv6 host address. The duid attribute is then required.",
+	},
+	"domain_name": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The domain name of the IPv6 host address, used when use_domain_name is set.",
+	},
+	"duid": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			duidValidator(),
+		},
+		MarkdownDescription: "DHCPv6 Unique Identifier (DUID) of the IPv6 host address.",
+	},
+	"func_call": schema.SingleNestedAttribute{
+		Optional:   true,
+		Attributes: funcCallResourceSchemaAttributes([]string{"ipv6network", "ipv6range"}, ipv6AddressValidator{}),
+		MarkdownDescription: "Allocates the IPv6 Address of the host from an IPv6 network or an IPv6 range. " +
+			"The address is only allocated when it is added to the host, it is kept on update.",
+	},
+	"host": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The host to which the IPv6 host address belongs, in FQDN format.",
+	},
+	"ipv6addr": schema.StringAttribute{
+		CustomType: customtypes.IPv6AddressType{},
+		Optional:   true,
+		Computed:   true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("func_call")),
+		},
+		MarkdownDescription: "The IPv6 Address of the host. Exactly one of `ipv6addr` and `func_call` must be set.",
+	},
+	"network": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The network of the IPv6 host address, in FQDN/CIDR format.",
+	},
+	"use_domain_name": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Use flag for: domain_name",
+	},
+	"use_for_ea_inheritance": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Set this to true when using this IPv6 host address for EA inheritance.",
+	},
+}
+
+func ExpandRecordHostIpv6addr(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordHostIpv6addr {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m RecordHostIpv6addrModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *RecordHostIpv6addrModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordHostIpv6addr {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordHostIpv6addr{
+		ConfigureForDhcp:    flex.ExpandBoolPointer(m.ConfigureForDhcp),
+		DomainName:          flex.ExpandStringPointer(m.DomainName),
+		Ipv6addr:            expandRecordHostIpv6addrIpv6addr(ctx, m.Ipv6addr, m.FuncCall, diags),
+		UseDomainName:       flex.ExpandBoolPointer(m.UseDomainName),
+		UseForEaInheritance: flex.ExpandBoolPointer(m.UseForEaInheritance),
+	}
+	// WAPI rejects an empty DUID, an address without DUID has none
+	if m.Duid.ValueString() != "" {
+		to.Duid = flex.ExpandStringPointer(m.Duid)
+	}
+	return to
+}
+
+// expandRecordHostIpv6addrIpv6addr returns the address of an IPv6 host address, or the function call allocating it
+// while it is unknown. Once allocated, the address is sent as is so that it is never allocated again.
+func expandRecordHostIpv6addrIpv6addr(ctx context.Context, ipv6addr customtypes.IPv6Address, funcCall types.Object, diags *diag.Diagnostics) *dns.RecordHostIpv6addrIpv6addr {
+	if ipv6addr.IsUnknown() || ipv6addr.IsNull() {
+		if fc := ExpandFuncCall(ctx, funcCall, diags); fc != nil {
+			return utils.Ptr(dns.RecordHostIpv6addrIpv6addrOneOfAsRecordHostIpv6addrIpv6addr((*dns.RecordHostIpv6addrIpv6addrOneOf)(fc)))
+		}
+	}
+	return utils.Ptr(dns.StringAsRecordHostIpv6addrIpv6addr(utils.Ptr(ipv6addr.ValueIPv6Address())))
+}
+
+func FlattenRecordHostIpv6addr(ctx context.Context, from *dns.RecordHostIpv6addr, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordHostIpv6addrAttrTypes)
+	}
+	m := RecordHostIpv6addrModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordHostIpv6addrAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordHostIpv6addrModel) Flatten(ctx context.Context, from *dns.RecordHostIpv6addr, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordHostIpv6addrModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.ConfigureForDhcp = types.BoolPointerValue(from.ConfigureForDhcp)
+	m.DomainName = flex.FlattenStringPointer(from.DomainName)
+	m.Duid = flex.FlattenStringPointer(from.Duid)
+	m.Host = flex.FlattenStringPointer(from.Host)
+	// WAPI does not return the function call, it is kept as configured
+	if m.FuncCall.IsNull() {
+		m.FuncCall = types.ObjectNull(FuncCallAttrTypes)
+	}
+	m.Ipv6addr = customtypes.NewIPv6AddressPointerValue(from.GetIpv6addr().String)
+	m.Network = flex.FlattenStringPointer(from.Network)
+	m.UseDomainName = types.BoolPointerValue(from.UseDomainName)
+	m.UseForEaInheritance = types.BoolPointerValue(from.UseForEaInheritance)
+}
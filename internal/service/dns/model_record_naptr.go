@@ -0,0 +1,314 @@
+package dns
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordNAPTRModel struct {
+	Ref               types.String `tfsdk:"ref"`
+	CloudInfo         types.String `tfsdk:"cloud_info"`
+	Comment           types.String `tfsdk:"comment"`
+	CreationTime      types.Int32  `tfsdk:"creation_time"`
+	Creator           types.String `tfsdk:"creator"`
+	DdnsPrincipal     types.String `tfsdk:"ddns_principal"`
+	DdnsProtected     types.Bool   `tfsdk:"ddns_protected"`
+	Disable           types.Bool   `tfsdk:"disable"`
+	DnsName           types.String `tfsdk:"dns_name"`
+	DnsReplacement    types.String `tfsdk:"dns_replacement"`
+	Extattrs          types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll       types.Map    `tfsdk:"extattrs_all"`
+	Flags             types.String `tfsdk:"flags"`
+	ForbidReclamation types.Bool   `tfsdk:"forbid_reclamation"`
+	LastQueried       types.String `tfsdk:"last_queried"`
+	Name              types.String `tfsdk:"name"`
+	Order             types.Int32  `tfsdk:"order"`
+	Preference        types.Int32  `tfsdk:"preference"`
+	Reclaimable       types.Bool   `tfsdk:"reclaimable"`
+	Regexp            types.String `tfsdk:"regexp"`
+	Replacement       types.String `tfsdk:"replacement"`
+	Services          types.String `tfsdk:"services"`
+	Ttl               types.Int32  `tfsdk:"ttl"`
+	UseTtl            types.Bool   `tfsdk:"use_ttl"`
+	View              types.String `tfsdk:"view"`
+	Zone              types.String `tfsdk:"zone"`
+}
+
+var RecordNAPTRAttrTypes = map[string]attr.Type{
+	"ref":                types.StringType,
+	"cloud_info":         types.StringType,
+	"comment":            types.StringType,
+	"creation_time":      types.Int32Type,
+	"creator":            types.StringType,
+	"ddns_principal":     types.StringType,
+	"ddns_protected":     types.BoolType,
+	"disable":            types.BoolType,
+	"dns_name":           types.StringType,
+	"dns_replacement":    types.StringType,
+	"extattrs":           types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":       types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"flags":              types.StringType,
+	"forbid_reclamation": types.BoolType,
+	"last_queried":       types.StringType,
+	"name":               types.StringType,
+	"order":              types.Int32Type,
+	"preference":         types.Int32Type,
+	"reclaimable":        types.BoolType,
+	"regexp":             types.StringType,
+	"replacement":        types.StringType,
+	"services":           types.StringType,
+	"ttl":                types.Int32Type,
+	"use_ttl":            types.BoolType,
+	"view":               types.StringType,
+	"zone":               types.StringType,
+}
+
+var RecordNAPTRResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"cloud_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the record; maximum 256 characters.",
+	},
+	"creation_time": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the record creation in Epoch seconds format.",
+	},
+	"creator": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("STATIC", "DYNAMIC"),
+		},
+		Default:             stringdefault.StaticString("STATIC"),
+		MarkdownDescription: "The record creator.",
+	},
+	"ddns_principal": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The GSS-TSIG principal that owns this record.",
+	},
+	"ddns_protected": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the DDNS updates for this record are allowed or not.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the record is disabled or not. False means that the record is enabled.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name for a NAPTR record in punycode format.",
+	},
+	"dns_replacement": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The replacement of the NAPTR record in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.MapType{ElemType: types.StringType},
+		//Default:             mapdefault.StaticValue(types.MapNull(types.MapType{ElemType: types.StringType})),
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"flags": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			naptrFlagsValidator(),
+		},
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The flags controlling the interpretation of the other fields of the NAPTR record, e.g. \"U\" for a terminal rule whose result is an URI.",
+	},
+	"forbid_reclamation": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the reclamation is allowed for the record or not.",
+	},
+	"last_queried": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			recordNameValidator(),
+		},
+		MarkdownDescription: "The name for a NAPTR record in FQDN format.",
+	},
+	"order": schema.Int32Attribute{
+		Required: true,
+		Validators: []validator.Int32{
+			int32validator.Between(0, 65535),
+		},
+		MarkdownDescription: "The order in which the NAPTR records must be processed, from 0 to 65535. Lower values are processed first.",
+	},
+	"preference": schema.Int32Attribute{
+		Required: true,
+		Validators: []validator.Int32{
+			int32validator.Between(0, 65535),
+		},
+		MarkdownDescription: "The preference of the NAPTR records with the same order, from 0 to 65535. Lower values are preferred.",
+	},
+	"reclaimable": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the record is reclaimable or not.",
+	},
+	"regexp": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The substitution expression applied to the original string of the client to construct the next domain name to look up.",
+	},
+	"replacement": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameOrRootValidator(),
+		},
+		MarkdownDescription: "The next domain name to look up in FQDN format, or \".\" when regexp is used.",
+	},
+	"services": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(""),
+		MarkdownDescription: "The services available down the rewrite path, e.g. \"E2U+sip\".",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the record, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the NAPTR record.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the record resides.",
+	},
+}
+
+func (m *RecordNAPTRModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordNaptr {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordNaptr{
+		Comment:           flex.ExpandStringPointer(m.Comment),
+		Creator:           flex.ExpandStringPointer(m.Creator),
+		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
+		DdnsProtected:     flex.ExpandBoolPointer(m.DdnsProtected),
+		Disable:           flex.ExpandBoolPointer(m.Disable),
+		Extattrs:          flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Flags:             flex.ExpandStringPointer(m.Flags),
+		ForbidReclamation: flex.ExpandBoolPointer(m.ForbidReclamation),
+		Name:              flex.ExpandString(m.Name),
+		Order:             flex.ExpandInt32(m.Order),
+		Preference:        flex.ExpandInt32(m.Preference),
+		Regexp:            flex.ExpandStringPointer(m.Regexp),
+		Replacement:       flex.ExpandString(m.Replacement),
+		Services:          flex.ExpandStringPointer(m.Services),
+		Ttl:               flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:            flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+func FlattenRecordNAPTR(ctx context.Context, from *dns.RecordNaptr, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordNAPTRAttrTypes)
+	}
+	m := RecordNAPTRModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordNAPTRAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordNAPTRModel) Flatten(ctx context.Context, from *dns.RecordNaptr, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordNAPTRModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.CloudInfo = flex.FlattenStringPointer(from.CloudInfo)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.CreationTime = flex.FlattenInt32Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DdnsPrincipal = flex.FlattenStringPointer(from.DdnsPrincipal)
+	m.DdnsProtected = types.BoolPointerValue(from.DdnsProtected)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.DnsReplacement = flex.FlattenStringPointer(from.DnsReplacement)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.Flags = flex.FlattenStringPointer(from.Flags)
+	m.ForbidReclamation = types.BoolPointerValue(from.ForbidReclamation)
+	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
+	m.Name = flex.FlattenString(from.Name)
+	m.Order = types.Int32Value(from.Order)
+	m.Preference = types.Int32Value(from.Preference)
+	m.Reclaimable = types.BoolPointerValue(from.Reclaimable)
+	m.Regexp = flex.FlattenStringPointer(from.Regexp)
+	m.Replacement = flex.FlattenString(from.Replacement)
+	m.Services = flex.FlattenStringPointer(from.Services)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
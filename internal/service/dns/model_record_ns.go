@@ -0,0 +1,168 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordNSModel struct {
+	Ref              types.String `tfsdk:"ref"`
+	Addresses        types.List   `tfsdk:"addresses"`
+	CloudInfo        types.String `tfsdk:"cloud_info"`
+	Creator          types.String `tfsdk:"creator"`
+	DnsName          types.String `tfsdk:"dns_name"`
+	LastQueried      types.String `tfsdk:"last_queried"`
+	MsDelegationName types.String `tfsdk:"ms_delegation_name"`
+	Name             types.String `tfsdk:"name"`
+	Nameserver       types.String `tfsdk:"nameserver"`
+	Policy           types.String `tfsdk:"policy"`
+	View             types.String `tfsdk:"view"`
+	Zone             types.String `tfsdk:"zone"`
+}
+
+var RecordNSAttrTypes = map[string]attr.Type{
+	"ref":                types.StringType,
+	"addresses":          types.ListType{ElemType: types.ObjectType{AttrTypes: RecordNsAddressesAttrTypes}},
+	"cloud_info":         types.StringType,
+	"creator":            types.StringType,
+	"dns_name":           types.StringType,
+	"last_queried":       types.StringType,
+	"ms_delegation_name": types.StringType,
+	"name":               types.StringType,
+	"nameserver":         types.StringType,
+	"policy":             types.StringType,
+	"view":               types.StringType,
+	"zone":               types.StringType,
+}
+
+var RecordNSResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"addresses": schema.ListNestedAttribute{
+		Required: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: RecordNsAddressesResourceSchemaAttributes,
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The addresses of the name server, which are the glue records of the delegation when the name server is in the delegated zone.",
+	},
+	"cloud_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+	},
+	"creator": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The record creator.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the NS record in punycode format.",
+	},
+	"last_queried": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"ms_delegation_name": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The delegation point name of the record, for the zones a Microsoft server serves.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The name of the NS record in FQDN format: the name of the delegated subdomain, in its parent authoritative zone.",
+	},
+	"nameserver": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The domain name of an authoritative server for the delegated subdomain.",
+	},
+	"policy": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The host name policy for the record.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the record resides.",
+	},
+}
+
+func (m *RecordNSModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordNs {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordNs{
+		Addresses:        flex.ExpandFrameworkListNestedBlock(ctx, m.Addresses, diags, ExpandRecordNsAddresses),
+		MsDelegationName: flex.ExpandStringPointer(m.MsDelegationName),
+		Name:             flex.ExpandString(m.Name),
+		Nameserver:       flex.ExpandString(m.Nameserver),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+func FlattenRecordNS(ctx context.Context, from *dns.RecordNs, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordNSAttrTypes)
+	}
+	m := RecordNSModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordNSAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordNSModel) Flatten(ctx context.Context, from *dns.RecordNs, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordNSModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Addresses = flex.FlattenFrameworkListNestedBlock(ctx, from.Addresses, RecordNsAddressesAttrTypes, diags, FlattenRecordNsAddresses)
+	m.CloudInfo = flex.FlattenStringPointer(from.CloudInfo)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
+	m.MsDelegationName = flex.FlattenStringPointer(from.MsDelegationName)
+	m.Name = flex.FlattenString(from.Name)
+	m.Nameserver = flex.FlattenString(from.Nameserver)
+	m.Policy = flex.FlattenStringPointer(from.Policy)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,88 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordNsAddressesModel struct {
+	Address       types.String `tfsdk:"address"`
+	AutoCreatePtr types.Bool   `tfsdk:"auto_create_ptr"`
+}
+
+var RecordNsAddressesAttrTypes = map[string]attr.Type{
+	"address":         types.StringType,
+	"auto_create_ptr": types.BoolType,
+}
+
+var RecordNsAddressesResourceSchemaAttributes = map[string]schema.Attribute{
+	"address": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.Any(ipv4AddressValidator{}, ipv6AddressValidator{}),
+		},
+		MarkdownDescription: "The IPv4 or IPv6 address of the name server.",
+	},
+	"auto_create_ptr": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Set this to true to create the PTR record of the address in its reverse zone, when the grid serves it.",
+	},
+}
+
+func ExpandRecordNsAddresses(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordNsAddresses {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m RecordNsAddressesModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *RecordNsAddressesModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordNsAddresses {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordNsAddresses{
+		Address:       flex.ExpandString(m.Address),
+		AutoCreatePtr: flex.ExpandBoolPointer(m.AutoCreatePtr),
+	}
+	return to
+}
+
+func FlattenRecordNsAddresses(ctx context.Context, from *dns.RecordNsAddresses, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordNsAddressesAttrTypes)
+	}
+	m := RecordNsAddressesModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordNsAddressesAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordNsAddressesModel) Flatten(ctx context.Context, from *dns.RecordNsAddresses, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordNsAddressesModel{}
+	}
+	m.Address = flex.FlattenString(from.Address)
+	m.AutoCreatePtr = types.BoolPointerValue(from.AutoCreatePtr)
+}
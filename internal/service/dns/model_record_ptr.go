@@ -0,0 +1,318 @@
+package dns
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/customtypes"
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordPTRModel struct {
+	Ref                types.String            `tfsdk:"ref"`
+	AwsRte53RecordInfo types.String            `tfsdk:"aws_rte53_record_info"`
+	CloudInfo          types.String            `tfsdk:"cloud_info"`
+	Comment            types.String            `tfsdk:"comment"`
+	CreationTime       types.Int32             `tfsdk:"creation_time"`
+	Creator            types.String            `tfsdk:"creator"`
+	DdnsPrincipal      types.String            `tfsdk:"ddns_principal"`
+	DdnsProtected      types.Bool              `tfsdk:"ddns_protected"`
+	Disable            types.Bool              `tfsdk:"disable"`
+	DiscoveredData     types.String            `tfsdk:"discovered_data"`
+	DnsName            types.String            `tfsdk:"dns_name"`
+	DnsPtrdname        types.String            `tfsdk:"dns_ptrdname"`
+	Extattrs           types.Map               `tfsdk:"extattrs"`
+	ExtattrsAll        types.Map               `tfsdk:"extattrs_all"`
+	ForbidReclamation  types.Bool              `tfsdk:"forbid_reclamation"`
+	Ipv4addr           types.String            `tfsdk:"ipv4addr"`
+	Ipv6addr           customtypes.IPv6Address `tfsdk:"ipv6addr"`
+	LastQueried        types.String            `tfsdk:"last_queried"`
+	MsAdUserData       types.String            `tfsdk:"ms_ad_user_data"`
+	Name               types.String            `tfsdk:"name"`
+	Ptrdname           types.String            `tfsdk:"ptrdname"`
+	Reclaimable        types.Bool              `tfsdk:"reclaimable"`
+	SharedRecordGroup  types.String            `tfsdk:"shared_record_group"`
+	Ttl                types.Int32             `tfsdk:"ttl"`
+	UseTtl             types.Bool              `tfsdk:"use_ttl"`
+	View               types.String            `tfsdk:"view"`
+	Zone               types.String            `tfsdk:"zone"`
+}
+
+var RecordPTRAttrTypes = map[string]attr.Type{
+	"ref":                   types.StringType,
+	"aws_rte53_record_info": types.StringType,
+	"cloud_info":            types.StringType,
+	"comment":               types.StringType,
+	"creation_time":         types.Int32Type,
+	"creator":               types.StringType,
+	"ddns_principal":        types.StringType,
+	"ddns_protected":        types.BoolType,
+	"disable":               types.BoolType,
+	"discovered_data":       types.StringType,
+	"dns_name":              types.StringType,
+	"dns_ptrdname":          types.StringType,
+	"extattrs":              types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":          types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"forbid_reclamation":    types.BoolType,
+	"ipv4addr":              types.StringType,
+	"ipv6addr":              customtypes.IPv6AddressType{},
+	"last_queried":          types.StringType,
+	"ms_ad_user_data":       types.StringType,
+	"name":                  types.StringType,
+	"ptrdname":              types.StringType,
+	"reclaimable":           types.BoolType,
+	"shared_record_group":   types.StringType,
+	"ttl":                   types.Int32Type,
+	"use_ttl":               types.BoolType,
+	"view":                  types.StringType,
+	"zone":                  types.StringType,
+}
+
+var RecordPTRResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"aws_rte53_record_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Aws Route 53 record information.",
+	},
+	"cloud_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the record; maximum 256 characters.",
+	},
+	"creation_time": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the record creation in Epoch seconds format.",
+	},
+	"creator": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("STATIC", "DYNAMIC"),
+		},
+		Default:             stringdefault.StaticString("STATIC"),
+		MarkdownDescription: "The record creator.",
+	},
+	"ddns_principal": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The GSS-TSIG principal that owns this record.",
+	},
+	"ddns_protected": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the DDNS updates for this record are allowed or not.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the record is disabled or not. False means that the record is enabled.",
+	},
+	"discovered_data": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The discovered data for this PTR record.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name for a PTR record in punycode format.",
+	},
+	"dns_ptrdname": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The domain name of the DNS PTR record in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.MapType{ElemType: types.StringType},
+		//Default:             mapdefault.StaticValue(types.MapNull(types.MapType{ElemType: types.StringType})),
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"forbid_reclamation": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the reclamation is allowed for the record or not.",
+	},
+	"ipv4addr": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			ipv4AddressValidator{},
+			stringvalidator.ExactlyOneOf(path.MatchRoot("ipv4addr"), path.MatchRoot("ipv6addr"), path.MatchRoot("name")),
+		},
+		MarkdownDescription: "The IPv4 Address of the record. Computed from `name` when it is an in-addr.arpa name.",
+	},
+	"ipv6addr": schema.StringAttribute{
+		CustomType:          customtypes.IPv6AddressType{},
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The IPv6 Address of the record. Computed from `name` when it is an ip6.arpa name.",
+	},
+	"last_queried": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			recordNameValidator(),
+		},
+		MarkdownDescription: "The name of the DNS PTR record in FQDN format, e.g. `10.2.0.192.in-addr.arpa`. Computed from `ipv4addr` or `ipv6addr` when one of them is set.",
+	},
+	"ptrdname": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The domain name of the DNS PTR record in FQDN format.",
+	},
+	"ms_ad_user_data": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The Microsoft Active Directory user related information.",
+	},
+	"reclaimable": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the record is reclaimable or not.",
+	},
+	"shared_record_group": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The shared record group this record belongs to.",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the record, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the PTR record.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the record resides.",
+	},
+}
+
+func (m *RecordPTRModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordPtr {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordPtr{
+		Comment:           flex.ExpandStringPointer(m.Comment),
+		Creator:           flex.ExpandStringPointer(m.Creator),
+		DdnsPrincipal:     flex.ExpandStringPointer(m.DdnsPrincipal),
+		DdnsProtected:     flex.ExpandBoolPointer(m.DdnsProtected),
+		Disable:           flex.ExpandBoolPointer(m.Disable),
+		Extattrs:          flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		ForbidReclamation: flex.ExpandBoolPointer(m.ForbidReclamation),
+		Ptrdname:          flex.ExpandString(m.Ptrdname),
+		Ttl:               flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:            flex.ExpandBoolPointer(m.UseTtl),
+	}
+	// The record is identified by its address when it is known, WAPI computes the reverse name from it
+	switch {
+	case !m.Ipv4addr.IsNull() && !m.Ipv4addr.IsUnknown():
+		to.Ipv4addr = flex.ExpandStringPointer(m.Ipv4addr)
+	case !m.Ipv6addr.IsNull() && !m.Ipv6addr.IsUnknown():
+		to.Ipv6addr = dns.PtrString(m.Ipv6addr.ValueIPv6Address())
+	default:
+		to.Name = flex.ExpandStringPointer(m.Name)
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+func FlattenRecordPTR(ctx context.Context, from *dns.RecordPtr, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordPTRAttrTypes)
+	}
+	m := RecordPTRModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordPTRAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordPTRModel) Flatten(ctx context.Context, from *dns.RecordPtr, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordPTRModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AwsRte53RecordInfo = flex.FlattenStringPointer(from.AwsRte53RecordInfo)
+	m.CloudInfo = flex.FlattenStringPointer(from.CloudInfo)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.CreationTime = flex.FlattenInt32Pointer(from.CreationTime)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.DdnsPrincipal = flex.FlattenStringPointer(from.DdnsPrincipal)
+	m.DdnsProtected = types.BoolPointerValue(from.DdnsProtected)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DiscoveredData = flex.FlattenStringPointer(from.DiscoveredData)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.DnsPtrdname = flex.FlattenStringPointer(from.DnsPtrdname)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ForbidReclamation = types.BoolPointerValue(from.ForbidReclamation)
+	m.Ipv4addr = flex.FlattenStringPointer(from.Ipv4addr)
+	m.Ipv6addr = customtypes.NewIPv6AddressPointerValue(from.Ipv6addr)
+	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
+	m.MsAdUserData = flex.FlattenStringPointer(from.MsAdUserData)
+	m.Name = flex.FlattenStringPointer(from.Name)
+	m.Ptrdname = flex.FlattenString(from.Ptrdname)
+	m.Reclaimable = types.BoolPointerValue(from.Reclaimable)
+	m.SharedRecordGroup = flex.FlattenStringPointer(from.SharedRecordGroup)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,188 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/customtypes"
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordRPZAAAAModel struct {
+	Ref         types.String            `tfsdk:"ref"`
+	Comment     types.String            `tfsdk:"comment"`
+	Disable     types.Bool              `tfsdk:"disable"`
+	Extattrs    types.Map               `tfsdk:"extattrs"`
+	ExtattrsAll types.Map               `tfsdk:"extattrs_all"`
+	Ipv6addr    customtypes.IPv6Address `tfsdk:"ipv6addr"`
+	Name        types.String            `tfsdk:"name"`
+	RpZone      types.String            `tfsdk:"rp_zone"`
+	Ttl         types.Int32             `tfsdk:"ttl"`
+	UseTtl      types.Bool              `tfsdk:"use_ttl"`
+	View        types.String            `tfsdk:"view"`
+	Zone        types.String            `tfsdk:"zone"`
+}
+
+var RecordRPZAAAAAttrTypes = map[string]attr.Type{
+	"ref":          types.StringType,
+	"comment":      types.StringType,
+	"disable":      types.BoolType,
+	"extattrs":     types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all": types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"ipv6addr":     customtypes.IPv6AddressType{},
+	"name":         types.StringType,
+	"rp_zone":      types.StringType,
+	"ttl":          types.Int32Type,
+	"use_ttl":      types.BoolType,
+	"view":         types.StringType,
+	"zone":         types.StringType,
+}
+
+var RecordRPZAAAAResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtMost(256),
+		},
+		MarkdownDescription: "Comment for the rule; maximum 256 characters.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the rule is disabled or not. False means that the rule is enabled.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"ipv6addr": schema.StringAttribute{
+		CustomType:          customtypes.IPv6AddressType{},
+		Required:            true,
+		MarkdownDescription: "The IPv6 address substituted in the answers for the domain name of the rule.",
+	},
+	"name": schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The name of the rule: the domain name the rule applies to, followed by the name of its response policy zone, such as `bad.example.com.rpz.example.org`. A wildcard domain name such as `*.example.com` applies the rule to the subdomains.",
+	},
+	"rp_zone": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the response policy zone of the rule. The rule is recreated when the zone changes.",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the rule, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the rule.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the response policy zone of the rule. The rule is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the rule resides, its response policy zone.",
+	},
+}
+
+func (m *RecordRPZAAAAModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordRpzAaaa {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordRpzAaaa{
+		Comment:  flex.ExpandStringPointer(m.Comment),
+		Disable:  flex.ExpandBoolPointer(m.Disable),
+		Extattrs: flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Ipv6addr: m.Ipv6addr.ValueIPv6Address(),
+		Name:     flex.ExpandString(m.Name),
+		Ttl:      flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:   flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.RpZone = flex.ExpandStringPointer(m.RpZone)
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+func FlattenRecordRPZAAAA(ctx context.Context, from *dns.RecordRpzAaaa, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordRPZAAAAAttrTypes)
+	}
+	m := RecordRPZAAAAModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordRPZAAAAAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordRPZAAAAModel) Flatten(ctx context.Context, from *dns.RecordRpzAaaa, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordRPZAAAAModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.Ipv6addr = customtypes.NewIPv6AddressValue(from.Ipv6addr)
+	m.Name = flex.FlattenString(from.Name)
+	m.RpZone = flex.FlattenStringPointer(from.RpZone)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,249 @@
+package dns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+// The types of the rules on a domain name. WAPI encodes them in the canonical name of the rule: an empty name for
+// NXDOMAIN, `*` for NODATA, and the domain name of the rule itself for PASSTHRU. The rules on IP addresses encode
+// PASSTHRU, DROP and TCP_ONLY as `rpz-passthru`, `rpz-drop` and `rpz-tcp-only`.
+const (
+	rpzRuleTypeNxdomain   = "NXDOMAIN"
+	rpzRuleTypeNodata     = "NODATA"
+	rpzRuleTypePassthru   = "PASSTHRU"
+	rpzRuleTypeSubstitute = "SUBSTITUTE"
+	rpzRuleTypeDrop       = "DROP"
+	rpzRuleTypeTcpOnly    = "TCP_ONLY"
+)
+
+var rpzRuleTypes = []string{rpzRuleTypeNxdomain, rpzRuleTypeNodata, rpzRuleTypePassthru, rpzRuleTypeSubstitute}
+
+type RecordRPZCNAMEModel struct {
+	Ref         types.String `tfsdk:"ref"`
+	Canonical   types.String `tfsdk:"canonical"`
+	Comment     types.String `tfsdk:"comment"`
+	Disable     types.Bool   `tfsdk:"disable"`
+	Extattrs    types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll types.Map    `tfsdk:"extattrs_all"`
+	Name        types.String `tfsdk:"name"`
+	RpZone      types.String `tfsdk:"rp_zone"`
+	RuleType    types.String `tfsdk:"rule_type"`
+	Ttl         types.Int32  `tfsdk:"ttl"`
+	UseTtl      types.Bool   `tfsdk:"use_ttl"`
+	View        types.String `tfsdk:"view"`
+	Zone        types.String `tfsdk:"zone"`
+}
+
+var RecordRPZCNAMEAttrTypes = map[string]attr.Type{
+	"ref":          types.StringType,
+	"canonical":    types.StringType,
+	"comment":      types.StringType,
+	"disable":      types.BoolType,
+	"extattrs":     types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all": types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"name":         types.StringType,
+	"rp_zone":      types.StringType,
+	"rule_type":    types.StringType,
+	"ttl":          types.Int32Type,
+	"use_ttl":      types.BoolType,
+	"view":         types.StringType,
+	"zone":         types.StringType,
+}
+
+var RecordRPZCNAMEResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"canonical": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The domain name substituted for the queried one. Required for the `SUBSTITUTE` rules and not allowed for the others.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtMost(256),
+		},
+		MarkdownDescription: "Comment for the rule; maximum 256 characters.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the rule is disabled or not. False means that the rule is enabled.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"name": schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The name of the rule: the domain name the rule applies to, followed by the name of its response policy zone, such as `bad.example.com.rpz.example.org`. A wildcard domain name such as `*.example.com` applies the rule to the subdomains.",
+	},
+	"rp_zone": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the response policy zone of the rule. The rule is recreated when the zone changes.",
+	},
+	"rule_type": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(rpzRuleTypes...),
+		},
+		MarkdownDescription: "The action of the rule: `NXDOMAIN` to answer that the domain does not exist, `NODATA` to answer that it has no records of the queried type, `PASSTHRU` to pass the query through, or `SUBSTITUTE` to answer with the `canonical` domain name instead.",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the rule, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the rule.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the response policy zone of the rule. The rule is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the rule resides, its response policy zone.",
+	},
+}
+
+func (m *RecordRPZCNAMEModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordRpzCname {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordRpzCname{
+		Canonical: m.expandCanonical(),
+		Comment:   flex.ExpandStringPointer(m.Comment),
+		Disable:   flex.ExpandBoolPointer(m.Disable),
+		Extattrs:  flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Name:      flex.ExpandString(m.Name),
+		Ttl:       flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:    flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.RpZone = flex.ExpandStringPointer(m.RpZone)
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+func FlattenRecordRPZCNAME(ctx context.Context, from *dns.RecordRpzCname, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordRPZCNAMEAttrTypes)
+	}
+	m := RecordRPZCNAMEModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordRPZCNAMEAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordRPZCNAMEModel) Flatten(ctx context.Context, from *dns.RecordRpzCname, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordRPZCNAMEModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.RuleType, m.Canonical = flattenRPZCNAMERuleType(from)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.Name = flex.FlattenString(from.Name)
+	m.RpZone = flex.FlattenStringPointer(from.RpZone)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
+
+// expandCanonical returns the canonical name WAPI encodes the type of the rule in.
+func (m *RecordRPZCNAMEModel) expandCanonical() string {
+	switch m.RuleType.ValueString() {
+	case rpzRuleTypeNodata:
+		return "*"
+	case rpzRuleTypePassthru:
+		return rpzRuleTrigger(m.Name.ValueString(), m.RpZone.ValueString())
+	case rpzRuleTypeSubstitute:
+		return m.Canonical.ValueString()
+	}
+	return ""
+}
+
+// flattenRPZCNAMERuleType returns the type of the rule and, for a SUBSTITUTE rule, its canonical name.
+func flattenRPZCNAMERuleType(from *dns.RecordRpzCname) (types.String, types.String) {
+	switch from.Canonical {
+	case "":
+		return types.StringValue(rpzRuleTypeNxdomain), types.StringNull()
+	case "*":
+		return types.StringValue(rpzRuleTypeNodata), types.StringNull()
+	}
+	if strings.EqualFold(from.Canonical, rpzRuleTrigger(from.Name, from.GetRpZone())) {
+		return types.StringValue(rpzRuleTypePassthru), types.StringNull()
+	}
+	return types.StringValue(rpzRuleTypeSubstitute), types.StringValue(from.Canonical)
+}
+
+// rpzRuleTrigger returns the trigger of a rule, its name without the name of its response policy zone.
+func rpzRuleTrigger(name, rpZone string) string {
+	suffix := "." + rpZone
+	if len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return name[:len(name)-len(suffix)]
+	}
+	return name
+}
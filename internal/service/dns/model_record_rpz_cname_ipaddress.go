@@ -0,0 +1,240 @@
+package dns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+// The types of the rules on IP addresses. A rule on the addresses of the clients can also drop the query or have the
+// client retry over TCP, but not substitute the queried name.
+var (
+	rpzIPRuleTypes       = []string{rpzRuleTypeNxdomain, rpzRuleTypeNodata, rpzRuleTypePassthru, rpzRuleTypeSubstitute}
+	rpzClientIPRuleTypes = []string{rpzRuleTypeNxdomain, rpzRuleTypeNodata, rpzRuleTypePassthru, rpzRuleTypeDrop, rpzRuleTypeTcpOnly}
+)
+
+// rpzIPRuleCanonicals are the canonical names WAPI encodes the types of the rules on IP addresses in.
+var rpzIPRuleCanonicals = map[string]string{
+	rpzRuleTypeNxdomain: "",
+	rpzRuleTypeNodata:   "*",
+	rpzRuleTypePassthru: "rpz-passthru",
+	rpzRuleTypeDrop:     "rpz-drop",
+	rpzRuleTypeTcpOnly:  "rpz-tcp-only",
+}
+
+type RecordRPZCNAMEIpaddressModel struct {
+	Ref         types.String `tfsdk:"ref"`
+	Canonical   types.String `tfsdk:"canonical"`
+	Comment     types.String `tfsdk:"comment"`
+	Disable     types.Bool   `tfsdk:"disable"`
+	Extattrs    types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll types.Map    `tfsdk:"extattrs_all"`
+	IsIpv4      types.Bool   `tfsdk:"is_ipv4"`
+	Name        types.String `tfsdk:"name"`
+	RpZone      types.String `tfsdk:"rp_zone"`
+	RuleType    types.String `tfsdk:"rule_type"`
+	Ttl         types.Int32  `tfsdk:"ttl"`
+	UseTtl      types.Bool   `tfsdk:"use_ttl"`
+	View        types.String `tfsdk:"view"`
+	Zone        types.String `tfsdk:"zone"`
+}
+
+var RecordRPZCNAMEIpaddressAttrTypes = map[string]attr.Type{
+	"ref":          types.StringType,
+	"canonical":    types.StringType,
+	"comment":      types.StringType,
+	"disable":      types.BoolType,
+	"extattrs":     types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all": types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"is_ipv4":      types.BoolType,
+	"name":         types.StringType,
+	"rp_zone":      types.StringType,
+	"rule_type":    types.StringType,
+	"ttl":          types.Int32Type,
+	"use_ttl":      types.BoolType,
+	"view":         types.StringType,
+	"zone":         types.StringType,
+}
+
+var RecordRPZCNAMEIpaddressResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"canonical": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The domain name substituted for the queried one. Required for the `SUBSTITUTE` rules and not allowed for the others.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtMost(256),
+		},
+		MarkdownDescription: "Comment for the rule; maximum 256 characters.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the rule is disabled or not. False means that the rule is enabled.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"is_ipv4": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the trigger of the rule is an IPv4 address or network. False means that it is an IPv6 one.",
+	},
+	"name": schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The name of the rule: the address of the answers the rule applies to, an IPv4 or IPv6 address or network in CIDR notation, followed by the name of its response policy zone, such as `192.0.2.0/24.rpz.example.org`. The address or network must be in its canonical form.",
+	},
+	"rp_zone": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the response policy zone of the rule. The rule is recreated when the zone changes.",
+	},
+	"rule_type": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(rpzIPRuleTypes...),
+		},
+		MarkdownDescription: "The action of the rule, for the answers containing its address: `NXDOMAIN` to answer that the domain does not exist, `NODATA` to answer that it has no records of the queried type, `PASSTHRU` to pass the answer through, or `SUBSTITUTE` to answer with the `canonical` domain name instead.",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the rule, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the rule.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the response policy zone of the rule. The rule is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the rule resides, its response policy zone.",
+	},
+}
+
+func (m *RecordRPZCNAMEIpaddressModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordRpzCnameIpaddress {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordRpzCnameIpaddress{
+		Canonical: expandRPZIPRuleCanonical(m.RuleType, m.Canonical),
+		Comment:   flex.ExpandStringPointer(m.Comment),
+		Disable:   flex.ExpandBoolPointer(m.Disable),
+		Extattrs:  flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Name:      flex.ExpandString(m.Name),
+		Ttl:       flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:    flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.RpZone = flex.ExpandStringPointer(m.RpZone)
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+func FlattenRecordRPZCNAMEIpaddress(ctx context.Context, from *dns.RecordRpzCnameIpaddress, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordRPZCNAMEIpaddressAttrTypes)
+	}
+	m := RecordRPZCNAMEIpaddressModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordRPZCNAMEIpaddressAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordRPZCNAMEIpaddressModel) Flatten(ctx context.Context, from *dns.RecordRpzCnameIpaddress, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordRPZCNAMEIpaddressModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.RuleType, m.Canonical = flattenRPZIPRuleType(from.Canonical)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.IsIpv4 = types.BoolPointerValue(from.IsIpv4)
+	m.Name = flex.FlattenString(from.Name)
+	m.RpZone = flex.FlattenStringPointer(from.RpZone)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
+
+// expandRPZIPRuleCanonical returns the canonical name WAPI encodes the type of a rule on IP addresses in.
+func expandRPZIPRuleCanonical(ruleType, canonical types.String) string {
+	if ruleType.ValueString() == rpzRuleTypeSubstitute {
+		return canonical.ValueString()
+	}
+	return rpzIPRuleCanonicals[ruleType.ValueString()]
+}
+
+// flattenRPZIPRuleType returns the type of a rule on IP addresses and, for a SUBSTITUTE rule, its canonical name.
+func flattenRPZIPRuleType(canonical string) (types.String, types.String) {
+	for ruleType, c := range rpzIPRuleCanonicals {
+		if strings.EqualFold(canonical, c) {
+			return types.StringValue(ruleType), types.StringNull()
+		}
+	}
+	return types.StringValue(rpzRuleTypeSubstitute), types.StringValue(canonical)
+}
@@ -0,0 +1,242 @@
+package dns
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordTLSAModel struct {
+	Ref              types.String `tfsdk:"ref"`
+	CertificateData  types.String `tfsdk:"certificate_data"`
+	CertificateUsage types.Int32  `tfsdk:"certificate_usage"`
+	CloudInfo        types.String `tfsdk:"cloud_info"`
+	Comment          types.String `tfsdk:"comment"`
+	Creator          types.String `tfsdk:"creator"`
+	Disable          types.Bool   `tfsdk:"disable"`
+	DnsName          types.String `tfsdk:"dns_name"`
+	Extattrs         types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll      types.Map    `tfsdk:"extattrs_all"`
+	LastQueried      types.String `tfsdk:"last_queried"`
+	MatchedType      types.Int32  `tfsdk:"matched_type"`
+	Name             types.String `tfsdk:"name"`
+	Selector         types.Int32  `tfsdk:"selector"`
+	Ttl              types.Int32  `tfsdk:"ttl"`
+	UseTtl           types.Bool   `tfsdk:"use_ttl"`
+	View             types.String `tfsdk:"view"`
+	Zone             types.String `tfsdk:"zone"`
+}
+
+var RecordTLSAAttrTypes = map[string]attr.Type{
+	"ref":               types.StringType,
+	"certificate_data":  types.StringType,
+	"certificate_usage": types.Int32Type,
+	"cloud_info":        types.StringType,
+	"comment":           types.StringType,
+	"creator":           types.StringType,
+	"disable":           types.BoolType,
+	"dns_name":          types.StringType,
+	"extattrs":          types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":      types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"last_queried":      types.StringType,
+	"matched_type":      types.Int32Type,
+	"name":              types.StringType,
+	"selector":          types.Int32Type,
+	"ttl":               types.Int32Type,
+	"use_ttl":           types.BoolType,
+	"view":              types.StringType,
+	"zone":              types.StringType,
+}
+
+var RecordTLSAResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"certificate_data": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			hexStringValidator(),
+		},
+		MarkdownDescription: "The certificate association data, in hexadecimal: the raw data for the matching type 0, or its SHA-256 or SHA-512 hash for the matching types 1 and 2.",
+	},
+	"certificate_usage": schema.Int32Attribute{
+		Required: true,
+		Validators: []validator.Int32{
+			int32validator.Between(0, 3),
+		},
+		MarkdownDescription: "The certificate usage, which tells how the certificate of the TLS server is matched: 0 (PKIX-TA), 1 (PKIX-EE), 2 (DANE-TA) or 3 (DANE-EE).",
+	},
+	"cloud_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the record; maximum 256 characters.",
+	},
+	"creator": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("STATIC", "DYNAMIC"),
+		},
+		Default:             stringdefault.StaticString("STATIC"),
+		MarkdownDescription: "The record creator.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the record is disabled or not. False means that the record is enabled.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name for a TLSA record in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"last_queried": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"matched_type": schema.Int32Attribute{
+		Required: true,
+		Validators: []validator.Int32{
+			int32validator.Between(0, 2),
+		},
+		MarkdownDescription: "The matching type, which tells how the certificate association data is presented: 0 (full data), 1 (SHA-256 hash) or 2 (SHA-512 hash).",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			tlsaNameValidator(),
+		},
+		MarkdownDescription: "The name for a TLSA record in FQDN format, prefixed with the port and the protocol of the TLS server, such as `_443._tcp.www.example.com`.",
+	},
+	"selector": schema.Int32Attribute{
+		Required: true,
+		Validators: []validator.Int32{
+			int32validator.Between(0, 1),
+		},
+		MarkdownDescription: "The selector, which tells which part of the certificate is matched: 0 (full certificate) or 1 (SubjectPublicKeyInfo).",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the record, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the TLSA record.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the record resides.",
+	},
+}
+
+func (m *RecordTLSAModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordTlsa {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordTlsa{
+		CertificateData:  flex.ExpandString(m.CertificateData),
+		CertificateUsage: flex.ExpandInt32(m.CertificateUsage),
+		Comment:          flex.ExpandStringPointer(m.Comment),
+		Creator:          flex.ExpandStringPointer(m.Creator),
+		Disable:          flex.ExpandBoolPointer(m.Disable),
+		Extattrs:         flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		MatchedType:      flex.ExpandInt32(m.MatchedType),
+		Name:             flex.ExpandString(m.Name),
+		Selector:         flex.ExpandInt32(m.Selector),
+		Ttl:              flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:           flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+	}
+	return to
+}
+
+func FlattenRecordTLSA(ctx context.Context, from *dns.RecordTlsa, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordTLSAAttrTypes)
+	}
+	m := RecordTLSAModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordTLSAAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordTLSAModel) Flatten(ctx context.Context, from *dns.RecordTlsa, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordTLSAModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.CertificateData = flex.FlattenString(from.CertificateData)
+	m.CertificateUsage = types.Int32Value(from.CertificateUsage)
+	m.CloudInfo = flex.FlattenStringPointer(from.CloudInfo)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
+	m.MatchedType = types.Int32Value(from.MatchedType)
+	m.Name = flex.FlattenString(from.Name)
+	m.Selector = types.Int32Value(from.Selector)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
@@ -0,0 +1,300 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordUnknownModel struct {
+	Ref                  types.String `tfsdk:"ref"`
+	CloudInfo            types.String `tfsdk:"cloud_info"`
+	Comment              types.String `tfsdk:"comment"`
+	Creator              types.String `tfsdk:"creator"`
+	Disable              types.Bool   `tfsdk:"disable"`
+	DisplayRdata         types.String `tfsdk:"display_rdata"`
+	DnsName              types.String `tfsdk:"dns_name"`
+	EnableHostNamePolicy types.Bool   `tfsdk:"enable_host_name_policy"`
+	Extattrs             types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll          types.Map    `tfsdk:"extattrs_all"`
+	LastQueried          types.String `tfsdk:"last_queried"`
+	Name                 types.String `tfsdk:"name"`
+	Policy               types.String `tfsdk:"policy"`
+	RecordType           types.Int32  `tfsdk:"record_type"`
+	SubfieldValues       types.List   `tfsdk:"subfield_values"`
+	Ttl                  types.Int32  `tfsdk:"ttl"`
+	UseTtl               types.Bool   `tfsdk:"use_ttl"`
+	View                 types.String `tfsdk:"view"`
+	Zone                 types.String `tfsdk:"zone"`
+}
+
+var RecordUnknownAttrTypes = map[string]attr.Type{
+	"ref":                     types.StringType,
+	"cloud_info":              types.StringType,
+	"comment":                 types.StringType,
+	"creator":                 types.StringType,
+	"disable":                 types.BoolType,
+	"display_rdata":           types.StringType,
+	"dns_name":                types.StringType,
+	"enable_host_name_policy": types.BoolType,
+	"extattrs":                types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":            types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"last_queried":            types.StringType,
+	"name":                    types.StringType,
+	"policy":                  types.StringType,
+	"record_type":             types.Int32Type,
+	"subfield_values":         types.ListType{ElemType: types.ObjectType{AttrTypes: RecordUnknownSubfieldValuesAttrTypes}},
+	"ttl":                     types.Int32Type,
+	"use_ttl":                 types.BoolType,
+	"view":                    types.StringType,
+	"zone":                    types.StringType,
+}
+
+var RecordUnknownResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"cloud_info": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Structure containing all cloud API related information for this object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the record; maximum 256 characters.",
+	},
+	"creator": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("STATIC", "DYNAMIC"),
+		},
+		Default:             stringdefault.StaticString("STATIC"),
+		MarkdownDescription: "The record creator.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the record is disabled or not. False means that the record is enabled.",
+	},
+	"display_rdata": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The textual representation of the RDATA of the record.",
+	},
+	"dns_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the unknown record in punycode format.",
+	},
+	"enable_host_name_policy": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines whether the host name policy is applicable for the record.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"last_queried": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The time of the last DNS query in Epoch seconds format.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			recordNameValidator(),
+		},
+		MarkdownDescription: "The name of the unknown record in FQDN format.",
+	},
+	"policy": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The host name policy for the record.",
+	},
+	"record_type": schema.Int32Attribute{
+		Required: true,
+		Validators: []validator.Int32{
+			recordTypeValidator{},
+		},
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The number of the record type, such as 65534. The meta types and query types of RFC 6895, such as OPT and ANY, cannot be records. The record is recreated when the type changes.",
+	},
+	"subfield_values": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: RecordUnknownSubfieldValuesResourceSchemaAttributes,
+		},
+		Optional:            true,
+		MarkdownDescription: "The subfields of the RDATA of the record, in order. A record without subfields has an empty RDATA.",
+	},
+	"ttl": schema.Int32Attribute{
+		Optional:            true,
+		MarkdownDescription: "Time-to-live value of the record, in seconds.",
+		Validators: []validator.Int32{
+			int32validator.AlsoRequires(path.MatchRoot("use_ttl")),
+		},
+	},
+	"use_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the TTL value should be used for the unknown record.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "View that this record is part of. The record is recreated when the view changes.",
+	},
+	"zone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The zone in which the record resides.",
+	},
+}
+
+func (m *RecordUnknownModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.RecordUnknown {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordUnknown{
+		Comment:        flex.ExpandStringPointer(m.Comment),
+		Creator:        flex.ExpandStringPointer(m.Creator),
+		Disable:        flex.ExpandBoolPointer(m.Disable),
+		Extattrs:       flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Name:           flex.ExpandString(m.Name),
+		RecordType:     fmt.Sprintf("TYPE%d", m.RecordType.ValueInt32()),
+		SubfieldValues: flex.ExpandFrameworkListNestedBlock(ctx, m.SubfieldValues, diags, ExpandRecordUnknownSubfieldValues),
+		Ttl:            flex.ExpandInt32Pointer(m.Ttl),
+		UseTtl:         flex.ExpandBoolPointer(m.UseTtl),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+	} else if to.SubfieldValues == nil {
+		// WAPI keeps the subfields when they are not sent, removing them takes an empty list
+		to.SubfieldValues = []dns.RecordUnknownSubfieldValues{}
+	}
+	return to
+}
+
+func FlattenRecordUnknown(ctx context.Context, from *dns.RecordUnknown, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordUnknownAttrTypes)
+	}
+	m := RecordUnknownModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordUnknownAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordUnknownModel) Flatten(ctx context.Context, from *dns.RecordUnknown, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordUnknownModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.CloudInfo = flex.FlattenStringPointer(from.CloudInfo)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Creator = flex.FlattenStringPointer(from.Creator)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DisplayRdata = flex.FlattenStringPointer(from.DisplayRdata)
+	m.DnsName = flex.FlattenStringPointer(from.DnsName)
+	m.EnableHostNamePolicy = types.BoolPointerValue(from.EnableHostNamePolicy)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.LastQueried = flex.FlattenStringPointer(from.LastQueried)
+	m.Name = flex.FlattenString(from.Name)
+	m.Policy = flex.FlattenStringPointer(from.Policy)
+	m.RecordType = flattenUnknownRecordType(from.RecordType, diags)
+	m.SubfieldValues = flex.FlattenFrameworkListNestedBlock(ctx, from.SubfieldValues, RecordUnknownSubfieldValuesAttrTypes, diags, FlattenRecordUnknownSubfieldValues)
+	m.Ttl = flex.FlattenInt32Pointer(from.Ttl)
+	m.UseTtl = types.BoolPointerValue(from.UseTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.Zone = flex.FlattenStringPointer(from.Zone)
+}
+
+// unknownRecordTypes are the numbers of the record types WAPI may name with their mnemonic rather than in the TYPEnnn
+// format of RFC 3597, e.g. SPF for the type 99.
+var unknownRecordTypes = map[string]int32{
+	"AFSDB":      18,
+	"APL":        42,
+	"CAA":        257,
+	"CDNSKEY":    60,
+	"CDS":        59,
+	"CERT":       37,
+	"CSYNC":      62,
+	"DHCID":      49,
+	"DLV":        32769,
+	"EUI48":      108,
+	"EUI64":      109,
+	"HINFO":      13,
+	"HIP":        55,
+	"HTTPS":      65,
+	"IPSECKEY":   45,
+	"KEY":        25,
+	"KX":         36,
+	"LOC":        29,
+	"MINFO":      14,
+	"NSEC3PARAM": 51,
+	"OPENPGPKEY": 61,
+	"RP":         17,
+	"SMIMEA":     53,
+	"SPF":        99,
+	"SSHFP":      44,
+	"SVCB":       64,
+	"TA":         32768,
+	"TLSA":       52,
+	"URI":        256,
+	"ZONEMD":     63,
+}
+
+// flattenUnknownRecordType returns the number of a record type WAPI names in the TYPEnnn format of RFC 3597 or with
+// its mnemonic.
+func flattenUnknownRecordType(recordType string, diags *diag.Diagnostics) types.Int32 {
+	if n, ok := unknownRecordTypes[strings.ToUpper(recordType)]; ok {
+		return types.Int32Value(n)
+	}
+	n, err := strconv.ParseInt(strings.TrimPrefix(recordType, "TYPE"), 10, 32)
+	if !strings.HasPrefix(recordType, "TYPE") || err != nil {
+		diags.AddError("Unexpected record type", fmt.Sprintf("The record type %q is neither in the TYPEnnn format nor a known mnemonic.", recordType))
+		return types.Int32Null()
+	}
+	return types.Int32Value(int32(n))
+}
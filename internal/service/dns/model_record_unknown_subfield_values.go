@@ -0,0 +1,99 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type RecordUnknownSubfieldValuesModel struct {
+	FieldType     types.String `tfsdk:"field_type"`
+	FieldValue    types.String `tfsdk:"field_value"`
+	IncludeLength types.String `tfsdk:"include_length"`
+}
+
+var RecordUnknownSubfieldValuesAttrTypes = map[string]attr.Type{
+	"field_type":     types.StringType,
+	"field_value":    types.StringType,
+	"include_length": types.StringType,
+}
+
+var RecordUnknownSubfieldValuesResourceSchemaAttributes = map[string]schema.Attribute{
+	"field_type": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("B", "S", "I", "H", "X", "4", "6", "N", "T"),
+		},
+		MarkdownDescription: "The type of the subfield: `B`, `S` or `I` for an unsigned 8, 16 or 32-bit integer, `H` for base64 data, `X` for hexadecimal data, `4` or `6` for an IPv4 or IPv6 address, `N` for a domain name and `T` for a text string.",
+	},
+	"field_value": schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The value of the subfield, in the textual format of its type.",
+	},
+	"include_length": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("NONE"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("NONE", "8_BIT", "16_BIT"),
+		},
+		MarkdownDescription: "The size of the length field that precedes the subfield in the RDATA: `NONE`, `8_BIT` or `16_BIT`.",
+	},
+}
+
+func ExpandRecordUnknownSubfieldValues(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordUnknownSubfieldValues {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m RecordUnknownSubfieldValuesModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *RecordUnknownSubfieldValuesModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordUnknownSubfieldValues {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordUnknownSubfieldValues{
+		FieldType:     flex.ExpandString(m.FieldType),
+		FieldValue:    flex.ExpandString(m.FieldValue),
+		IncludeLength: flex.ExpandStringPointer(m.IncludeLength),
+	}
+	return to
+}
+
+func FlattenRecordUnknownSubfieldValues(ctx context.Context, from *dns.RecordUnknownSubfieldValues, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(RecordUnknownSubfieldValuesAttrTypes)
+	}
+	m := RecordUnknownSubfieldValuesModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, RecordUnknownSubfieldValuesAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *RecordUnknownSubfieldValuesModel) Flatten(ctx context.Context, from *dns.RecordUnknownSubfieldValues, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = RecordUnknownSubfieldValuesModel{}
+	}
+	m.FieldType = flex.FlattenString(from.FieldType)
+	m.FieldValue = flex.FlattenString(from.FieldValue)
+	m.IncludeLength = flex.FlattenStringPointer(from.IncludeLength)
+}
@@ -0,0 +1,37 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFlattenUnknownRecordType(t *testing.T) {
+	tests := []struct {
+		recordType string
+		want       types.Int32
+		wantErr    bool
+	}{
+		{recordType: "TYPE65280", want: types.Int32Value(65280)},
+		{recordType: "TYPE99", want: types.Int32Value(99)},
+		{recordType: "SPF", want: types.Int32Value(99)},
+		{recordType: "SSHFP", want: types.Int32Value(44)},
+		{recordType: "caa", want: types.Int32Value(257)},
+		{recordType: "TYPE", want: types.Int32Null(), wantErr: true},
+		{recordType: "NOTATYPE", want: types.Int32Null(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.recordType, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := flattenUnknownRecordType(tt.recordType, &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("flattenUnknownRecordType(%q) diagnostics = %v, want error %t", tt.recordType, diags, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("flattenUnknownRecordType(%q) = %s, want %s", tt.recordType, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type SvcParametersModel struct {
+	Mandatory types.Bool   `tfsdk:"mandatory"`
+	SvcKey    types.String `tfsdk:"svc_key"`
+	SvcValue  types.List   `tfsdk:"svc_value"`
+}
+
+var SvcParametersAttrTypes = map[string]attr.Type{
+	"mandatory": types.BoolType,
+	"svc_key":   types.StringType,
+	"svc_value": types.ListType{ElemType: types.StringType},
+}
+
+var SvcParametersResourceSchemaAttributes = map[string]schema.Attribute{
+	"mandatory": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Set this to true to list the parameter in the mandatory keys of the record: clients that do not support it must ignore the record.",
+	},
+	"svc_key": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			svcParamKeyValidator(),
+		},
+		MarkdownDescription: "The key of the parameter: `alpn`, `no-default-alpn`, `port`, `ipv4hint`, `ech`, `ipv6hint`, `dohpath`, `ohttp`, or `keyNNNNN` for the keys without a name. The mandatory keys are set with the mandatory attribute of the parameters.",
+	},
+	"svc_value": schema.ListAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The values of the parameter, such as the protocol identifiers for `alpn` or the addresses for `ipv4hint`. `no-default-alpn` and `ohttp` have no value.",
+	},
+}
+
+func ExpandSvcParameters(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordSvcbSvcParameters {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m SvcParametersModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+// Expand returns the SVC parameter of an SVCB record. The SVC parameters of an HTTPS record have the same fields and
+// are converted from it.
+func (m *SvcParametersModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.RecordSvcbSvcParameters {
+	if m == nil {
+		return nil
+	}
+	to := &dns.RecordSvcbSvcParameters{
+		Mandatory: flex.ExpandBoolPointer(m.Mandatory),
+		SvcKey:    flex.ExpandString(m.SvcKey),
+		SvcValue:  flex.ExpandFrameworkListString(ctx, m.SvcValue, diags),
+	}
+	return to
+}
+
+func ExpandHttpsSvcParameters(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.RecordHttpsSvcParameters {
+	return (*dns.RecordHttpsSvcParameters)(ExpandSvcParameters(ctx, o, diags))
+}
+
+func FlattenSvcParameters(ctx context.Context, from *dns.RecordSvcbSvcParameters, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(SvcParametersAttrTypes)
+	}
+	m := SvcParametersModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, SvcParametersAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func FlattenHttpsSvcParameters(ctx context.Context, from *dns.RecordHttpsSvcParameters, diags *diag.Diagnostics) types.Object {
+	return FlattenSvcParameters(ctx, (*dns.RecordSvcbSvcParameters)(from), diags)
+}
+
+func (m *SvcParametersModel) Flatten(ctx context.Context, from *dns.RecordSvcbSvcParameters, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = SvcParametersModel{}
+	}
+	m.Mandatory = types.BoolPointerValue(from.Mandatory)
+	m.SvcKey = flex.FlattenString(from.SvcKey)
+	m.SvcValue = flex.FlattenFrameworkListString(ctx, from.SvcValue, diags)
+}
+
+// checkSvcParameters rejects the SVC parameters of an SVCB or HTTPS record that RFC 9460 does not allow: a record
+// with the priority 0 is in AliasMode and has no parameter, a key appears once, no-default-alpn needs alpn, and the
+// values of the keys the RFC defines have their format.
+func checkSvcParameters(ctx context.Context, priority types.Int32, svcParameters types.List, diags *diag.Diagnostics) {
+	if priority.IsUnknown() || svcParameters.IsNull() || svcParameters.IsUnknown() {
+		return
+	}
+	var params []SvcParametersModel
+	diags.Append(svcParameters.ElementsAs(ctx, &params, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	p := path.Root("svc_parameters")
+	if priority.ValueInt32() == 0 && len(params) > 0 {
+		diags.AddAttributeError(p, "Invalid SVC parameters",
+			"A record with the priority 0 is in AliasMode and cannot have SVC parameters.")
+		return
+	}
+	keys := map[string]bool{}
+	for i, param := range params {
+		if param.SvcKey.IsUnknown() || param.SvcValue.IsUnknown() {
+			continue
+		}
+		key := param.SvcKey.ValueString()
+		if keys[key] {
+			diags.AddAttributeError(p.AtListIndex(i).AtName("svc_key"), "Duplicate SVC parameter",
+				fmt.Sprintf("The SVC parameter %s is set more than once.", key))
+		}
+		keys[key] = true
+
+		var values []string
+		diags.Append(param.SvcValue.ElementsAs(ctx, &values, false)...)
+		if err := checkSvcParameterValues(key, values); err != nil {
+			diags.AddAttributeError(p.AtListIndex(i).AtName("svc_value"), "Invalid SVC parameter value",
+				fmt.Sprintf("The values of the SVC parameter %s %s.", key, err))
+		}
+	}
+	if keys["no-default-alpn"] && !keys["alpn"] {
+		diags.AddAttributeError(p, "Invalid SVC parameters",
+			"The SVC parameter no-default-alpn requires the alpn parameter.")
+	}
+}
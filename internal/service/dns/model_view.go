@@ -0,0 +1,299 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ViewModel struct {
+	Ref                     types.String `tfsdk:"ref"`
+	Comment                 types.String `tfsdk:"comment"`
+	Disable                 types.Bool   `tfsdk:"disable"`
+	Dns64Enabled            types.Bool   `tfsdk:"dns64_enabled"`
+	Dns64Groups             types.List   `tfsdk:"dns64_groups"`
+	Extattrs                types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll             types.Map    `tfsdk:"extattrs_all"`
+	ForceDelete             types.Bool   `tfsdk:"force_delete"`
+	ForwardOnly             types.Bool   `tfsdk:"forward_only"`
+	Forwarders              types.List   `tfsdk:"forwarders"`
+	IsDefault               types.Bool   `tfsdk:"is_default"`
+	MatchClients            types.List   `tfsdk:"match_clients"`
+	MatchDestinations       types.List   `tfsdk:"match_destinations"`
+	Name                    types.String `tfsdk:"name"`
+	NetworkView             types.String `tfsdk:"network_view"`
+	Recursion               types.Bool   `tfsdk:"recursion"`
+	ResponseRateLimiting    types.Object `tfsdk:"response_rate_limiting"`
+	UseDns64                types.Bool   `tfsdk:"use_dns64"`
+	UseForwarders           types.Bool   `tfsdk:"use_forwarders"`
+	UseRecursion            types.Bool   `tfsdk:"use_recursion"`
+	UseResponseRateLimiting types.Bool   `tfsdk:"use_response_rate_limiting"`
+}
+
+var ViewAttrTypes = map[string]attr.Type{
+	"ref":                        types.StringType,
+	"comment":                    types.StringType,
+	"disable":                    types.BoolType,
+	"dns64_enabled":              types.BoolType,
+	"dns64_groups":               types.ListType{ElemType: types.StringType},
+	"extattrs":                   types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":               types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"force_delete":               types.BoolType,
+	"forward_only":               types.BoolType,
+	"forwarders":                 types.ListType{ElemType: types.StringType},
+	"is_default":                 types.BoolType,
+	"match_clients":              types.ListType{ElemType: types.ObjectType{AttrTypes: AddressACAttrTypes}},
+	"match_destinations":         types.ListType{ElemType: types.ObjectType{AttrTypes: AddressACAttrTypes}},
+	"name":                       types.StringType,
+	"network_view":               types.StringType,
+	"recursion":                  types.BoolType,
+	"response_rate_limiting":     types.ObjectType{AttrTypes: ViewResponseRateLimitingAttrTypes},
+	"use_dns64":                  types.BoolType,
+	"use_forwarders":             types.BoolType,
+	"use_recursion":              types.BoolType,
+	"use_response_rate_limiting": types.BoolType,
+}
+
+var ViewResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtMost(64),
+		},
+		MarkdownDescription: "Comment for the DNS view; maximum 64 characters.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the DNS view is disabled or not. False means that the DNS view is enabled.",
+	},
+	"dns64_enabled": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("use_dns64")),
+		},
+		MarkdownDescription: "Determines if the AAAA records are synthesized from the A records for the IPv6 only clients, when use_dns64 is true.",
+	},
+	"dns64_groups": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.AlsoRequires(path.MatchRoot("use_dns64")),
+		},
+		MarkdownDescription: "The DNS64 synthesis groups of the DNS view, which override the ones of the grid when use_dns64 is true.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"force_delete": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Set this to true to delete the DNS view with the zones it contains. Otherwise the DNS view is only deleted when it has no zones. WAPI does not have it, it is kept as configured.",
+	},
+	"forward_only": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("use_forwarders")),
+		},
+		MarkdownDescription: "Determines if the queries the DNS view cannot answer are only sent to the forwarders, and not to the root servers, when use_forwarders is true.",
+	},
+	"forwarders": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(stringvalidator.Any(ipv4AddressValidator{}, ipv6AddressValidator{})),
+			listvalidator.AlsoRequires(path.MatchRoot("use_forwarders")),
+		},
+		MarkdownDescription: "The IPv4 or IPv6 addresses of the forwarders of the DNS view, which override the ones of the grid when use_forwarders is true. The IPv6 addresses are in their canonical form, such as `2001:db8::1`.",
+	},
+	"is_default": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the DNS view is the default DNS view of the grid.",
+	},
+	"match_clients": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: AddressACResourceSchemaAttributes,
+		},
+		Optional:            true,
+		MarkdownDescription: "The ACL of the clients the DNS view answers. The rules are matched in order, a DNS view without rules answers all the clients.",
+	},
+	"match_destinations": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: AddressACResourceSchemaAttributes,
+		},
+		Optional:            true,
+		MarkdownDescription: "The ACL of the destination addresses of the queries the DNS view answers. The rules are matched in order, a DNS view without rules answers the queries to all the addresses.",
+	},
+	"name": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		MarkdownDescription: "The name of the DNS view.",
+	},
+	"network_view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The network view of the DNS view. The DNS view is recreated when the network view changes.",
+	},
+	"recursion": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("use_recursion")),
+		},
+		MarkdownDescription: "Determines if the DNS view answers the recursive queries, when use_recursion is true.",
+	},
+	"response_rate_limiting": schema.SingleNestedAttribute{
+		Attributes:          ViewResponseRateLimitingResourceSchemaAttributes,
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The response rate limiting settings of the DNS view, which override the ones of the grid when use_response_rate_limiting is true.",
+	},
+	"use_dns64": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Flag to indicate whether the DNS64 settings of the DNS view override the ones of the grid.",
+	},
+	"use_forwarders": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Flag to indicate whether the forwarders and forward_only of the DNS view override the ones of the grid.",
+	},
+	"use_recursion": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Flag to indicate whether the recursion of the DNS view overrides the one of the grid.",
+	},
+	"use_response_rate_limiting": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Flag to indicate whether the response rate limiting settings of the DNS view override the ones of the grid.",
+	},
+}
+
+func (m *ViewModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.View {
+	if m == nil {
+		return nil
+	}
+	to := &dns.View{
+		Comment:                 flex.ExpandStringPointer(m.Comment),
+		Disable:                 flex.ExpandBoolPointer(m.Disable),
+		Dns64Enabled:            flex.ExpandBoolPointer(m.Dns64Enabled),
+		Dns64Groups:             flex.ExpandFrameworkListString(ctx, m.Dns64Groups, diags),
+		Extattrs:                flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		ForwardOnly:             flex.ExpandBoolPointer(m.ForwardOnly),
+		Forwarders:              flex.ExpandFrameworkListString(ctx, m.Forwarders, diags),
+		MatchClients:            flex.ExpandFrameworkListNestedBlock(ctx, m.MatchClients, diags, ExpandViewMatchClients),
+		MatchDestinations:       flex.ExpandFrameworkListNestedBlock(ctx, m.MatchDestinations, diags, ExpandViewMatchDestinations),
+		Name:                    flex.ExpandString(m.Name),
+		Recursion:               flex.ExpandBoolPointer(m.Recursion),
+		ResponseRateLimiting:    ExpandViewResponseRateLimiting(ctx, m.ResponseRateLimiting, diags),
+		UseDns64:                flex.ExpandBoolPointer(m.UseDns64),
+		UseForwarders:           flex.ExpandBoolPointer(m.UseForwarders),
+		UseRecursion:            flex.ExpandBoolPointer(m.UseRecursion),
+		UseResponseRateLimiting: flex.ExpandBoolPointer(m.UseResponseRateLimiting),
+	}
+	if isCreate {
+		to.NetworkView = flex.ExpandStringPointer(m.NetworkView)
+		return to
+	}
+	// WAPI keeps the lists that are not sent, removing them takes an empty list
+	if to.Dns64Groups == nil {
+		to.Dns64Groups = []string{}
+	}
+	if to.Forwarders == nil {
+		to.Forwarders = []string{}
+	}
+	if to.MatchClients == nil {
+		to.MatchClients = []dns.ViewMatchClients{}
+	}
+	if to.MatchDestinations == nil {
+		to.MatchDestinations = []dns.ViewMatchDestinations{}
+	}
+	return to
+}
+
+func FlattenView(ctx context.Context, from *dns.View, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ViewAttrTypes)
+	}
+	m := ViewModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ViewAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+// Flatten updates m with from. force_delete is not a field of WAPI, it is left as is.
+func (m *ViewModel) Flatten(ctx context.Context, from *dns.View, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ViewModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.Dns64Enabled = types.BoolPointerValue(from.Dns64Enabled)
+	m.Dns64Groups = flex.FlattenFrameworkListString(ctx, from.Dns64Groups, diags)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ForwardOnly = types.BoolPointerValue(from.ForwardOnly)
+	m.Forwarders = flex.FlattenFrameworkListString(ctx, from.Forwarders, diags)
+	m.IsDefault = types.BoolPointerValue(from.IsDefault)
+	m.MatchClients = flex.FlattenFrameworkListNestedBlock(ctx, from.MatchClients, AddressACAttrTypes, diags, FlattenViewMatchClients)
+	m.MatchDestinations = flex.FlattenFrameworkListNestedBlock(ctx, from.MatchDestinations, AddressACAttrTypes, diags, FlattenViewMatchDestinations)
+	m.Name = flex.FlattenString(from.Name)
+	m.NetworkView = flex.FlattenStringPointer(from.NetworkView)
+	m.Recursion = types.BoolPointerValue(from.Recursion)
+	m.ResponseRateLimiting = FlattenViewResponseRateLimiting(ctx, from.ResponseRateLimiting, diags)
+	m.UseDns64 = types.BoolPointerValue(from.UseDns64)
+	m.UseForwarders = types.BoolPointerValue(from.UseForwarders)
+	m.UseRecursion = types.BoolPointerValue(from.UseRecursion)
+	m.UseResponseRateLimiting = types.BoolPointerValue(from.UseResponseRateLimiting)
+}
@@ -0,0 +1,129 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ViewResponseRateLimitingModel struct {
+	EnableRrlLogging      types.Bool  `tfsdk:"enable_rrl_logging"`
+	EnableRrlRateLimiting types.Bool  `tfsdk:"enable_rrl_rate_limiting"`
+	LogOnly               types.Bool  `tfsdk:"log_only"`
+	ResponsesPerSecond    types.Int32 `tfsdk:"responses_per_second"`
+	Slip                  types.Int32 `tfsdk:"slip"`
+	Window                types.Int32 `tfsdk:"window"`
+}
+
+var ViewResponseRateLimitingAttrTypes = map[string]attr.Type{
+	"enable_rrl_logging":       types.BoolType,
+	"enable_rrl_rate_limiting": types.BoolType,
+	"log_only":                 types.BoolType,
+	"responses_per_second":     types.Int32Type,
+	"slip":                     types.Int32Type,
+	"window":                   types.Int32Type,
+}
+
+var ViewResponseRateLimitingResourceSchemaAttributes = map[string]schema.Attribute{
+	"enable_rrl_logging": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Determines if the responses dropped or truncated by the rate limiting are logged.",
+	},
+	"enable_rrl_rate_limiting": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Determines if the rate of the responses is limited.",
+	},
+	"log_only": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Set this to true to only log the responses that exceed the rate, without dropping them.",
+	},
+	"responses_per_second": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.Between(1, 1000),
+		},
+		MarkdownDescription: "The number of identical responses per second a client gets before they are limited.",
+	},
+	"slip": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.Between(0, 10),
+		},
+		MarkdownDescription: "One in every slip limited responses is sent truncated, the others are dropped. 0 drops all of them.",
+	},
+	"window": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.Between(1, 3600),
+		},
+		MarkdownDescription: "The interval in seconds over which the responses are counted.",
+	},
+}
+
+func ExpandViewResponseRateLimiting(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ViewResponseRateLimiting {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m ViewResponseRateLimitingModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *ViewResponseRateLimitingModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.ViewResponseRateLimiting {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ViewResponseRateLimiting{
+		EnableRrlLogging:      flex.ExpandBoolPointer(m.EnableRrlLogging),
+		EnableRrlRateLimiting: flex.ExpandBoolPointer(m.EnableRrlRateLimiting),
+		LogOnly:               flex.ExpandBoolPointer(m.LogOnly),
+		ResponsesPerSecond:    flex.ExpandInt32Pointer(m.ResponsesPerSecond),
+		Slip:                  flex.ExpandInt32Pointer(m.Slip),
+		Window:                flex.ExpandInt32Pointer(m.Window),
+	}
+	return to
+}
+
+func FlattenViewResponseRateLimiting(ctx context.Context, from *dns.ViewResponseRateLimiting, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ViewResponseRateLimitingAttrTypes)
+	}
+	m := ViewResponseRateLimitingModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ViewResponseRateLimitingAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ViewResponseRateLimitingModel) Flatten(ctx context.Context, from *dns.ViewResponseRateLimiting, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ViewResponseRateLimitingModel{}
+	}
+	m.EnableRrlLogging = types.BoolPointerValue(from.EnableRrlLogging)
+	m.EnableRrlRateLimiting = types.BoolPointerValue(from.EnableRrlRateLimiting)
+	m.LogOnly = types.BoolPointerValue(from.LogOnly)
+	m.ResponsesPerSecond = types.Int32PointerValue(from.ResponsesPerSecond)
+	m.Slip = types.Int32PointerValue(from.Slip)
+	m.Window = types.Int32PointerValue(from.Window)
+}
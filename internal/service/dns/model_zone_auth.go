@@ -0,0 +1,399 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ZoneAuthModel struct {
+	Ref              types.String `tfsdk:"ref"`
+	AllowTransfer    types.List   `tfsdk:"allow_transfer"`
+	AllowUpdate      types.List   `tfsdk:"allow_update"`
+	Comment          types.String `tfsdk:"comment"`
+	Disable          types.Bool   `tfsdk:"disable"`
+	DisplayDomain    types.String `tfsdk:"display_domain"`
+	DnsFqdn          types.String `tfsdk:"dns_fqdn"`
+	Extattrs         types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll      types.Map    `tfsdk:"extattrs_all"`
+	Fqdn             types.String `tfsdk:"fqdn"`
+	GridPrimary      types.List   `tfsdk:"grid_primary"`
+	GridSecondaries  types.List   `tfsdk:"grid_secondaries"`
+	NsGroup          types.String `tfsdk:"ns_group"`
+	Prefix           types.String `tfsdk:"prefix"`
+	PrimaryType      types.String `tfsdk:"primary_type"`
+	RestartIfNeeded  types.Bool   `tfsdk:"restart_if_needed"`
+	SoaDefaultTtl    types.Int32  `tfsdk:"soa_default_ttl"`
+	SoaEmail         types.String `tfsdk:"soa_email"`
+	SoaExpire        types.Int32  `tfsdk:"soa_expire"`
+	SoaNegativeTtl   types.Int32  `tfsdk:"soa_negative_ttl"`
+	SoaRefresh       types.Int32  `tfsdk:"soa_refresh"`
+	SoaRetry         types.Int32  `tfsdk:"soa_retry"`
+	SoaSerialNumber  types.Int64  `tfsdk:"soa_serial_number"`
+	UseAllowTransfer types.Bool   `tfsdk:"use_allow_transfer"`
+	UseAllowUpdate   types.Bool   `tfsdk:"use_allow_update"`
+	UseGridZoneTimer types.Bool   `tfsdk:"use_grid_zone_timer"`
+	UseSoaEmail      types.Bool   `tfsdk:"use_soa_email"`
+	View             types.String `tfsdk:"view"`
+	ZoneFormat       types.String `tfsdk:"zone_format"`
+}
+
+var ZoneAuthAttrTypes = map[string]attr.Type{
+	"ref":                 types.StringType,
+	"allow_transfer":      types.ListType{ElemType: types.ObjectType{AttrTypes: AddressACAttrTypes}},
+	"allow_update":        types.ListType{ElemType: types.ObjectType{AttrTypes: AddressACAttrTypes}},
+	"comment":             types.StringType,
+	"disable":             types.BoolType,
+	"display_domain":      types.StringType,
+	"dns_fqdn":            types.StringType,
+	"extattrs":            types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":        types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"fqdn":                types.StringType,
+	"grid_primary":        types.ListType{ElemType: types.ObjectType{AttrTypes: MemberServerAttrTypes}},
+	"grid_secondaries":    types.ListType{ElemType: types.ObjectType{AttrTypes: MemberServerAttrTypes}},
+	"ns_group":            types.StringType,
+	"prefix":              types.StringType,
+	"primary_type":        types.StringType,
+	"restart_if_needed":   types.BoolType,
+	"soa_default_ttl":     types.Int32Type,
+	"soa_email":           types.StringType,
+	"soa_expire":          types.Int32Type,
+	"soa_negative_ttl":    types.Int32Type,
+	"soa_refresh":         types.Int32Type,
+	"soa_retry":           types.Int32Type,
+	"soa_serial_number":   types.Int64Type,
+	"use_allow_transfer":  types.BoolType,
+	"use_allow_update":    types.BoolType,
+	"use_grid_zone_timer": types.BoolType,
+	"use_soa_email":       types.BoolType,
+	"view":                types.StringType,
+	"zone_format":         types.StringType,
+}
+
+var ZoneAuthResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"allow_transfer": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: AddressACResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.AlsoRequires(path.MatchRoot("use_allow_transfer")),
+		},
+		MarkdownDescription: "The ACL of the zone transfers, which overrides the one of the grid when use_allow_transfer is true. The rules are matched in order.",
+	},
+	"allow_update": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: AddressACResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.AlsoRequires(path.MatchRoot("use_allow_update")),
+		},
+		MarkdownDescription: "The ACL of the dynamic DNS updates, which overrides the one of the grid when use_allow_update is true. The rules are matched in order.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the zone; maximum 256 characters.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the zone is disabled or not. False means that the zone is enabled.",
+	},
+	"display_domain": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The displayed name of the zone: its domain name, such as `2.0.192.in-addr.arpa` for a reverse zone.",
+	},
+	"dns_fqdn": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"fqdn": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the zone in FQDN format for a forward zone, or its network in CIDR notation for a reverse zone, such as `192.0.2.0/24`. The zone is recreated when the name changes.",
+	},
+	"grid_primary": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: MemberServerResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.ConflictsWith(path.MatchRoot("ns_group")),
+		},
+		MarkdownDescription: "The grid members that are the primary servers of the zone. Cannot be set with ns_group.",
+	},
+	"grid_secondaries": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: MemberServerResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.ConflictsWith(path.MatchRoot("ns_group")),
+		},
+		MarkdownDescription: "The grid members that are the secondary servers of the zone. Cannot be set with ns_group.",
+	},
+	"ns_group": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The name server group that serves the zone, instead of the grid primary and secondary servers.",
+	},
+	"prefix": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The RFC 2317 prefix of a classless reverse zone, for an IPv4 network longer than /24, such as `128/26`.",
+	},
+	"primary_type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The type of the primary server of the zone, such as `Grid` or `None`.",
+	},
+	"restart_if_needed": schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Set this to true to restart the DNS service of the members serving the zone when the change requires it. WAPI does not return it, it is kept as configured.",
+	},
+	"soa_default_ttl": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+			int32validator.AlsoRequires(path.MatchRoot("use_grid_zone_timer")),
+		},
+		MarkdownDescription: "The default TTL of the records of the zone, in seconds. It is also the TTL of the SOA record.",
+	},
+	"soa_email": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("use_soa_email")),
+		},
+		MarkdownDescription: "The email address of the administrator of the zone, in the SOA record, such as `hostmaster@example.com`.",
+	},
+	"soa_expire": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+			int32validator.AlsoRequires(path.MatchRoot("use_grid_zone_timer")),
+		},
+		MarkdownDescription: "The time, in seconds, after which a secondary server stops answering for the zone when it cannot reach the primary server.",
+	},
+	"soa_negative_ttl": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+			int32validator.AlsoRequires(path.MatchRoot("use_grid_zone_timer")),
+		},
+		MarkdownDescription: "The time, in seconds, the negative answers for the zone are cached.",
+	},
+	"soa_refresh": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+			int32validator.AlsoRequires(path.MatchRoot("use_grid_zone_timer")),
+		},
+		MarkdownDescription: "The interval, in seconds, at which a secondary server checks the serial number of the zone on the primary server.",
+	},
+	"soa_retry": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+			int32validator.AlsoRequires(path.MatchRoot("use_grid_zone_timer")),
+		},
+		MarkdownDescription: "The time, in seconds, a secondary server waits before it retries to reach the primary server after a failure.",
+	},
+	"soa_serial_number": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The serial number of the SOA record of the zone, which increases with every change of the zone.",
+	},
+	"use_allow_transfer": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Flag to indicate whether the allow_transfer ACL of the zone overrides the one of the grid.",
+	},
+	"use_allow_update": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Flag to indicate whether the allow_update ACL of the zone overrides the one of the grid.",
+	},
+	"use_grid_zone_timer": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("soa_refresh")),
+		},
+		MarkdownDescription: "Flag to indicate whether the SOA timers of the zone override the ones of the grid: soa_default_ttl, soa_expire, soa_negative_ttl, soa_refresh and soa_retry.",
+	},
+	"use_soa_email": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("soa_email")),
+		},
+		MarkdownDescription: "Flag to indicate whether the soa_email of the zone overrides the one of the grid.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the zone. The zone is recreated when the view changes.",
+	},
+	"zone_format": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("FORWARD"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("FORWARD", "IPV4", "IPV6"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The format of the zone: `FORWARD`, or `IPV4` and `IPV6` for the reverse zones. The zone is recreated when the format changes.",
+	},
+}
+
+func (m *ZoneAuthModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.ZoneAuth {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneAuth{
+		AllowTransfer:    flex.ExpandFrameworkListNestedBlock(ctx, m.AllowTransfer, diags, ExpandAddressAC),
+		AllowUpdate:      flex.ExpandFrameworkListNestedBlock(ctx, m.AllowUpdate, diags, ExpandZoneAuthAllowUpdate),
+		Comment:          flex.ExpandStringPointer(m.Comment),
+		Disable:          flex.ExpandBoolPointer(m.Disable),
+		Extattrs:         flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Fqdn:             flex.ExpandString(m.Fqdn),
+		GridPrimary:      flex.ExpandFrameworkListNestedBlock(ctx, m.GridPrimary, diags, ExpandMemberServer),
+		GridSecondaries:  flex.ExpandFrameworkListNestedBlock(ctx, m.GridSecondaries, diags, ExpandZoneAuthGridSecondaries),
+		NsGroup:          flex.ExpandStringPointer(m.NsGroup),
+		Prefix:           flex.ExpandStringPointer(m.Prefix),
+		RestartIfNeeded:  flex.ExpandBoolPointer(m.RestartIfNeeded),
+		SoaDefaultTtl:    flex.ExpandInt32Pointer(m.SoaDefaultTtl),
+		SoaEmail:         flex.ExpandStringPointer(m.SoaEmail),
+		SoaExpire:        flex.ExpandInt32Pointer(m.SoaExpire),
+		SoaNegativeTtl:   flex.ExpandInt32Pointer(m.SoaNegativeTtl),
+		SoaRefresh:       flex.ExpandInt32Pointer(m.SoaRefresh),
+		SoaRetry:         flex.ExpandInt32Pointer(m.SoaRetry),
+		UseAllowTransfer: flex.ExpandBoolPointer(m.UseAllowTransfer),
+		UseAllowUpdate:   flex.ExpandBoolPointer(m.UseAllowUpdate),
+		UseGridZoneTimer: flex.ExpandBoolPointer(m.UseGridZoneTimer),
+		UseSoaEmail:      flex.ExpandBoolPointer(m.UseSoaEmail),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+		to.ZoneFormat = flex.ExpandStringPointer(m.ZoneFormat)
+		return to
+	}
+	// WAPI keeps the lists that are not sent, removing them takes an empty list. The servers of a zone served by a
+	// name server group come from the group.
+	if to.AllowTransfer == nil {
+		to.AllowTransfer = []dns.ZoneAuthAllowTransfer{}
+	}
+	if to.AllowUpdate == nil {
+		to.AllowUpdate = []dns.ZoneAuthAllowUpdate{}
+	}
+	if to.NsGroup == nil {
+		if to.GridPrimary == nil {
+			to.GridPrimary = []dns.ZoneAuthGridPrimary{}
+		}
+		if to.GridSecondaries == nil {
+			to.GridSecondaries = []dns.ZoneAuthGridSecondaries{}
+		}
+	}
+	return to
+}
+
+func FlattenZoneAuth(ctx context.Context, from *dns.ZoneAuth, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ZoneAuthAttrTypes)
+	}
+	m := ZoneAuthModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ZoneAuthAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+// Flatten updates m with from. restart_if_needed is not returned by WAPI, it is left as is.
+func (m *ZoneAuthModel) Flatten(ctx context.Context, from *dns.ZoneAuth, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ZoneAuthModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.AllowTransfer = flex.FlattenFrameworkListNestedBlock(ctx, from.AllowTransfer, AddressACAttrTypes, diags, FlattenAddressAC)
+	m.AllowUpdate = flex.FlattenFrameworkListNestedBlock(ctx, from.AllowUpdate, AddressACAttrTypes, diags, FlattenZoneAuthAllowUpdate)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DisplayDomain = flex.FlattenStringPointer(from.DisplayDomain)
+	m.DnsFqdn = flex.FlattenStringPointer(from.DnsFqdn)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.Fqdn = flex.FlattenString(from.Fqdn)
+	m.GridPrimary = flex.FlattenFrameworkListNestedBlock(ctx, from.GridPrimary, MemberServerAttrTypes, diags, FlattenMemberServer)
+	m.GridSecondaries = flex.FlattenFrameworkListNestedBlock(ctx, from.GridSecondaries, MemberServerAttrTypes, diags, FlattenZoneAuthGridSecondaries)
+	m.NsGroup = flex.FlattenStringPointer(from.NsGroup)
+	m.Prefix = flex.FlattenStringPointer(from.Prefix)
+	m.PrimaryType = flex.FlattenStringPointer(from.PrimaryType)
+	m.SoaDefaultTtl = types.Int32PointerValue(from.SoaDefaultTtl)
+	m.SoaEmail = flex.FlattenStringPointer(from.SoaEmail)
+	m.SoaExpire = types.Int32PointerValue(from.SoaExpire)
+	m.SoaNegativeTtl = types.Int32PointerValue(from.SoaNegativeTtl)
+	m.SoaRefresh = types.Int32PointerValue(from.SoaRefresh)
+	m.SoaRetry = types.Int32PointerValue(from.SoaRetry)
+	m.SoaSerialNumber = flex.FlattenInt64Pointer(from.SoaSerialNumber)
+	m.UseAllowTransfer = types.BoolPointerValue(from.UseAllowTransfer)
+	m.UseAllowUpdate = types.BoolPointerValue(from.UseAllowUpdate)
+	m.UseGridZoneTimer = types.BoolPointerValue(from.UseGridZoneTimer)
+	m.UseSoaEmail = types.BoolPointerValue(from.UseSoaEmail)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.ZoneFormat = flex.FlattenStringPointer(from.ZoneFormat)
+}
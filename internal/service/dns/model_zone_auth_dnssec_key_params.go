@@ -0,0 +1,233 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ZoneAuthDnssecKeyParamsModel struct {
+	EnableKskAutoRollover         types.Bool   `tfsdk:"enable_ksk_auto_rollover"`
+	KskAlgorithms                 types.List   `tfsdk:"ksk_algorithms"`
+	KskEmailNotificationEnabled   types.Bool   `tfsdk:"ksk_email_notification_enabled"`
+	KskRollover                   types.Int32  `tfsdk:"ksk_rollover"`
+	KskRolloverNotificationConfig types.String `tfsdk:"ksk_rollover_notification_config"`
+	KskSnmpNotificationEnabled    types.Bool   `tfsdk:"ksk_snmp_notification_enabled"`
+	NextSecureType                types.String `tfsdk:"next_secure_type"`
+	Nsec3Iterations               types.Int32  `tfsdk:"nsec3_iterations"`
+	Nsec3SaltMaxLength            types.Int32  `tfsdk:"nsec3_salt_max_length"`
+	Nsec3SaltMinLength            types.Int32  `tfsdk:"nsec3_salt_min_length"`
+	SignatureExpiration           types.Int32  `tfsdk:"signature_expiration"`
+	ZskAlgorithms                 types.List   `tfsdk:"zsk_algorithms"`
+	ZskRollover                   types.Int32  `tfsdk:"zsk_rollover"`
+	ZskRolloverMechanism          types.String `tfsdk:"zsk_rollover_mechanism"`
+}
+
+var ZoneAuthDnssecKeyParamsAttrTypes = map[string]attr.Type{
+	"enable_ksk_auto_rollover":         types.BoolType,
+	"ksk_algorithms":                   types.ListType{ElemType: types.ObjectType{AttrTypes: DnssecKeyAlgorithmAttrTypes}},
+	"ksk_email_notification_enabled":   types.BoolType,
+	"ksk_rollover":                     types.Int32Type,
+	"ksk_rollover_notification_config": types.StringType,
+	"ksk_snmp_notification_enabled":    types.BoolType,
+	"next_secure_type":                 types.StringType,
+	"nsec3_iterations":                 types.Int32Type,
+	"nsec3_salt_max_length":            types.Int32Type,
+	"nsec3_salt_min_length":            types.Int32Type,
+	"signature_expiration":             types.Int32Type,
+	"zsk_algorithms":                   types.ListType{ElemType: types.ObjectType{AttrTypes: DnssecKeyAlgorithmAttrTypes}},
+	"zsk_rollover":                     types.Int32Type,
+	"zsk_rollover_mechanism":           types.StringType,
+}
+
+var ZoneAuthDnssecKeyParamsResourceSchemaAttributes = map[string]schema.Attribute{
+	"enable_ksk_auto_rollover": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Determines if the key-signing keys are rolled over automatically. When false, the rollover waits for a KSK rollover operation.",
+	},
+	"ksk_algorithms": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: DnssecKeyAlgorithmResourceSchemaAttributes,
+		},
+		Optional: true,
+		Computed: true,
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 2),
+		},
+		MarkdownDescription: "The algorithms of the key-signing keys (KSK). A second algorithm signs the zone with both.",
+	},
+	"ksk_email_notification_enabled": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Determines if the KSK related events are notified by email.",
+	},
+	"ksk_rollover": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(1),
+		},
+		MarkdownDescription: "The rollover interval of the key-signing keys, in seconds.",
+	},
+	"ksk_rollover_notification_config": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("ALL", "NONE", "REQUIRE_MANUAL_INTERVENTION"),
+		},
+		MarkdownDescription: "The KSK rollover events that are notified: `ALL`, `NONE`, or only the ones that `REQUIRE_MANUAL_INTERVENTION`.",
+	},
+	"ksk_snmp_notification_enabled": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Determines if the KSK related events are notified by SNMP traps.",
+	},
+	"next_secure_type": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("NSEC", "NSEC3"),
+		},
+		MarkdownDescription: "The type of the records proving the non-existence of names, `NSEC` or `NSEC3`.",
+	},
+	"nsec3_iterations": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+		},
+		MarkdownDescription: "The number of additional hashing iterations of the NSEC3 records.",
+	},
+	"nsec3_salt_max_length": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.Between(0, 255),
+		},
+		MarkdownDescription: "The maximum length of the NSEC3 salts, in bytes.",
+	},
+	"nsec3_salt_min_length": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.Between(0, 255),
+		},
+		MarkdownDescription: "The minimum length of the NSEC3 salts, in bytes.",
+	},
+	"signature_expiration": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(1),
+		},
+		MarkdownDescription: "The validity period of the signatures, in seconds.",
+	},
+	"zsk_algorithms": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: DnssecKeyAlgorithmResourceSchemaAttributes,
+		},
+		Optional: true,
+		Computed: true,
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 2),
+		},
+		MarkdownDescription: "The algorithms of the zone-signing keys (ZSK).",
+	},
+	"zsk_rollover": schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(1),
+		},
+		MarkdownDescription: "The rollover interval of the zone-signing keys, in seconds.",
+	},
+	"zsk_rollover_mechanism": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("PRE_PUBLISH", "DOUBLE_SIGN"),
+		},
+		MarkdownDescription: "The ZSK rollover mechanism, `PRE_PUBLISH` the new key or `DOUBLE_SIGN` the zone.",
+	},
+}
+
+func ExpandZoneAuthDnssecKeyParams(ctx context.Context, o types.Object, diags *diag.Diagnostics) *dns.ZoneAuthDnssecKeyParams {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	var m ZoneAuthDnssecKeyParamsModel
+	diags.Append(o.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+	return m.Expand(ctx, diags)
+}
+
+func (m *ZoneAuthDnssecKeyParamsModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.ZoneAuthDnssecKeyParams {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneAuthDnssecKeyParams{
+		EnableKskAutoRollover:         flex.ExpandBoolPointer(m.EnableKskAutoRollover),
+		KskAlgorithms:                 flex.ExpandFrameworkListNestedBlock(ctx, m.KskAlgorithms, diags, ExpandDnssecKeyAlgorithm),
+		KskEmailNotificationEnabled:   flex.ExpandBoolPointer(m.KskEmailNotificationEnabled),
+		KskRollover:                   flex.ExpandInt32Pointer(m.KskRollover),
+		KskRolloverNotificationConfig: flex.ExpandStringPointer(m.KskRolloverNotificationConfig),
+		KskSnmpNotificationEnabled:    flex.ExpandBoolPointer(m.KskSnmpNotificationEnabled),
+		NextSecureType:                flex.ExpandStringPointer(m.NextSecureType),
+		Nsec3Iterations:               flex.ExpandInt32Pointer(m.Nsec3Iterations),
+		Nsec3SaltMaxLength:            flex.ExpandInt32Pointer(m.Nsec3SaltMaxLength),
+		Nsec3SaltMinLength:            flex.ExpandInt32Pointer(m.Nsec3SaltMinLength),
+		SignatureExpiration:           flex.ExpandInt32Pointer(m.SignatureExpiration),
+		ZskAlgorithms:                 flex.ExpandFrameworkListNestedBlock(ctx, m.ZskAlgorithms, diags, ExpandZoneauthdnsseckeyparamsZskAlgorithms),
+		ZskRollover:                   flex.ExpandInt32Pointer(m.ZskRollover),
+		ZskRolloverMechanism:          flex.ExpandStringPointer(m.ZskRolloverMechanism),
+	}
+	return to
+}
+
+func FlattenZoneAuthDnssecKeyParams(ctx context.Context, from *dns.ZoneAuthDnssecKeyParams, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ZoneAuthDnssecKeyParamsAttrTypes)
+	}
+	m := ZoneAuthDnssecKeyParamsModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ZoneAuthDnssecKeyParamsAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ZoneAuthDnssecKeyParamsModel) Flatten(ctx context.Context, from *dns.ZoneAuthDnssecKeyParams, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ZoneAuthDnssecKeyParamsModel{}
+	}
+	m.EnableKskAutoRollover = types.BoolPointerValue(from.EnableKskAutoRollover)
+	m.KskAlgorithms = flex.FlattenFrameworkListNestedBlock(ctx, from.KskAlgorithms, DnssecKeyAlgorithmAttrTypes, diags, FlattenDnssecKeyAlgorithm)
+	m.KskEmailNotificationEnabled = types.BoolPointerValue(from.KskEmailNotificationEnabled)
+	m.KskRollover = types.Int32PointerValue(from.KskRollover)
+	m.KskRolloverNotificationConfig = flex.FlattenStringPointer(from.KskRolloverNotificationConfig)
+	m.KskSnmpNotificationEnabled = types.BoolPointerValue(from.KskSnmpNotificationEnabled)
+	m.NextSecureType = flex.FlattenStringPointer(from.NextSecureType)
+	m.Nsec3Iterations = types.Int32PointerValue(from.Nsec3Iterations)
+	m.Nsec3SaltMaxLength = types.Int32PointerValue(from.Nsec3SaltMaxLength)
+	m.Nsec3SaltMinLength = types.Int32PointerValue(from.Nsec3SaltMinLength)
+	m.SignatureExpiration = types.Int32PointerValue(from.SignatureExpiration)
+	m.ZskAlgorithms = flex.FlattenFrameworkListNestedBlock(ctx, from.ZskAlgorithms, DnssecKeyAlgorithmAttrTypes, diags, FlattenZoneauthdnsseckeyparamsZskAlgorithms)
+	m.ZskRollover = types.Int32PointerValue(from.ZskRollover)
+	m.ZskRolloverMechanism = flex.FlattenStringPointer(from.ZskRolloverMechanism)
+}
@@ -0,0 +1,212 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ZoneDelegatedModel struct {
+	Ref             types.String `tfsdk:"ref"`
+	Comment         types.String `tfsdk:"comment"`
+	DelegateTo      types.List   `tfsdk:"delegate_to"`
+	DelegatedTtl    types.Int32  `tfsdk:"delegated_ttl"`
+	Disable         types.Bool   `tfsdk:"disable"`
+	DisplayDomain   types.String `tfsdk:"display_domain"`
+	DnsFqdn         types.String `tfsdk:"dns_fqdn"`
+	Extattrs        types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll     types.Map    `tfsdk:"extattrs_all"`
+	Fqdn            types.String `tfsdk:"fqdn"`
+	NsGroup         types.String `tfsdk:"ns_group"`
+	UseDelegatedTtl types.Bool   `tfsdk:"use_delegated_ttl"`
+	View            types.String `tfsdk:"view"`
+	ZoneFormat      types.String `tfsdk:"zone_format"`
+}
+
+var ZoneDelegatedAttrTypes = map[string]attr.Type{
+	"ref":               types.StringType,
+	"comment":           types.StringType,
+	"delegate_to":       types.ListType{ElemType: types.ObjectType{AttrTypes: ExtServerAttrTypes}},
+	"delegated_ttl":     types.Int32Type,
+	"disable":           types.BoolType,
+	"display_domain":    types.StringType,
+	"dns_fqdn":          types.StringType,
+	"extattrs":          types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":      types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"fqdn":              types.StringType,
+	"ns_group":          types.StringType,
+	"use_delegated_ttl": types.BoolType,
+	"view":              types.StringType,
+	"zone_format":       types.StringType,
+}
+
+var ZoneDelegatedResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the zone; maximum 256 characters.",
+	},
+	"delegate_to": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ExtServerResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ExactlyOneOf(path.MatchRoot("ns_group")),
+		},
+		MarkdownDescription: "The name servers the zone is delegated to. Exactly one of delegate_to and ns_group is set.",
+	},
+	"delegated_ttl": schema.Int32Attribute{
+		Optional: true,
+		Validators: []validator.Int32{
+			int32validator.AtLeast(0),
+			int32validator.AlsoRequires(path.MatchRoot("use_delegated_ttl")),
+		},
+		MarkdownDescription: "The TTL of the NS and glue records of the delegation, in seconds.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the zone is disabled or not. False means that the zone is enabled.",
+	},
+	"display_domain": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The displayed name of the zone: its domain name, such as `2.0.192.in-addr.arpa` for a reverse zone.",
+	},
+	"dns_fqdn": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"fqdn": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the zone in FQDN format for a forward zone, or its network in CIDR notation for a reverse zone, such as `192.0.2.0/24`. The zone is recreated when the name changes.",
+	},
+	"ns_group": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The delegation name server group whose name servers the zone is delegated to, instead of delegate_to.",
+	},
+	"use_delegated_ttl": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("delegated_ttl")),
+		},
+		MarkdownDescription: "Flag to indicate whether the delegated_ttl value should be used for the NS and glue records of the delegation.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the zone. The zone is recreated when the view changes.",
+	},
+	"zone_format": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("FORWARD"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("FORWARD", "IPV4", "IPV6"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The format of the zone: `FORWARD`, or `IPV4` and `IPV6` for the reverse zones. The zone is recreated when the format changes.",
+	},
+}
+
+func (m *ZoneDelegatedModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.ZoneDelegated {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneDelegated{
+		Comment:         flex.ExpandStringPointer(m.Comment),
+		DelegateTo:      flex.ExpandFrameworkListNestedBlock(ctx, m.DelegateTo, diags, ExpandZoneDelegatedDelegateTo),
+		DelegatedTtl:    flex.ExpandInt32Pointer(m.DelegatedTtl),
+		Disable:         flex.ExpandBoolPointer(m.Disable),
+		Extattrs:        flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Fqdn:            flex.ExpandString(m.Fqdn),
+		NsGroup:         flex.ExpandStringPointer(m.NsGroup),
+		UseDelegatedTtl: flex.ExpandBoolPointer(m.UseDelegatedTtl),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+		to.ZoneFormat = flex.ExpandStringPointer(m.ZoneFormat)
+	}
+	return to
+}
+
+func FlattenZoneDelegated(ctx context.Context, from *dns.ZoneDelegated, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ZoneDelegatedAttrTypes)
+	}
+	m := ZoneDelegatedModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ZoneDelegatedAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ZoneDelegatedModel) Flatten(ctx context.Context, from *dns.ZoneDelegated, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ZoneDelegatedModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.DelegateTo = flex.FlattenFrameworkListNestedBlock(ctx, from.DelegateTo, ExtServerAttrTypes, diags, FlattenZoneDelegatedDelegateTo)
+	m.DelegatedTtl = types.Int32PointerValue(from.DelegatedTtl)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DisplayDomain = flex.FlattenStringPointer(from.DisplayDomain)
+	m.DnsFqdn = flex.FlattenStringPointer(from.DnsFqdn)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.Fqdn = flex.FlattenString(from.Fqdn)
+	m.NsGroup = flex.FlattenStringPointer(from.NsGroup)
+	m.UseDelegatedTtl = types.BoolPointerValue(from.UseDelegatedTtl)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.ZoneFormat = flex.FlattenStringPointer(from.ZoneFormat)
+}
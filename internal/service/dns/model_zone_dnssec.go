@@ -0,0 +1,119 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+// ZoneDnssecModel holds the DNSSEC settings of an authoritative zone, which are fields of the zone_auth object
+// managed apart from the zone.
+type ZoneDnssecModel struct {
+	Ref                   types.String `tfsdk:"ref"`
+	DnssecKeyParams       types.Object `tfsdk:"dnssec_key_params"`
+	DnssecKskRolloverDate types.Int64  `tfsdk:"dnssec_ksk_rollover_date"`
+	DnssecZskRolloverDate types.Int64  `tfsdk:"dnssec_zsk_rollover_date"`
+	Fqdn                  types.String `tfsdk:"fqdn"`
+	IsDnssecEnabled       types.Bool   `tfsdk:"is_dnssec_enabled"`
+	IsDnssecSigned        types.Bool   `tfsdk:"is_dnssec_signed"`
+	View                  types.String `tfsdk:"view"`
+}
+
+var ZoneDnssecAttrTypes = map[string]attr.Type{
+	"ref":                      types.StringType,
+	"dnssec_key_params":        types.ObjectType{AttrTypes: ZoneAuthDnssecKeyParamsAttrTypes},
+	"dnssec_ksk_rollover_date": types.Int64Type,
+	"dnssec_zsk_rollover_date": types.Int64Type,
+	"fqdn":                     types.StringType,
+	"is_dnssec_enabled":        types.BoolType,
+	"is_dnssec_signed":         types.BoolType,
+	"view":                     types.StringType,
+}
+
+var ZoneDnssecResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the authoritative zone.",
+	},
+	"dnssec_key_params": schema.SingleNestedAttribute{
+		Attributes:          ZoneAuthDnssecKeyParamsResourceSchemaAttributes,
+		Required:            true,
+		MarkdownDescription: "The DNSSEC key parameters of the zone, which override the ones of the grid. The parameters that are not set get the values of the grid.",
+	},
+	"dnssec_ksk_rollover_date": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The date of the next KSK rollover of the zone, in seconds since the epoch.",
+	},
+	"dnssec_zsk_rollover_date": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The date of the next ZSK rollover of the zone, in seconds since the epoch.",
+	},
+	"fqdn": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the authoritative zone, in FQDN format for a forward zone or in CIDR notation for a reverse zone. The zone must exist.",
+	},
+	"is_dnssec_enabled": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if DNSSEC is enabled for the zone.",
+	},
+	"is_dnssec_signed": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Determines if the zone is signed. The zone is signed and unsigned by the nios_dns_zone_dnssec_operation resource.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the zone.",
+	},
+}
+
+// Expand returns the fields of the zone holding its DNSSEC settings. The name of the zone is sent as it is a
+// required field of zone_auth, it is left unchanged.
+func (m *ZoneDnssecModel) Expand(ctx context.Context, diags *diag.Diagnostics) *dns.ZoneAuth {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneAuth{
+		DnssecKeyParams:    ExpandZoneAuthDnssecKeyParams(ctx, m.DnssecKeyParams, diags),
+		Fqdn:               flex.ExpandString(m.Fqdn),
+		UseDnssecKeyParams: utils.Ptr(true),
+	}
+	return to
+}
+
+func (m *ZoneDnssecModel) Flatten(ctx context.Context, from *dns.ZoneAuth, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ZoneDnssecModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.DnssecKeyParams = FlattenZoneAuthDnssecKeyParams(ctx, from.DnssecKeyParams, diags)
+	m.DnssecKskRolloverDate = flex.FlattenInt64Pointer(from.DnssecKskRolloverDate)
+	m.DnssecZskRolloverDate = flex.FlattenInt64Pointer(from.DnssecZskRolloverDate)
+	m.Fqdn = flex.FlattenString(from.Fqdn)
+	m.IsDnssecEnabled = types.BoolPointerValue(from.IsDnssecEnabled)
+	m.IsDnssecSigned = types.BoolPointerValue(from.IsDnssecSigned)
+	m.View = flex.FlattenStringPointer(from.View)
+}
@@ -0,0 +1,75 @@
+package dns
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnssecOperation is the WAPI function of zone_auth signing, unsigning and rolling the keys of a zone.
+const dnssecOperation = "dnssec_operation"
+
+// ZoneDnssecOperationModel holds a DNSSEC operation run on an authoritative zone. It is not a WAPI object: the
+// operation is run when the resource is created, and again when it is replaced.
+type ZoneDnssecOperationModel struct {
+	Ref       types.String `tfsdk:"ref"`
+	Fqdn      types.String `tfsdk:"fqdn"`
+	Operation types.String `tfsdk:"operation"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+	View      types.String `tfsdk:"view"`
+}
+
+var ZoneDnssecOperationAttrTypes = map[string]attr.Type{
+	"ref":       types.StringType,
+	"fqdn":      types.StringType,
+	"operation": types.StringType,
+	"triggers":  types.MapType{ElemType: types.StringType},
+	"view":      types.StringType,
+}
+
+var ZoneDnssecOperationResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The reference to the authoritative zone the operation was run on.",
+	},
+	"fqdn": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the authoritative zone, in FQDN format for a forward zone or in CIDR notation for a reverse zone. The zone must exist.",
+	},
+	"operation": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("SIGN", "UNSIGN", "ROLLOVER_KSK"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNSSEC operation: `SIGN` signs the zone, `UNSIGN` removes its signatures and keys, and `ROLLOVER_KSK` starts the rollover of its key-signing key.",
+	},
+	"triggers": schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "Arbitrary values that run the operation again when they change, e.g. the date of a planned KSK rollover.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the zone.",
+	},
+}
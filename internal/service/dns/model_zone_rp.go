@@ -0,0 +1,265 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ZoneRpModel struct {
+	Ref               types.String `tfsdk:"ref"`
+	Comment           types.String `tfsdk:"comment"`
+	Disable           types.Bool   `tfsdk:"disable"`
+	DisplayDomain     types.String `tfsdk:"display_domain"`
+	ExternalPrimaries types.List   `tfsdk:"external_primaries"`
+	Extattrs          types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll       types.Map    `tfsdk:"extattrs_all"`
+	Fqdn              types.String `tfsdk:"fqdn"`
+	GridPrimary       types.List   `tfsdk:"grid_primary"`
+	GridSecondaries   types.List   `tfsdk:"grid_secondaries"`
+	NsGroup           types.String `tfsdk:"ns_group"`
+	RpzPolicy         types.String `tfsdk:"rpz_policy"`
+	RpzPriority       types.Int32  `tfsdk:"rpz_priority"`
+	RpzSeverity       types.String `tfsdk:"rpz_severity"`
+	RpzType           types.String `tfsdk:"rpz_type"`
+	SubstituteName    types.String `tfsdk:"substitute_name"`
+	View              types.String `tfsdk:"view"`
+}
+
+var ZoneRpAttrTypes = map[string]attr.Type{
+	"ref":                types.StringType,
+	"comment":            types.StringType,
+	"disable":            types.BoolType,
+	"display_domain":     types.StringType,
+	"external_primaries": types.ListType{ElemType: types.ObjectType{AttrTypes: ExtServerAttrTypes}},
+	"extattrs":           types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":       types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"fqdn":               types.StringType,
+	"grid_primary":       types.ListType{ElemType: types.ObjectType{AttrTypes: MemberServerAttrTypes}},
+	"grid_secondaries":   types.ListType{ElemType: types.ObjectType{AttrTypes: MemberServerAttrTypes}},
+	"ns_group":           types.StringType,
+	"rpz_policy":         types.StringType,
+	"rpz_priority":       types.Int32Type,
+	"rpz_severity":       types.StringType,
+	"rpz_type":           types.StringType,
+	"substitute_name":    types.StringType,
+	"view":               types.StringType,
+}
+
+var ZoneRpResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the zone; maximum 256 characters.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the zone is disabled or not. False means that the zone is enabled.",
+	},
+	"display_domain": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The displayed name of the zone.",
+	},
+	"external_primaries": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ExtServerResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The primary servers of the feed a `FEED` zone gets its rules from by zone transfer. Required for a `FEED` zone, and only for it.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"fqdn": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the zone in FQDN format. The names of its rules end with it. The zone is recreated when the name changes.",
+	},
+	"grid_primary": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: MemberServerResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.ConflictsWith(path.MatchRoot("ns_group"), path.MatchRoot("external_primaries")),
+		},
+		MarkdownDescription: "The grid members that are the primary servers of a `LOCAL` zone. Cannot be set with ns_group or external_primaries.",
+	},
+	"grid_secondaries": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: MemberServerResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.ConflictsWith(path.MatchRoot("ns_group")),
+		},
+		MarkdownDescription: "The grid members that are the secondary servers of the zone, which apply its rules. Cannot be set with ns_group.",
+	},
+	"ns_group": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The name server group whose grid members serve the zone, instead of grid_primary and grid_secondaries.",
+	},
+	"rpz_policy": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("GIVEN"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("GIVEN", "DISABLED", "NXDOMAIN", "NODATA", "PASSTHRU", "SUBSTITUTE"),
+		},
+		MarkdownDescription: "The policy of the zone: `GIVEN` applies the action of each rule, `DISABLED` only logs the queries the rules match, " +
+			"and `NXDOMAIN`, `NODATA`, `PASSTHRU` and `SUBSTITUTE` apply that action to all of them instead. `SUBSTITUTE` requires substitute_name.",
+	},
+	"rpz_priority": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: "The priority of the zone among the response policy zones of its view, from 0 for the first zone. The rules of the first zone matching a query apply.",
+	},
+	"rpz_severity": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("MAJOR"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("CRITICAL", "MAJOR", "WARNING", "INFORMATIONAL"),
+		},
+		MarkdownDescription: "The severity of the events the rules of the zone log: `CRITICAL`, `MAJOR`, `WARNING` or `INFORMATIONAL`.",
+	},
+	"rpz_type": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("LOCAL"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("LOCAL", "FEED"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The type of the zone: `LOCAL` for a zone whose rules are managed on the grid, or `FEED` for a zone that gets its rules from the external_primaries of a feed. The zone is recreated when the type changes.",
+	},
+	"substitute_name": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.String{
+			domainNameValidator(),
+		},
+		MarkdownDescription: "The domain name substituted for the queried ones when the rpz_policy is `SUBSTITUTE`. Only for that policy.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the zone. The zone is recreated when the view changes.",
+	},
+}
+
+func (m *ZoneRpModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.ZoneRp {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneRp{
+		Comment:           flex.ExpandStringPointer(m.Comment),
+		Disable:           flex.ExpandBoolPointer(m.Disable),
+		ExternalPrimaries: flex.ExpandFrameworkListNestedBlock(ctx, m.ExternalPrimaries, diags, ExpandZoneRpExternalPrimaries),
+		Extattrs:          flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Fqdn:              flex.ExpandString(m.Fqdn),
+		GridPrimary:       flex.ExpandFrameworkListNestedBlock(ctx, m.GridPrimary, diags, ExpandZoneRpGridPrimary),
+		GridSecondaries:   flex.ExpandFrameworkListNestedBlock(ctx, m.GridSecondaries, diags, ExpandZoneRpGridSecondaries),
+		NsGroup:           flex.ExpandStringPointer(m.NsGroup),
+		RpzPolicy:         flex.ExpandStringPointer(m.RpzPolicy),
+		RpzSeverity:       flex.ExpandStringPointer(m.RpzSeverity),
+		SubstituteName:    flex.ExpandStringPointer(m.SubstituteName),
+	}
+	if isCreate {
+		to.RpzType = flex.ExpandStringPointer(m.RpzType)
+		to.View = flex.ExpandStringPointer(m.View)
+		return to
+	}
+	// WAPI keeps the servers that are not sent, removing them takes an empty list. The servers of a zone served by a
+	// name server group come from the group.
+	if to.NsGroup == nil {
+		if to.GridPrimary == nil {
+			to.GridPrimary = []dns.ZoneRpGridPrimary{}
+		}
+		if to.GridSecondaries == nil {
+			to.GridSecondaries = []dns.ZoneRpGridSecondaries{}
+		}
+	}
+	return to
+}
+
+func FlattenZoneRp(ctx context.Context, from *dns.ZoneRp, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ZoneRpAttrTypes)
+	}
+	m := ZoneRpModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ZoneRpAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ZoneRpModel) Flatten(ctx context.Context, from *dns.ZoneRp, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ZoneRpModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DisplayDomain = flex.FlattenStringPointer(from.DisplayDomain)
+	m.ExternalPrimaries = flex.FlattenFrameworkListNestedBlock(ctx, from.ExternalPrimaries, ExtServerAttrTypes, diags, FlattenZoneRpExternalPrimaries)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.Fqdn = flex.FlattenString(from.Fqdn)
+	m.GridPrimary = flex.FlattenFrameworkListNestedBlock(ctx, from.GridPrimary, MemberServerAttrTypes, diags, FlattenZoneRpGridPrimary)
+	m.GridSecondaries = flex.FlattenFrameworkListNestedBlock(ctx, from.GridSecondaries, MemberServerAttrTypes, diags, FlattenZoneRpGridSecondaries)
+	m.NsGroup = flex.FlattenStringPointer(from.NsGroup)
+	m.RpzPolicy = flex.FlattenStringPointer(from.RpzPolicy)
+	m.RpzPriority = types.Int32PointerValue(from.RpzPriority)
+	m.RpzSeverity = flex.FlattenStringPointer(from.RpzSeverity)
+	m.RpzType = flex.FlattenStringPointer(from.RpzType)
+	m.SubstituteName = flex.FlattenStringPointer(from.SubstituteName)
+	m.View = flex.FlattenStringPointer(from.View)
+}
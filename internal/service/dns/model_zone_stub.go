@@ -0,0 +1,203 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/nios-go-client/dns"
+
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+)
+
+type ZoneStubModel struct {
+	Ref           types.String `tfsdk:"ref"`
+	Comment       types.String `tfsdk:"comment"`
+	Disable       types.Bool   `tfsdk:"disable"`
+	DisplayDomain types.String `tfsdk:"display_domain"`
+	DnsFqdn       types.String `tfsdk:"dns_fqdn"`
+	Extattrs      types.Map    `tfsdk:"extattrs"`
+	ExtattrsAll   types.Map    `tfsdk:"extattrs_all"`
+	Fqdn          types.String `tfsdk:"fqdn"`
+	NsGroup       types.String `tfsdk:"ns_group"`
+	StubFrom      types.List   `tfsdk:"stub_from"`
+	StubMembers   types.List   `tfsdk:"stub_members"`
+	View          types.String `tfsdk:"view"`
+	ZoneFormat    types.String `tfsdk:"zone_format"`
+}
+
+var ZoneStubAttrTypes = map[string]attr.Type{
+	"ref":            types.StringType,
+	"comment":        types.StringType,
+	"disable":        types.BoolType,
+	"display_domain": types.StringType,
+	"dns_fqdn":       types.StringType,
+	"extattrs":       types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"extattrs_all":   types.MapType{ElemType: types.MapType{ElemType: types.StringType}},
+	"fqdn":           types.StringType,
+	"ns_group":       types.StringType,
+	"stub_from":      types.ListType{ElemType: types.ObjectType{AttrTypes: ExtServerAttrTypes}},
+	"stub_members":   types.ListType{ElemType: types.ObjectType{AttrTypes: MemberServerAttrTypes}},
+	"view":           types.StringType,
+	"zone_format":    types.StringType,
+}
+
+var ZoneStubResourceSchemaAttributes = map[string]schema.Attribute{
+	"ref": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		MarkdownDescription: "The reference to the object.",
+	},
+	"comment": schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Comment for the zone; maximum 256 characters.",
+	},
+	"disable": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Determines if the zone is disabled or not. False means that the zone is enabled.",
+	},
+	"display_domain": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The displayed name of the zone: its domain name, such as `2.0.192.in-addr.arpa` for a reverse zone.",
+	},
+	"dns_fqdn": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The name of the zone in punycode format.",
+	},
+	"extattrs": schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object.",
+	},
+	"extattrs_all": schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+		MarkdownDescription: "Extensible attributes associated with the object, including the default extensible attributes of the provider.",
+	},
+	"fqdn": schema.StringAttribute{
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The name of the zone in FQDN format for a forward zone, or its network in CIDR notation for a reverse zone, such as `192.0.2.0/24`. The zone is recreated when the name changes.",
+	},
+	"ns_group": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "The name server group whose grid members serve the stub zone, instead of stub_members.",
+	},
+	"stub_from": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ExtServerResourceSchemaAttributes,
+		},
+		Required: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		MarkdownDescription: "The primary servers of the zone, from which the stub zone gets its SOA and NS records.",
+	},
+	"stub_members": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: MemberServerResourceSchemaAttributes,
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.ConflictsWith(path.MatchRoot("ns_group")),
+		},
+		MarkdownDescription: "The grid members that serve the stub zone. Their grid_replicate, lead and stealth attributes do not apply to a stub zone. Cannot be set with ns_group.",
+	},
+	"view": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("default"),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The DNS view of the zone. The zone is recreated when the view changes.",
+	},
+	"zone_format": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString("FORWARD"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("FORWARD", "IPV4", "IPV6"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		MarkdownDescription: "The format of the zone: `FORWARD`, or `IPV4` and `IPV6` for the reverse zones. The zone is recreated when the format changes.",
+	},
+}
+
+func (m *ZoneStubModel) Expand(ctx context.Context, diags *diag.Diagnostics, isCreate bool) *dns.ZoneStub {
+	if m == nil {
+		return nil
+	}
+	to := &dns.ZoneStub{
+		Comment:     flex.ExpandStringPointer(m.Comment),
+		Disable:     flex.ExpandBoolPointer(m.Disable),
+		Extattrs:    flex.ExpandFrameworkMapOfMapString(ctx, m.Extattrs, diags),
+		Fqdn:        flex.ExpandString(m.Fqdn),
+		NsGroup:     flex.ExpandStringPointer(m.NsGroup),
+		StubFrom:    flex.ExpandFrameworkListNestedBlock(ctx, m.StubFrom, diags, ExpandZoneStubStubFrom),
+		StubMembers: flex.ExpandFrameworkListNestedBlock(ctx, m.StubMembers, diags, ExpandZoneStubStubMembers),
+	}
+	if isCreate {
+		to.View = flex.ExpandStringPointer(m.View)
+		to.ZoneFormat = flex.ExpandStringPointer(m.ZoneFormat)
+		return to
+	}
+	// WAPI keeps the stub members when they are not sent, removing them takes an empty list
+	if to.StubMembers == nil && to.NsGroup == nil {
+		to.StubMembers = []dns.ZoneStubStubMembers{}
+	}
+	return to
+}
+
+func FlattenZoneStub(ctx context.Context, from *dns.ZoneStub, diags *diag.Diagnostics) types.Object {
+	if from == nil {
+		return types.ObjectNull(ZoneStubAttrTypes)
+	}
+	m := ZoneStubModel{}
+	m.Flatten(ctx, from, diags)
+	t, d := types.ObjectValueFrom(ctx, ZoneStubAttrTypes, m)
+	diags.Append(d...)
+	return t
+}
+
+func (m *ZoneStubModel) Flatten(ctx context.Context, from *dns.ZoneStub, diags *diag.Diagnostics) {
+	if from == nil {
+		return
+	}
+	if m == nil {
+		*m = ZoneStubModel{}
+	}
+	m.Ref = flex.FlattenStringPointer(from.Ref)
+	m.Comment = flex.FlattenStringPointer(from.Comment)
+	m.Disable = types.BoolPointerValue(from.Disable)
+	m.DisplayDomain = flex.FlattenStringPointer(from.DisplayDomain)
+	m.DnsFqdn = flex.FlattenStringPointer(from.DnsFqdn)
+	m.Extattrs = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.ExtattrsAll = flex.FlattenFrameworkMapOfMapString(ctx, from.Extattrs, diags)
+	m.Fqdn = flex.FlattenString(from.Fqdn)
+	m.NsGroup = flex.FlattenStringPointer(from.NsGroup)
+	m.StubFrom = flex.FlattenFrameworkListNestedBlock(ctx, from.StubFrom, ExtServerAttrTypes, diags, FlattenZoneStubStubFrom)
+	m.StubMembers = flex.FlattenFrameworkListNestedBlock(ctx, from.StubMembers, MemberServerAttrTypes, diags, FlattenZoneStubStubMembers)
+	m.View = flex.FlattenStringPointer(from.View)
+	m.ZoneFormat = flex.FlattenStringPointer(from.ZoneFormat)
+}
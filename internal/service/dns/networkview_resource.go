@@ -0,0 +1,257 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"strings"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForNetworkview = "associated_dns_views,comment,extattrs,is_default,name"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkviewResource{}
+var _ resource.ResourceWithImportState = &NetworkviewResource{}
+var _ resource.ResourceWithModifyPlan = &NetworkviewResource{}
+
+func NewNetworkviewResource() resource.Resource {
+	return &NetworkviewResource{}
+}
+
+// NetworkviewResource defines the resource implementation.
+type NetworkviewResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *NetworkviewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "network_view"
+}
+
+func (r *NetworkviewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          NetworkviewResourceSchemaAttributes,
+	}
+}
+
+func (r *NetworkviewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkviewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "networkview", readableAttributesForNetworkview) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the network view
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name")
+}
+
+func (r *NetworkviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkviewModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkview := data.Expand(ctx, &resp.Diagnostics)
+	networkview.Extattrs = utils.MergeDefaultExtAttrs(networkview.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		NetworkviewAPI.
+		Post(ctx).
+		Networkview(*networkview).
+		ReturnFields2(readableAttributesForNetworkview).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Networkview", err, httpRes, NetworkviewResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkviewModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		NetworkviewAPI.
+		NetworkviewReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForNetworkview).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Networkview", err, httpRes, NetworkviewResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkviewModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the network view is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkview := data.Expand(ctx, &resp.Diagnostics)
+	networkview.Extattrs = utils.MergeDefaultExtAttrs(networkview.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		NetworkviewAPI.
+		NetworkviewReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Networkview(*networkview).
+		ReturnFields2(readableAttributesForNetworkview).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Networkview", err, httpRes, NetworkviewResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkviewModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// WAPI deletes the DNS views of a network view with it, and their zones
+	if !data.ForceDelete.ValueBool() {
+		r.checkZones(ctx, &data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		NetworkviewAPI.
+		NetworkviewReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Networkview", err, httpRes, NetworkviewResourceSchemaAttributes)
+		return
+	}
+}
+
+// checkZones rejects the deletion of a network view whose DNS views contain zones. The DNS views are read from the
+// grid, they can have been added since the network view was refreshed.
+func (r *NetworkviewResource) checkZones(ctx context.Context, data *NetworkviewModel, diags *diag.Diagnostics) {
+	apiRes, httpRes, err := r.client.DNSAPI.
+		NetworkviewAPI.
+		NetworkviewReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2("associated_dns_views").
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIError(diags, "delete", "Networkview", err, httpRes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	for _, view := range res.AssociatedDnsViews {
+		zones, httpRes, err := viewZones(ctx, r.client, view)
+		if err != nil {
+			utils.AddWAPIError(diags, "delete", "Networkview", err, httpRes)
+			return
+		}
+		if len(zones) > 0 {
+			diags.AddError("Network view contains zones",
+				fmt.Sprintf("The DNS view %s of the network view %s contains the zones %s. Delete the zones first, or set "+
+					"force_delete to true and apply it before deleting the network view to delete its zones with it.",
+					view, data.Name.ValueString(), strings.Join(zones, ", ")))
+			return
+		}
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the network view.
+func (r *NetworkviewResource) flatten(ctx context.Context, data *NetworkviewModel, res *dns.Networkview, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *NetworkviewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,342 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForNetworkviewTest = "associated_dns_views,comment,extattrs,is_default,name"
+
+func TestAccNetworkviewResource_basic(t *testing.T) {
+	var resourceName = "nios_network_view.test"
+	var v dns.Networkview
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkviewBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkviewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "associated_dns_views.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkviewResource_disappears(t *testing.T) {
+	resourceName := "nios_network_view.test"
+	var v dns.Networkview
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkviewDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkviewBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkviewExists(context.Background(), resourceName, &v),
+					testAccCheckNetworkviewDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkviewResource_Comment(t *testing.T) {
+	var resourceName = "nios_network_view.test_comment"
+	var v dns.Networkview
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkviewComment(name, "This is a new network view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkviewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new network view"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkviewComment(name, "This is an updated network view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkviewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated network view"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkviewResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_network_view.test_extattrs"
+	var v dns.Networkview
+	name := acctest.RandomName()
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccNetworkviewExtattrs(name, map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkviewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccNetworkviewExtattrs(name, map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkviewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkviewResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_network_view.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("networkview"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccNetworkviewComment(name, "This is a new network view"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "associated_dns_views.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "associated_dns_views.0", "default."+name),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccNetworkviewComment(name, "This is an updated network view"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated network view"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccNetworkviewComment(name, "This is an updated network view"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccNetworkviewImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkviewResource_ForceDelete(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("networkview"),
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + testAccNetworkviewForceDelete(name, "false") + testAccNetworkviewZone(name, true),
+			},
+			// A network view whose DNS views still contain zones is kept
+			{
+				Config:      fake.ProviderConfig() + testAccNetworkviewZone(name, false),
+				ExpectError: regexp.MustCompile("Network view contains zones"),
+			},
+			// Unless force_delete is set
+			{
+				Config: fake.ProviderConfig() + testAccNetworkviewForceDelete(name, "true") + testAccNetworkviewZone(name, true),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccNetworkviewZone(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						if views := fake.Objects("networkview"); len(views) != 0 {
+							return fmt.Errorf("expected the network view to be deleted, got %v", views)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkviewImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckNetworkviewExists(ctx context.Context, resourceName string, v *dns.Networkview) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			NetworkviewAPI.
+			NetworkviewReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForNetworkviewTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckNetworkviewDestroy(ctx context.Context, v *dns.Networkview) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			NetworkviewAPI.
+			NetworkviewReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForNetworkviewTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckNetworkviewDisappears(ctx context.Context, v *dns.Networkview) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			NetworkviewAPI.
+			NetworkviewReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccNetworkviewBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nios_network_view" "test" {
+	name = %q
+}
+`, name)
+}
+
+func testAccNetworkviewComment(name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_network_view" "test_comment" {
+	name = %q
+	comment = %q
+}
+`, name, comment)
+}
+
+func testAccNetworkviewExtattrs(name string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_network_view" "test_extattrs" {
+	name = %q
+	extattrs = %s
+}
+`, name, extattrsStr)
+}
+
+func testAccNetworkviewForceDelete(name, forceDelete string) string {
+	return fmt.Sprintf(`
+resource "nios_network_view" "test_force_delete" {
+	name = %q
+	force_delete = %q
+}
+`, name, forceDelete)
+}
+
+// testAccNetworkviewZone returns an authoritative zone in the DNS view the grid creates with the network view,
+// which depends on the network view resource when withView is true.
+func testAccNetworkviewZone(name string, withView bool) string {
+	view := fmt.Sprintf("%q", "default."+name)
+	if withView {
+		view = `"default.${nios_network_view.test_force_delete.name}"`
+	}
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_force_delete" {
+	fqdn = "tenant.example.com"
+	view = %s
+}
+`, view)
+}
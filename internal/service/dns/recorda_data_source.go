@@ -93,19 +93,24 @@ func (d *RecordaDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	utils.CheckWAPIFieldsSupport(ctx, d.client, "record:a", readableAttributesForRecorda, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	apiRes, httpRes, err := d.client.DNSAPI.
 		RecordaAPI.
 		Get(ctx).
 		Filters(flex.ExpandFrameworkMapString(ctx, data.Filters, &resp.Diagnostics)).
 		ReturnAsObject(1).
-		ReturnFields2(readableAttributes).
+		ReturnFields2(readableAttributesForRecorda).
 		Execute()
 	if err != nil {
 		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Record, got error: %s", err))
+		utils.AddWAPIError(&resp.Diagnostics, "read", "Record", err, httpRes)
 		return
 	}
 
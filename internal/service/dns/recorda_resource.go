@@ -3,20 +3,24 @@ package dns
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/unasra/terraform-provider-nios/internal/utils"
 	"net/http"
 
 	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
 )
 
-var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,extattrs,forbid_reclamation,ipv4addr,last_queried,ms_ad_user_data,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+var readableAttributesForRecorda = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,extattrs,forbid_reclamation,ipv4addr,last_queried,ms_ad_user_data,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RecordaResource{}
 var _ resource.ResourceWithImportState = &RecordaResource{}
+var _ resource.ResourceWithModifyPlan = &RecordaResource{}
 
 func NewRecordaResource() resource.Resource {
 	return &RecordaResource{}
@@ -58,6 +62,23 @@ func (r *RecordaResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = client
 }
 
+func (r *RecordaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:a", readableAttributesForRecorda) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the record
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name", "view")
+}
+
 func (r *RecordaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RecordAModel
 
@@ -68,24 +89,31 @@ func (r *RecordaResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	apiRes, httpres, err := r.client.DNSAPI.
+	recordA := data.Expand(ctx, &resp.Diagnostics, true)
+	recordA.Extattrs = utils.MergeDefaultExtAttrs(recordA.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	// Concurrent allocations from the same network or range could return the same address
+	unlock := lockFuncCalls(ctx, r.client, &resp.Diagnostics, recordA.Ipv4addr.RecordAIpv4addrOneOf)
+	defer unlock()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
 		RecordaAPI.
 		Post(ctx).
-		RecordA(*data.Expand(ctx, &resp.Diagnostics, true)).
-		ReturnFields2(readableAttributes).
+		RecordA(*recordA).
+		ReturnFields2(readableAttributesForRecorda).
 		ReturnAsObject(1).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Recorda, got error: %s", err))
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recorda", err, httpRes, RecordAResourceSchemaAttributes)
 		return
 	}
-	if httpres == nil {
-		httpres = nil
-	}
 	res := apiRes.GetResult()
 
 	// Save data into Terraform state
-	data.Flatten(ctx, &res, &resp.Diagnostics)
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -104,7 +132,7 @@ func (r *RecordaResource) Read(ctx context.Context, req resource.ReadRequest, re
 		RecordaAPI.
 		RecordaReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
 		//ReturnFields("ref,aws_rte53_record_info,cloud_info, comment, creation_time, creator, ddns_principal, ddns_protected, disable, discovered_data, dns_name, extattrs, forbid_reclamation, ipv4addr, last_queried, ms_ad_user_data, name, reclaimable, remove_associated_ptr, shared_record_group, ttl, use_ttl, view, zone").
-		ReturnFields2(readableAttributes).
+		ReturnFields2(readableAttributesForRecorda).
 		ReturnAsObject(1).
 		Execute()
 	if err != nil {
@@ -112,12 +140,12 @@ func (r *RecordaResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Recorda, got error: %s", err))
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recorda", err, httpRes, RecordAResourceSchemaAttributes)
 		return
 	}
 
 	res := apiRes.GetResult()
-	data.Flatten(ctx, &res, &resp.Diagnostics)
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -129,24 +157,30 @@ func (r *RecordaResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	apiRes, _, err := r.client.DNSAPI.
+	recordA := data.Expand(ctx, &resp.Diagnostics, false)
+	recordA.Extattrs = utils.MergeDefaultExtAttrs(recordA.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
 		RecordaAPI.
 		RecordaReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
-		RecordA(*data.Expand(ctx, &resp.Diagnostics, false)).
-		ReturnFields2(readableAttributes).
+		RecordA(*recordA).
+		ReturnFields2(readableAttributesForRecorda).
 		ReturnAsObject(1).
 		Execute()
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Recorda, got error: %s", err))
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recorda", err, httpRes, RecordAResourceSchemaAttributes)
 		return
 	}
 
 	res := apiRes.GetResult()
-	data.Flatten(ctx, &res, &resp.Diagnostics)
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -170,11 +204,19 @@ func (r *RecordaResource) Delete(ctx context.Context, req resource.DeleteRequest
 		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Recorda, got error: %s", err))
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recorda", err, httpRes, RecordAResourceSchemaAttributes)
 		return
 	}
 }
 
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the record.
+func (r *RecordaResource) flatten(ctx context.Context, data *RecordAModel, res *dns.RecordA, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
 func (r *RecordaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
 }
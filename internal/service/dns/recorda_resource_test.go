@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/unasra/terraform-provider-nios/internal/utils"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -314,7 +315,8 @@ func TestAccRecordaResource_Ipv4addr(t *testing.T) {
 func TestAccRecordaResource_Name(t *testing.T) {
 	var resourceName = "nios_dns_a_record.test_name"
 	var v dns.RecordA
-	//name := acctest.RandomName() +  ".example.com"
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -322,18 +324,18 @@ func TestAccRecordaResource_Name(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read
 			{
-				Config: testAccRecordaName("NAME_REPLACE_ME"),
+				Config: testAccRecordaName(name, "10.0.0.20"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRecordaExists(context.Background(), resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "name", "NAME_REPLACE_ME"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
 				),
 			},
 			// Update and Read
 			{
-				Config: testAccRecordaName("NAME_UPDATE_REPLACE_ME"),
+				Config: testAccRecordaName(updatedName, "10.0.0.20"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRecordaExists(context.Background(), resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "name", "NAME_UPDATE_REPLACE_ME"),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -401,6 +403,120 @@ func TestAccRecordaResource_UseTtl(t *testing.T) {
 	})
 }
 
+func TestAccRecordaResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_a_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:a"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordaComment(name, "10.0.0.20", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordaComment(name, "10.0.0.20", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordaComment(name, "10.0.0.20", "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordaImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaResource_FakeWAPIName(t *testing.T) {
+	var resourceName = "nios_dns_a_record.test_name"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:a"),
+		Steps: []resource.TestStep{
+			// The record is created in the default view
+			{
+				Config: fake.ProviderConfig() + testAccRecordaName(name, "10.0.0.20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+				),
+			},
+			// Renaming the record changes its reference
+			{
+				Config: fake.ProviderConfig() + testAccRecordaName(updatedName, "10.0.0.20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestMatchResourceAttr(resourceName, "ref", regexp.MustCompile(regexp.QuoteMeta(updatedName))),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaResource_FakeWAPIFuncCall(t *testing.T) {
+	var resourceName = "nios_dns_a_record.test_func_call"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+	if _, err := fake.Create("network", map[string]interface{}{"network": "10.1.0.0/24"}); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:a"),
+		Steps: []resource.TestStep{
+			// Allocate the next available address of the network, the excluded address aside
+			{
+				Config: fake.ProviderConfig() + testAccRecordaFuncCall(name, "default", "10.1.0.0/24", []string{"10.1.0.1"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4addr", "10.1.0.2"),
+					resource.TestCheckResourceAttr(resourceName, "func_call.object", "network"),
+				),
+			},
+			// The address is not allocated again on update
+			{
+				Config: fake.ProviderConfig() + testAccRecordaFuncCall(name, "default", "10.1.0.0/24", nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4addr", "10.1.0.2"),
+					resource.TestCheckNoResourceAttr(resourceName, "func_call.exclude"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordaImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
 func testAccCheckRecordaExists(ctx context.Context, resourceName string, v *dns.RecordA) resource.TestCheckFunc {
 	// Verify the resource exists in the cloud
 	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,extattrs,forbid_reclamation,ipv4addr,last_queried,ms_ad_user_data,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
@@ -573,12 +689,34 @@ resource "nios_dns_a_record" "test_ipv4addr" {
 `, ipv4addr)
 }
 
-func testAccRecordaName(name string) string {
+func testAccRecordaFuncCall(name, view, network string, exclude []string) string {
+	excludeStr := ""
+	if len(exclude) > 0 {
+		excludeStr = fmt.Sprintf("exclude = [%s]", quoteList(exclude))
+	}
+	return fmt.Sprintf(`
+resource "nios_dns_a_record" "test_func_call" {
+	name = %q
+	view = %q
+	func_call = {
+		object            = "network"
+		object_parameters = {
+			network      = %q
+			network_view = "default"
+		}
+		%s
+	}
+}
+`, name, view, network, excludeStr)
+}
+
+func testAccRecordaName(name, ipV4Addr string) string {
 	return fmt.Sprintf(`
 resource "nios_dns_a_record" "test_name" {
     name = %q
+	ipv4addr = %q
 }
-`, name)
+`, name, ipV4Addr)
 }
 
 func testAccRecordaTtl(name, ipV4Addr, view string, ttl int32, use_ttl string) string {
@@ -0,0 +1,221 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordaaaa = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,extattrs,forbid_reclamation,ipv6addr,last_queried,ms_ad_user_data,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordaaaaResource{}
+var _ resource.ResourceWithImportState = &RecordaaaaResource{}
+var _ resource.ResourceWithModifyPlan = &RecordaaaaResource{}
+
+func NewRecordaaaaResource() resource.Resource {
+	return &RecordaaaaResource{}
+}
+
+// RecordaaaaResource defines the resource implementation.
+type RecordaaaaResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordaaaaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_aaaa_record"
+}
+
+func (r *RecordaaaaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordAAAAResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordaaaaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordaaaaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:aaaa", readableAttributesForRecordaaaa) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the record
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name", "view")
+}
+
+func (r *RecordaaaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordAAAAModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordAAAA := data.Expand(ctx, &resp.Diagnostics, true)
+	recordAAAA.Extattrs = utils.MergeDefaultExtAttrs(recordAAAA.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	// Concurrent allocations from the same network or range could return the same address
+	unlock := lockFuncCalls(ctx, r.client, &resp.Diagnostics, (*dns.RecordAIpv4addrOneOf)(recordAAAA.Ipv6addr.RecordAAAAIpv6addrOneOf))
+	defer unlock()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordaaaaAPI.
+		Post(ctx).
+		RecordAAAA(*recordAAAA).
+		ReturnFields2(readableAttributesForRecordaaaa).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordaaaa", err, httpRes, RecordAAAAResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordaaaaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordAAAAModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordaaaaAPI.
+		RecordaaaaReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordaaaa).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordaaaa", err, httpRes, RecordAAAAResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordaaaaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordAAAAModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordAAAA := data.Expand(ctx, &resp.Diagnostics, false)
+	recordAAAA.Extattrs = utils.MergeDefaultExtAttrs(recordAAAA.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordaaaaAPI.
+		RecordaaaaReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordAAAA(*recordAAAA).
+		ReturnFields2(readableAttributesForRecordaaaa).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordaaaa", err, httpRes, RecordAAAAResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordaaaaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordAAAAModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordaaaaAPI.
+		RecordaaaaReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordaaaa", err, httpRes, RecordAAAAResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the record.
+func (r *RecordaaaaResource) flatten(ctx context.Context, data *RecordAAAAModel, res *dns.RecordAAAA, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *RecordaaaaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,734 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordaaaaResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaBasicConfig(name, "2001:db8::20", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_aaaa_record.test"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordaaaaDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordaaaaBasicConfig(name, "2001:db8::20", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					testAccCheckRecordaaaaDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_comment"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaComment(name, "2001:db8::20", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaComment(name, "2001:db8::20", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_creator"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaCreator(name, "2001:db8::20", "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaCreator(name, "2001:db8::20", "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_ddns_principal"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaDdnsPrincipal(name, "2001:db8::20", "default", "host/aaaa.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/aaaa.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaDdnsPrincipal(name, "2001:db8::20", "default", "host/aaaa-updated.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/aaaa-updated.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_ddns_protected"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaDdnsProtected(name, "2001:db8::20", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaDdnsProtected(name, "2001:db8::20", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_disable"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaDisable(name, "2001:db8::20", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaDisable(name, "2001:db8::20", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_extattrs"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaExtattrs(name, "2001:db8::20", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaExtattrs(name, "2001:db8::20", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_forbid_reclamation"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaForbidReclamation(name, "2001:db8::20", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaForbidReclamation(name, "2001:db8::20", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_Ipv6addr(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_ipv6addr"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaIpv6addr(name, "2001:db8::21"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:db8::21"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaIpv6addr(name, "2001:db8::22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:db8::22"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_name"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaName(name, "2001:db8::20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaName(updatedName, "2001:db8::20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_ttl"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaTtl(name, "2001:db8::20", "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaTtl(name, "2001:db8::20", "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_use_ttl"
+	var v dns.RecordAAAA
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaaaaUseTtl(name, "2001:db8::20", "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaaaaUseTtl(name, "2001:db8::20", "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:aaaa"),
+		Steps: []resource.TestStep{
+			// Create and Read, the grid returns the address in its canonical form
+			{
+				Config: fake.ProviderConfig() + testAccRecordaaaaComment(name, "2001:0db8:0:0::20", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:0db8:0:0::20"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordaaaaComment(name, "2001:0db8:0:0::20", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:0db8:0:0::20"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordaaaaComment(name, "2001:0db8:0:0::20", "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordaaaaImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+				// The imported address is in the canonical form returned by the grid
+				ImportStateVerifyIgnore: []string{"ipv6addr"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_FakeWAPIName(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_name"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:aaaa"),
+		Steps: []resource.TestStep{
+			// The record is created in the default view
+			{
+				Config: fake.ProviderConfig() + testAccRecordaaaaName(name, "2001:db8::20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+				),
+			},
+			// Renaming the record changes its reference
+			{
+				Config: fake.ProviderConfig() + testAccRecordaaaaName(updatedName, "2001:db8::20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestMatchResourceAttr(resourceName, "ref", regexp.MustCompile(regexp.QuoteMeta(updatedName))),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaaaaResource_FakeWAPIFuncCall(t *testing.T) {
+	var resourceName = "nios_dns_aaaa_record.test_func_call"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+	if _, err := fake.Create("ipv6range", map[string]interface{}{"start_addr": "2001:db8::10", "end_addr": "2001:db8::20", "network": "2001:db8::/64"}); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:aaaa"),
+		Steps: []resource.TestStep{
+			// Allocate the next available address of the range
+			{
+				Config: fake.ProviderConfig() + testAccRecordaaaaFuncCall(name, "default", "2001:db8::10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:db8::10"),
+					resource.TestCheckResourceAttr(resourceName, "func_call.object", "ipv6range"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordaaaaImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordaaaaExists(ctx context.Context, resourceName string, v *dns.RecordAAAA) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,extattrs,forbid_reclamation,ipv6addr,last_queried,ms_ad_user_data,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		//rs.Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordaaaaAPI.
+			RecordaaaaReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordaaaaDestroy(ctx context.Context, v *dns.RecordAAAA) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,extattrs,forbid_reclamation,ipv6addr,last_queried,ms_ad_user_data,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordaaaaAPI.
+			RecordaaaaReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordaaaaDisappears(ctx context.Context, v *dns.RecordAAAA) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordaaaaAPI.
+			RecordaaaaReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordaaaaBasicConfig(name, ipV6Addr, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+}
+`, name, ipV6Addr, view)
+}
+
+func testAccRecordaaaaComment(name, ipV6Addr, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_comment" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+	comment = %q
+}
+`, name, ipV6Addr, view, comment)
+}
+
+func testAccRecordaaaaCreator(name, ipV6Addr, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_creator" {
+	name = %q
+	ipv6addr = %q
+	view = %q  
+	creator = %q
+}
+`, name, ipV6Addr, view, creator)
+}
+
+func testAccRecordaaaaDdnsPrincipal(name, ipV6Addr, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_ddns_principal" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+	ddns_principal = %q
+}
+`, name, ipV6Addr, view, ddnsPrincipal)
+}
+
+func testAccRecordaaaaDdnsProtected(name, ipV6Addr, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_ddns_protected" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+	ddns_protected = %q
+}
+`, name, ipV6Addr, view, ddnsProtected)
+}
+
+func testAccRecordaaaaDisable(name, ipV6Addr, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_disable" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+	disable = %q
+}
+`, name, ipV6Addr, view, disable)
+}
+
+func testAccRecordaaaaExtattrs(name, ipV6Addr, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_extattrs" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+	extattrs = %s
+}
+`, name, ipV6Addr, view, extattrsStr)
+}
+
+func testAccRecordaaaaForbidReclamation(name, ipV6Addr, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_forbid_reclamation" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, ipV6Addr, view, forbidReclamation)
+}
+
+func testAccRecordaaaaIpv6addr(name, ipv6addr string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_ipv6addr" {
+	name = %q
+	ipv6addr = %q
+}
+`, name, ipv6addr)
+}
+
+func testAccRecordaaaaFuncCall(name, view, startAddr string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_func_call" {
+	name = %q
+	view = %q
+	func_call = {
+		object            = "ipv6range"
+		object_parameters = {
+			start_addr = %q
+		}
+	}
+}
+`, name, view, startAddr)
+}
+
+func testAccRecordaaaaName(name, ipV6Addr string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_name" {
+	name = %q
+	ipv6addr = %q
+}
+`, name, ipV6Addr)
+}
+
+func testAccRecordaaaaTtl(name, ipV6Addr, view string, ttl int32, use_ttl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_ttl" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, ipV6Addr, view, ttl, use_ttl)
+}
+
+func testAccRecordaaaaUseTtl(name, ipV6Addr, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_aaaa_record" "test_use_ttl" {
+	name = %q
+	ipv6addr = %q
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, ipV6Addr, view, useTtl, ttl)
+}
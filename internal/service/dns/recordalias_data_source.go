@@ -0,0 +1,122 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RecordaliasDataSource{}
+
+func NewRecordaliasDataSource() datasource.DataSource {
+	return &RecordaliasDataSource{}
+}
+
+// RecordaliasDataSource defines the data source implementation.
+type RecordaliasDataSource struct {
+	client *niosclient.APIClient
+}
+
+func (d *RecordaliasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_alias_records"
+}
+
+type RecordAliasModelWithFilter struct {
+	Filters types.Map  `tfsdk:"filters"`
+	Result  types.List `tfsdk:"result"`
+}
+
+func (m *RecordAliasModelWithFilter) FlattenResults(ctx context.Context, from []dns.RecordAlias, diags *diag.Diagnostics) {
+	if len(from) == 0 {
+		return
+	}
+	m.Result = flex.FlattenFrameworkListNestedBlock(ctx, from, RecordAliasAttrTypes, diags, FlattenRecordAlias)
+}
+
+func (d *RecordaliasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes: map[string]schema.Attribute{
+			"filters": schema.MapAttribute{
+				Description: "Filter are used to return a more specific list of results. Filters can be used to match resources by specific attributes, e.g. name. If you specify multiple filters, the results returned will have only resources that match all the specified filters.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"result": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: utils.DataSourceAttributeMap(RecordAliasResourceSchemaAttributes, &resp.Diagnostics),
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *RecordaliasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RecordaliasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RecordAliasModelWithFilter
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.CheckWAPIFieldsSupport(ctx, d.client, "record:alias", readableAttributesForRecordalias, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := d.client.DNSAPI.
+		RecordaliasAPI.
+		Get(ctx).
+		Filters(flex.ExpandFrameworkMapString(ctx, data.Filters, &resp.Diagnostics)).
+		ReturnAsObject(1).
+		ReturnFields2(readableAttributesForRecordalias).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIError(&resp.Diagnostics, "read", "Record", err, httpRes)
+		return
+	}
+
+	res := apiRes.ListRecordAliasResponseObject.GetResult()
+	data.FlattenResults(ctx, res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
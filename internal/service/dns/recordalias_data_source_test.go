@@ -0,0 +1,115 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordaliasDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_alias_records.test"
+	resourceName := "nios_dns_alias_record.test"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordaliasDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordaliasDataSourceConfigFilters(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordaliasResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordaliasDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_alias_records.test"
+	resourceName := "nios_dns_alias_record.test"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordaliasDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordaliasDataSourceConfigTagFilters(name, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordaliasResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordaliasResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "target_name", dataSourceName, "result.0.target_name"),
+		resource.TestCheckResourceAttrPair(resourceName, "target_type", dataSourceName, "result.0.target_type"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordaliasDataSourceConfigFilters(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+}
+
+data "nios_dns_alias_records" "test" {
+	filters = {
+		"name": nios_dns_alias_record.test.name
+	}
+}
+`, name, view)
+}
+
+func testAccRecordaliasDataSourceConfigTagFilters(name, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_alias_records" "test" {
+	filters = {
+		"*Site" = nios_dns_alias_record.test.extattrs.Site.value
+	}
+}
+`, name, view, extAttrsValue)
+}
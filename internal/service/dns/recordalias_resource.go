@@ -0,0 +1,230 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"strings"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordalias = "aws_rte53_record_info,cloud_info,comment,creator,disable,dns_name,dns_target_name,extattrs,last_queried,name,target_name,target_type,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordaliasResource{}
+var _ resource.ResourceWithImportState = &RecordaliasResource{}
+var _ resource.ResourceWithModifyPlan = &RecordaliasResource{}
+
+func NewRecordaliasResource() resource.Resource {
+	return &RecordaliasResource{}
+}
+
+// RecordaliasResource defines the resource implementation.
+type RecordaliasResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordaliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_alias_record"
+}
+
+func (r *RecordaliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordAliasResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordaliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordaliasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:alias", readableAttributesForRecordalias) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the record
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name", "view")
+	r.checkTarget(ctx, req, resp)
+}
+
+// checkTarget rejects an Alias record whose target is the record itself, the grid would resolve it in a loop.
+func (r *RecordaliasResource) checkTarget(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordAliasModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.TargetName.IsUnknown() {
+		return
+	}
+
+	if strings.EqualFold(plan.Name.ValueString(), plan.TargetName.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("target_name"), "Invalid alias target",
+			fmt.Sprintf("The target of the Alias record %s cannot be the name of the record itself.", plan.Name.ValueString()))
+	}
+}
+
+func (r *RecordaliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordAliasModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordAlias := data.Expand(ctx, &resp.Diagnostics, true)
+	recordAlias.Extattrs = utils.MergeDefaultExtAttrs(recordAlias.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordaliasAPI.
+		Post(ctx).
+		RecordAlias(*recordAlias).
+		ReturnFields2(readableAttributesForRecordalias).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordalias", err, httpRes, RecordAliasResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordaliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordAliasModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordaliasAPI.
+		RecordaliasReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordalias).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordalias", err, httpRes, RecordAliasResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordaliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordAliasModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordAlias := data.Expand(ctx, &resp.Diagnostics, false)
+	recordAlias.Extattrs = utils.MergeDefaultExtAttrs(recordAlias.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordaliasAPI.
+		RecordaliasReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordAlias(*recordAlias).
+		ReturnFields2(readableAttributesForRecordalias).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordalias", err, httpRes, RecordAliasResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordaliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordAliasModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordaliasAPI.
+		RecordaliasReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordalias", err, httpRes, RecordAliasResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the record.
+func (r *RecordaliasResource) flatten(ctx context.Context, data *RecordAliasModel, res *dns.RecordAlias, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *RecordaliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,628 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordaliasResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_alias_record.test"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordaliasDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordaliasBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					testAccCheckRecordaliasDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordaliasResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_comment"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_creator"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasCreator(name, "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasCreator(name, "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_disable"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasDisable(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasDisable(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_extattrs"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_name"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasName(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_ttl"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasTtl(name, "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasTtl(name, "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_use_ttl"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasUseTtl(name, "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasUseTtl(name, "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:alias"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordaliasComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "lb.cloud.example.net"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "dns_name", name),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordaliasComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordaliasComment(name, "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordaliasImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_TargetName(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_target_name"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasTargetName(name, "lb.cloud.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "lb.cloud.example.net"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasTargetName(name, "lb2.cloud.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "lb2.cloud.example.net"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_TargetType(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_target_type"
+	var v dns.RecordAlias
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordaliasTargetType(name, "A"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "A"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordaliasTargetType(name, "AAAA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordaliasExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "AAAA"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordaliasResource_Target(t *testing.T) {
+	var resourceName = "nios_dns_alias_record.test_target"
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:alias"),
+		Steps: []resource.TestStep{
+			// An Alias record can be placed at the apex of a zone
+			{
+				Config: fake.ProviderConfig() + testAccRecordaliasTarget("example.com", "lb.cloud.example.net", "A"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "lb.cloud.example.net"),
+					resource.TestCheckResourceAttr(resourceName, "dns_target_name", "lb.cloud.example.net"),
+				),
+			},
+			// A CNAME record cannot be the target of an alias
+			{
+				Config:      fake.ProviderConfig() + testAccRecordaliasTarget("example.com", "lb.cloud.example.net", "CNAME"),
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			// The record cannot be its own target
+			{
+				Config:      fake.ProviderConfig() + testAccRecordaliasTarget("example.com", "example.com", "A"),
+				ExpectError: regexp.MustCompile("Invalid alias target"),
+			},
+		},
+	})
+}
+
+func testAccRecordaliasImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordaliasExists(ctx context.Context, resourceName string, v *dns.RecordAlias) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creator,disable,dns_name,dns_target_name,extattrs,last_queried,name,target_name,target_type,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordaliasAPI.
+			RecordaliasReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordaliasDestroy(ctx context.Context, v *dns.RecordAlias) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creator,disable,dns_name,dns_target_name,extattrs,last_queried,name,target_name,target_type,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordaliasAPI.
+			RecordaliasReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordaliasDisappears(ctx context.Context, v *dns.RecordAlias) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordaliasAPI.
+			RecordaliasReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordaliasBasicConfig(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+}
+`, name, view)
+}
+
+func testAccRecordaliasComment(name, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_comment" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+	comment = %q
+}
+`, name, view, comment)
+}
+
+func testAccRecordaliasCreator(name, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_creator" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+	creator = %q
+}
+`, name, view, creator)
+}
+
+func testAccRecordaliasDisable(name, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_disable" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+	disable = %q
+}
+`, name, view, disable)
+}
+
+func testAccRecordaliasExtattrs(name, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_extattrs" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+	extattrs = %s
+}
+`, name, view, extattrsStr)
+}
+
+func testAccRecordaliasName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_name" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+}
+`, name)
+}
+
+func testAccRecordaliasTtl(name, view string, ttl int32, useTtl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_ttl" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, view, ttl, useTtl)
+}
+
+func testAccRecordaliasUseTtl(name, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_use_ttl" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = "A"
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, view, useTtl, ttl)
+}
+
+func testAccRecordaliasTargetName(name, targetName string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_target_name" {
+	name = %q
+	target_name = %q
+	target_type = "A"
+}
+`, name, targetName)
+}
+
+func testAccRecordaliasTargetType(name, targetType string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_target_type" {
+	name = %q
+	target_name = "lb.cloud.example.net"
+	target_type = %q
+}
+`, name, targetType)
+}
+
+func testAccRecordaliasTarget(name, targetName, targetType string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_alias_record" "test_target" {
+	name = %q
+	target_name = %q
+	target_type = %q
+}
+`, name, targetName, targetType)
+}
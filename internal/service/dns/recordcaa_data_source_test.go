@@ -0,0 +1,121 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordcaaDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_caa_records.test"
+	resourceName := "nios_dns_caa_record.test"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordcaaDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordcaaDataSourceConfigFilters(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordcaaResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordcaaDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_caa_records.test"
+	resourceName := "nios_dns_caa_record.test"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordcaaDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordcaaDataSourceConfigTagFilters(name, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordcaaResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordcaaResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "ca_flag", dataSourceName, "result.0.ca_flag"),
+		resource.TestCheckResourceAttrPair(resourceName, "ca_tag", dataSourceName, "result.0.ca_tag"),
+		resource.TestCheckResourceAttrPair(resourceName, "ca_value", dataSourceName, "result.0.ca_value"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_principal", dataSourceName, "result.0.ddns_principal"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_protected", dataSourceName, "result.0.ddns_protected"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "forbid_reclamation", dataSourceName, "result.0.forbid_reclamation"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordcaaDataSourceConfigFilters(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+}
+
+data "nios_dns_caa_records" "test" {
+	filters = {
+		"name": nios_dns_caa_record.test.name
+	}
+}
+`, name, view)
+}
+
+func testAccRecordcaaDataSourceConfigTagFilters(name, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_caa_records" "test" {
+	filters = {
+		"*Site" = nios_dns_caa_record.test.extattrs.Site.value
+	}
+}
+`, name, view, extAttrsValue)
+}
@@ -0,0 +1,229 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordcaa = "cloud_info,ca_flag,ca_tag,ca_value,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,extattrs,forbid_reclamation,last_queried,name,reclaimable,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordcaaResource{}
+var _ resource.ResourceWithImportState = &RecordcaaResource{}
+var _ resource.ResourceWithModifyPlan = &RecordcaaResource{}
+
+func NewRecordcaaResource() resource.Resource {
+	return &RecordcaaResource{}
+}
+
+// RecordcaaResource defines the resource implementation.
+type RecordcaaResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordcaaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_caa_record"
+}
+
+func (r *RecordcaaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordCAAResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordcaaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordcaaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:caa", readableAttributesForRecordcaa) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the record
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name", "view")
+	r.checkValue(ctx, req, resp)
+}
+
+// checkValue rejects a CAA property value that does not have the format RFC 8659 defines for its tag.
+func (r *RecordcaaResource) checkValue(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordCAAModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.CaTag.IsUnknown() || plan.CaValue.IsUnknown() {
+		return
+	}
+
+	if err := checkCAAValue(plan.CaTag.ValueString(), plan.CaValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ca_value"), "Invalid CAA property value",
+			fmt.Sprintf("The value of the %s property of the CAA record %s %s, got: %s", plan.CaTag.ValueString(), plan.Name.ValueString(), err, plan.CaValue.ValueString()))
+	}
+}
+
+func (r *RecordcaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordCAAModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordCaa := data.Expand(ctx, &resp.Diagnostics, true)
+	recordCaa.Extattrs = utils.MergeDefaultExtAttrs(recordCaa.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordcaaAPI.
+		Post(ctx).
+		RecordCaa(*recordCaa).
+		ReturnFields2(readableAttributesForRecordcaa).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordcaa", err, httpRes, RecordCAAResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordcaaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordCAAModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordcaaAPI.
+		RecordcaaReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordcaa).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordcaa", err, httpRes, RecordCAAResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordcaaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordCAAModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordCaa := data.Expand(ctx, &resp.Diagnostics, false)
+	recordCaa.Extattrs = utils.MergeDefaultExtAttrs(recordCaa.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordcaaAPI.
+		RecordcaaReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordCaa(*recordCaa).
+		ReturnFields2(readableAttributesForRecordcaa).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordcaa", err, httpRes, RecordCAAResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordcaaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordCAAModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordcaaAPI.
+		RecordcaaReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordcaa", err, httpRes, RecordCAAResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the record.
+func (r *RecordcaaResource) flatten(ctx context.Context, data *RecordCAAModel, res *dns.RecordCaa, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *RecordcaaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,799 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordcaaResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_caa_record.test"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordcaaDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordcaaBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					testAccCheckRecordcaaDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordcaaResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_comment"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_creator"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaCreator(name, "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaCreator(name, "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_ddns_principal"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaDdnsPrincipal(name, "default", "host/caa.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/caa.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaDdnsPrincipal(name, "default", "host/caa2.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/caa2.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_ddns_protected"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaDdnsProtected(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaDdnsProtected(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_disable"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaDisable(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaDisable(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_extattrs"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_forbid_reclamation"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaForbidReclamation(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaForbidReclamation(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_name"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaName(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_ttl"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaTtl(name, "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaTtl(name, "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_use_ttl"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaUseTtl(name, "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaUseTtl(name, "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:caa"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordcaaComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "ca_flag", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ca_tag", "issue"),
+					resource.TestCheckResourceAttr(resourceName, "ca_value", "ca.example.net"),
+					resource.TestCheckResourceAttr(resourceName, "dns_name", name),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordcaaComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordcaaComment(name, "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordcaaImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_CaFlag(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_ca_flag"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaCaFlag(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ca_flag", "0"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaCaFlag(name, 128),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ca_flag", "128"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_CaTag(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_ca_tag"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaCaTag(name, "issue", "ca.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ca_tag", "issue"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaCaTag(name, "issuewild", "ca.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ca_tag", "issuewild"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_CaValue(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_ca_value"
+	var v dns.RecordCaa
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcaaCaValue(name, "ca.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ca_value", "ca.example.net"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcaaCaValue(name, "ca.example.net; account=230123"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ca_value", "ca.example.net; account=230123"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcaaResource_PropertyValue(t *testing.T) {
+	var resourceName = "nios_dns_caa_record.test_ca_tag"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:caa"),
+		Steps: []resource.TestStep{
+			// The iodef property is a URL
+			{
+				Config: fake.ProviderConfig() + testAccRecordcaaCaTag(name, "iodef", "mailto:security@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ca_tag", "iodef"),
+					resource.TestCheckResourceAttr(resourceName, "ca_value", "mailto:security@example.com"),
+				),
+			},
+			// An iodef property without a scheme is rejected
+			{
+				Config:      fake.ProviderConfig() + testAccRecordcaaCaTag(name, "iodef", "security@example.com"),
+				ExpectError: regexp.MustCompile("Invalid CAA property value"),
+			},
+			// An issue property is the domain name of the issuer, not a URL
+			{
+				Config:      fake.ProviderConfig() + testAccRecordcaaCaTag(name, "issue", "https://ca.example.net"),
+				ExpectError: regexp.MustCompile("Invalid CAA property value"),
+			},
+		},
+	})
+}
+
+func testAccRecordcaaImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordcaaExists(ctx context.Context, resourceName string, v *dns.RecordCaa) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "cloud_info,ca_flag,ca_tag,ca_value,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,extattrs,forbid_reclamation,last_queried,name,reclaimable,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordcaaAPI.
+			RecordcaaReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordcaaDestroy(ctx context.Context, v *dns.RecordCaa) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "cloud_info,ca_flag,ca_tag,ca_value,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,extattrs,forbid_reclamation,last_queried,name,reclaimable,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordcaaAPI.
+			RecordcaaReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordcaaDisappears(ctx context.Context, v *dns.RecordCaa) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordcaaAPI.
+			RecordcaaReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordcaaBasicConfig(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+}
+`, name, view)
+}
+
+func testAccRecordcaaComment(name, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_comment" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	comment = %q
+}
+`, name, view, comment)
+}
+
+func testAccRecordcaaCreator(name, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_creator" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	creator = %q
+}
+`, name, view, creator)
+}
+
+func testAccRecordcaaDdnsPrincipal(name, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_ddns_principal" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	ddns_principal = %q
+}
+`, name, view, ddnsPrincipal)
+}
+
+func testAccRecordcaaDdnsProtected(name, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_ddns_protected" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	ddns_protected = %q
+}
+`, name, view, ddnsProtected)
+}
+
+func testAccRecordcaaDisable(name, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_disable" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	disable = %q
+}
+`, name, view, disable)
+}
+
+func testAccRecordcaaExtattrs(name, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_extattrs" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	extattrs = %s
+}
+`, name, view, extattrsStr)
+}
+
+func testAccRecordcaaForbidReclamation(name, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_forbid_reclamation" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, view, forbidReclamation)
+}
+
+func testAccRecordcaaName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_name" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+}
+`, name)
+}
+
+func testAccRecordcaaTtl(name, view string, ttl int32, useTtl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_ttl" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, view, ttl, useTtl)
+}
+
+func testAccRecordcaaUseTtl(name, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_use_ttl" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, view, useTtl, ttl)
+}
+
+func testAccRecordcaaCaFlag(name string, caFlag int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_ca_flag" {
+	name = %q
+	ca_flag = %d
+	ca_tag = "issue"
+	ca_value = "ca.example.net"
+}
+`, name, caFlag)
+}
+
+func testAccRecordcaaCaTag(name, caTag, caValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_ca_tag" {
+	name = %q
+	ca_flag = 0
+	ca_tag = %q
+	ca_value = %q
+}
+`, name, caTag, caValue)
+}
+
+func testAccRecordcaaCaValue(name, caValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_caa_record" "test_ca_value" {
+	name = %q
+	ca_flag = 0
+	ca_tag = "issue"
+	ca_value = %q
+}
+`, name, caValue)
+}
@@ -0,0 +1,250 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"strings"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordcname = "aws_rte53_record_info,canonical,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_canonical,dns_name,extattrs,forbid_reclamation,last_queried,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordcnameResource{}
+var _ resource.ResourceWithImportState = &RecordcnameResource{}
+var _ resource.ResourceWithModifyPlan = &RecordcnameResource{}
+
+func NewRecordcnameResource() resource.Resource {
+	return &RecordcnameResource{}
+}
+
+// RecordcnameResource defines the resource implementation.
+type RecordcnameResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordcnameResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_cname_record"
+}
+
+func (r *RecordcnameResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordCNAMEResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordcnameResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordcnameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:cname", readableAttributesForRecordcname) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the record
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name", "view")
+	r.checkName(ctx, req, resp)
+}
+
+// checkName rejects the names a CNAME record cannot have: its own canonical name, and the apex of a zone, where
+// the SOA and NS records of the zone are. The apex is looked up on the grid when the name or the view changes.
+func (r *RecordcnameResource) checkName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordCNAMEModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.View.IsUnknown() {
+		return
+	}
+
+	if strings.EqualFold(plan.Name.ValueString(), plan.Canonical.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("canonical"), "Invalid canonical name",
+			fmt.Sprintf("The canonical name of the CNAME record %s cannot be the name of the record itself.", plan.Name.ValueString()))
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state RecordCNAMEModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (state.Name.Equal(plan.Name) && state.View.Equal(plan.View)) {
+			return
+		}
+	}
+
+	isApex, httpRes, err := utils.IsZoneApex(ctx, r.client, plan.Name.ValueString(), plan.View.ValueString())
+	if err != nil {
+		utils.AddWAPIError(&resp.Diagnostics, "read", "ZoneAuth", err, httpRes)
+		return
+	}
+	if isApex {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "CNAME record at a zone apex",
+			fmt.Sprintf("%s is the name of a zone. A CNAME record cannot be placed at a zone apex, where the SOA and NS records of the zone are.", plan.Name.ValueString()))
+	}
+}
+
+func (r *RecordcnameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordCNAMEModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordCname := data.Expand(ctx, &resp.Diagnostics, true)
+	recordCname.Extattrs = utils.MergeDefaultExtAttrs(recordCname.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordcnameAPI.
+		Post(ctx).
+		RecordCname(*recordCname).
+		ReturnFields2(readableAttributesForRecordcname).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordcname", err, httpRes, RecordCNAMEResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordcnameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordCNAMEModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordcnameAPI.
+		RecordcnameReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordcname).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordcname", err, httpRes, RecordCNAMEResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordcnameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordCNAMEModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordCname := data.Expand(ctx, &resp.Diagnostics, false)
+	recordCname.Extattrs = utils.MergeDefaultExtAttrs(recordCname.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordcnameAPI.
+		RecordcnameReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordCname(*recordCname).
+		ReturnFields2(readableAttributesForRecordcname).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordcname", err, httpRes, RecordCNAMEResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordcnameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordCNAMEModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordcnameAPI.
+		RecordcnameReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordcname", err, httpRes, RecordCNAMEResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the record.
+func (r *RecordcnameResource) flatten(ctx context.Context, data *RecordCNAMEModel, res *dns.RecordCname, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *RecordcnameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
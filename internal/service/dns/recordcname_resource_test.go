@@ -0,0 +1,692 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordcnameResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameBasicConfig(name, "target.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_cname_record.test"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordcnameDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordcnameBasicConfig(name, "target.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					testAccCheckRecordcnameDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordcnameResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_comment"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameComment(name, "target.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameComment(name, "target.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_creator"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameCreator(name, "target.example.com", "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameCreator(name, "target.example.com", "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_ddns_principal"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameDdnsPrincipal(name, "target.example.com", "default", "host/cname.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/cname.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameDdnsPrincipal(name, "target.example.com", "default", "host/cname-updated.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/cname-updated.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_ddns_protected"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameDdnsProtected(name, "target.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameDdnsProtected(name, "target.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_disable"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameDisable(name, "target.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameDisable(name, "target.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_extattrs"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameExtattrs(name, "target.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameExtattrs(name, "target.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_forbid_reclamation"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameForbidReclamation(name, "target.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameForbidReclamation(name, "target.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_Canonical(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_canonical"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameCanonical(name, "target1.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "canonical", "target1.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameCanonical(name, "target2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "canonical", "target2.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_name"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameName(name, "target.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameName(updatedName, "target.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_ttl"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameTtl(name, "target.example.com", "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameTtl(name, "target.example.com", "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_use_ttl"
+	var v dns.RecordCname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordcnameUseTtl(name, "target.example.com", "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordcnameUseTtl(name, "target.example.com", "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordcnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_cname_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:cname"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordcnameComment(name, "target.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "canonical", "target.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "dns_canonical", "target.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordcnameComment(name, "target.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "canonical", "target.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordcnameComment(name, "target.example.com", "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordcnameImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordcnameResource_ZoneApex(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	if _, err := fake.Create("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default"}); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:cname"),
+		Steps: []resource.TestStep{
+			// A CNAME record cannot be placed at the apex of a zone
+			{
+				Config:      fake.ProviderConfig() + testAccRecordcnameBasicConfig("example.com", "target.example.org", "default"),
+				ExpectError: regexp.MustCompile("CNAME record at a zone apex"),
+			},
+			// The same name is valid in a view without the zone
+			{
+				Config:             fake.ProviderConfig() + testAccRecordcnameBasicConfig("example.com", "target.example.org", "internal"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// A CNAME record cannot point to itself
+			{
+				Config:      fake.ProviderConfig() + testAccRecordcnameBasicConfig("www.example.com", "WWW.example.com", "default"),
+				ExpectError: regexp.MustCompile("Invalid canonical name"),
+			},
+		},
+	})
+}
+
+func testAccRecordcnameImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordcnameExists(ctx context.Context, resourceName string, v *dns.RecordCname) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,canonical,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_canonical,dns_name,extattrs,forbid_reclamation,last_queried,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		//rs.Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordcnameAPI.
+			RecordcnameReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordcnameDestroy(ctx context.Context, v *dns.RecordCname) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,canonical,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_canonical,dns_name,extattrs,forbid_reclamation,last_queried,name,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordcnameAPI.
+			RecordcnameReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordcnameDisappears(ctx context.Context, v *dns.RecordCname) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordcnameAPI.
+			RecordcnameReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordcnameBasicConfig(name, canonical, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test" {
+	name = %q
+	canonical = %q
+	view = %q
+}
+`, name, canonical, view)
+}
+
+func testAccRecordcnameComment(name, canonical, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_comment" {
+	name = %q
+	canonical = %q
+	view = %q
+	comment = %q
+}
+`, name, canonical, view, comment)
+}
+
+func testAccRecordcnameCreator(name, canonical, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_creator" {
+	name = %q
+	canonical = %q
+	view = %q  
+	creator = %q
+}
+`, name, canonical, view, creator)
+}
+
+func testAccRecordcnameDdnsPrincipal(name, canonical, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_ddns_principal" {
+	name = %q
+	canonical = %q
+	view = %q
+	ddns_principal = %q
+}
+`, name, canonical, view, ddnsPrincipal)
+}
+
+func testAccRecordcnameDdnsProtected(name, canonical, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_ddns_protected" {
+	name = %q
+	canonical = %q
+	view = %q
+	ddns_protected = %q
+}
+`, name, canonical, view, ddnsProtected)
+}
+
+func testAccRecordcnameDisable(name, canonical, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_disable" {
+	name = %q
+	canonical = %q
+	view = %q
+	disable = %q
+}
+`, name, canonical, view, disable)
+}
+
+func testAccRecordcnameExtattrs(name, canonical, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_extattrs" {
+	name = %q
+	canonical = %q
+	view = %q
+	extattrs = %s
+}
+`, name, canonical, view, extattrsStr)
+}
+
+func testAccRecordcnameForbidReclamation(name, canonical, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_forbid_reclamation" {
+	name = %q
+	canonical = %q
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, canonical, view, forbidReclamation)
+}
+
+func testAccRecordcnameCanonical(name, canonical string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_canonical" {
+	name = %q
+	canonical = %q
+}
+`, name, canonical)
+}
+
+func testAccRecordcnameName(name, canonical string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_name" {
+	name = %q
+	canonical = %q
+}
+`, name, canonical)
+}
+
+func testAccRecordcnameTtl(name, canonical, view string, ttl int32, use_ttl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_ttl" {
+	name = %q
+	canonical = %q
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, canonical, view, ttl, use_ttl)
+}
+
+func testAccRecordcnameUseTtl(name, canonical, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_cname_record" "test_use_ttl" {
+	name = %q
+	canonical = %q
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, canonical, view, useTtl, ttl)
+}
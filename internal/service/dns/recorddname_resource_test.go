@@ -0,0 +1,681 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecorddnameResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_dname_record.test"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecorddnameDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecorddnameBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					testAccCheckRecorddnameDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecorddnameResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_comment"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_creator"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameCreator(name, "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameCreator(name, "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_ddns_principal"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameDdnsPrincipal(name, "default", "host/dname.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/dname.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameDdnsPrincipal(name, "default", "host/dname2.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/dname2.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_ddns_protected"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameDdnsProtected(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameDdnsProtected(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_disable"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameDisable(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameDisable(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_extattrs"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_forbid_reclamation"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameForbidReclamation(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameForbidReclamation(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_name"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameName(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_ttl"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameTtl(name, "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameTtl(name, "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_use_ttl"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameUseTtl(name, "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameUseTtl(name, "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:dname"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecorddnameComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "target", "example.net"),
+					resource.TestCheckResourceAttr(resourceName, "dns_target", "example.net"),
+					resource.TestCheckResourceAttr(resourceName, "dns_name", name),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecorddnameComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecorddnameComment(name, "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecorddnameImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_Target(t *testing.T) {
+	var resourceName = "nios_dns_dname_record.test_target"
+	var v dns.RecordDname
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecorddnameTarget(name, "example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target", "example.net"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecorddnameTarget(name, "example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecorddnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target", "example.org"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecorddnameResource_TargetLoop(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The target cannot be the name of the record
+			{
+				Config:      fake.ProviderConfig() + testAccRecorddnameTarget("old.example.com", "old.example.com"),
+				ExpectError: regexp.MustCompile("Invalid DNAME target"),
+			},
+			// The target cannot be under the name of the record either
+			{
+				Config:      fake.ProviderConfig() + testAccRecorddnameTarget("old.example.com", "new.old.example.com"),
+				ExpectError: regexp.MustCompile("Invalid DNAME target"),
+			},
+		},
+	})
+}
+
+func testAccRecorddnameImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecorddnameExists(ctx context.Context, resourceName string, v *dns.RecordDname) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,dns_target,extattrs,forbid_reclamation,last_queried,name,reclaimable,shared_record_group,target,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecorddnameAPI.
+			RecorddnameReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecorddnameDestroy(ctx context.Context, v *dns.RecordDname) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,dns_target,extattrs,forbid_reclamation,last_queried,name,reclaimable,shared_record_group,target,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecorddnameAPI.
+			RecorddnameReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecorddnameDisappears(ctx context.Context, v *dns.RecordDname) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecorddnameAPI.
+			RecorddnameReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecorddnameBasicConfig(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test" {
+	name = %q
+	target = "example.net"
+	view = %q
+}
+`, name, view)
+}
+
+func testAccRecorddnameComment(name, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_comment" {
+	name = %q
+	target = "example.net"
+	view = %q
+	comment = %q
+}
+`, name, view, comment)
+}
+
+func testAccRecorddnameCreator(name, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_creator" {
+	name = %q
+	target = "example.net"
+	view = %q
+	creator = %q
+}
+`, name, view, creator)
+}
+
+func testAccRecorddnameDdnsPrincipal(name, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_ddns_principal" {
+	name = %q
+	target = "example.net"
+	view = %q
+	ddns_principal = %q
+}
+`, name, view, ddnsPrincipal)
+}
+
+func testAccRecorddnameDdnsProtected(name, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_ddns_protected" {
+	name = %q
+	target = "example.net"
+	view = %q
+	ddns_protected = %q
+}
+`, name, view, ddnsProtected)
+}
+
+func testAccRecorddnameDisable(name, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_disable" {
+	name = %q
+	target = "example.net"
+	view = %q
+	disable = %q
+}
+`, name, view, disable)
+}
+
+func testAccRecorddnameExtattrs(name, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_extattrs" {
+	name = %q
+	target = "example.net"
+	view = %q
+	extattrs = %s
+}
+`, name, view, extattrsStr)
+}
+
+func testAccRecorddnameForbidReclamation(name, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_forbid_reclamation" {
+	name = %q
+	target = "example.net"
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, view, forbidReclamation)
+}
+
+func testAccRecorddnameName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_name" {
+	name = %q
+	target = "example.net"
+}
+`, name)
+}
+
+func testAccRecorddnameTtl(name, view string, ttl int32, useTtl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_ttl" {
+	name = %q
+	target = "example.net"
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, view, ttl, useTtl)
+}
+
+func testAccRecorddnameUseTtl(name, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_use_ttl" {
+	name = %q
+	target = "example.net"
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, view, useTtl, ttl)
+}
+
+func testAccRecorddnameTarget(name, target string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_dname_record" "test_target" {
+	name = %q
+	target = %q
+}
+`, name, target)
+}
@@ -0,0 +1,120 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordhostDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_host_records.test"
+	resourceName := "nios_dns_host_record.test"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordhostDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordhostDataSourceConfigFilters(name, acctest.RandomIP(), "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordhostResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordhostDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_host_records.test"
+	resourceName := "nios_dns_host_record.test"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordhostDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordhostDataSourceConfigTagFilters(name, acctest.RandomIP(), "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordhostResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordhostResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "aliases", dataSourceName, "result.0.aliases"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "configure_for_dns", dataSourceName, "result.0.configure_for_dns"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_protected", dataSourceName, "result.0.ddns_protected"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "ipv4addrs.#", dataSourceName, "result.0.ipv4addrs.#"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "network_view", dataSourceName, "result.0.network_view"),
+		resource.TestCheckResourceAttrPair(resourceName, "rrset_order", dataSourceName, "result.0.rrset_order"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordhostDataSourceConfigFilters(name, ipv4addr, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test" {
+	name = %q
+	view = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+}
+
+data "nios_dns_host_records" "test" {
+	filters = {
+		"name": nios_dns_host_record.test.name
+	}
+}
+`, name, view, ipv4addr)
+}
+
+func testAccRecordhostDataSourceConfigTagFilters(name, ipv4addr, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test" {
+	name = %q
+	view = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_host_records" "test" {
+	filters = {
+		"*Site" = nios_dns_host_record.test.extattrs.Site.value
+	}
+}
+`, name, view, ipv4addr, extAttrsValue)
+}
@@ -0,0 +1,469 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/customtypes"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"maps"
+	"net/http"
+	"slices"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordhost = "aliases,cloud_info,comment,configure_for_dns,creation_time,ddns_protected,device_description,device_location,device_type,device_vendor,disable,disable_discovery,dns_aliases,dns_name,extattrs,ipv4addrs,ipv6addrs,last_queried,ms_ad_user_data,name,network_view,rrset_order,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordhostResource{}
+var _ resource.ResourceWithImportState = &RecordhostResource{}
+var _ resource.ResourceWithModifyPlan = &RecordhostResource{}
+
+func NewRecordhostResource() resource.Resource {
+	return &RecordhostResource{}
+}
+
+// RecordhostResource defines the resource implementation.
+type RecordhostResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordhostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_host_record"
+}
+
+func (r *RecordhostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordHostResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordhostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordhostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:host", readableAttributesForRecordhost) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the record
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name", "view")
+	r.planAddresses(ctx, req, resp)
+	r.checkAddresses(ctx, req, resp)
+}
+
+// planAddresses plans the computed attributes of the host addresses. An address keeps the reference and the network
+// it has in the state, whatever its position in the set, so that reordering the addresses does not cause a diff.
+// An address allocated by a function call keeps the address it has in the state, it is never allocated again.
+// The host of an address is the name of the record, and its reference changes when the record is renamed.
+func (r *RecordhostResource) planAddresses(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state RecordHostModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	renamed := !plan.Name.Equal(state.Name)
+	ipv4addrs := planHostAddresses(ctx, plan.Ipv4addrs, state.Ipv4addrs, "ipv4addr", plan.Name, renamed, &resp.Diagnostics)
+	ipv6addrs := planHostAddresses(ctx, plan.Ipv6addrs, state.Ipv6addrs, "ipv6addr", plan.Name, renamed, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ipv4addrs"), ipv4addrs)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ipv6addrs"), ipv6addrs)...)
+}
+
+// planHostAddresses returns the planned host addresses with their computed attributes, looking up the current
+// addresses by their key attribute.
+func planHostAddresses(ctx context.Context, planned, current types.Set, key string, host types.String, renamed bool, diags *diag.Diagnostics) types.Set {
+	if planned.IsNull() || planned.IsUnknown() {
+		return planned
+	}
+
+	var currentAddresses []map[string]attr.Value
+	currentByAddress := make(map[string]map[string]attr.Value)
+	if !current.IsNull() && !current.IsUnknown() {
+		for _, e := range current.Elements() {
+			attributes := e.(types.Object).Attributes()
+			currentAddresses = append(currentAddresses, attributes)
+			currentByAddress[hostAddressKey(attributes[key])] = attributes
+		}
+	}
+
+	// An address allocated by a function call is kept, the current address with the same function call that is not
+	// otherwise planned is looked up
+	taken := make(map[string]bool)
+	for _, e := range planned.Elements() {
+		taken[hostAddressKey(e.(types.Object).Attributes()[key])] = true
+	}
+
+	elems := make([]attr.Value, 0, len(planned.Elements()))
+	for _, e := range planned.Elements() {
+		o := e.(types.Object)
+		attributes := maps.Clone(o.Attributes())
+		attributes["host"] = host
+		attributes["ref"] = types.StringUnknown()
+		attributes["network"] = types.StringUnknown()
+		if funcCall := attributes["func_call"]; hostAddressKey(attributes[key]) == "" && !funcCall.IsNull() {
+			for _, c := range currentAddresses {
+				if address := hostAddressKey(c[key]); !taken[address] && funcCall.Equal(c["func_call"]) {
+					attributes[key] = c[key]
+					taken[address] = true
+					break
+				}
+			}
+		}
+		if address := hostAddressKey(attributes[key]); address != "" {
+			if c, ok := currentByAddress[address]; ok {
+				attributes["network"] = c["network"]
+				if !renamed {
+					attributes["ref"] = c["ref"]
+				}
+			}
+		}
+		elem, d := types.ObjectValue(o.AttributeTypes(ctx), attributes)
+		diags.Append(d...)
+		elems = append(elems, elem)
+	}
+	s, d := types.SetValue(planned.ElementType(ctx), elems)
+	diags.Append(d...)
+	return s
+}
+
+// hostAddressKey returns the address of a host address, in its canonical form for an IPv6 address, or an empty
+// string when it is unknown.
+func hostAddressKey(v attr.Value) string {
+	switch a := v.(type) {
+	case customtypes.IPv6Address:
+		if a.IsNull() || a.IsUnknown() {
+			return ""
+		}
+		return a.ValueIPv6Address()
+	case types.String:
+		return a.ValueString()
+	}
+	return ""
+}
+
+// checkAddresses rejects the host records WAPI would refuse: a host address configured for DHCP needs a MAC
+// address, or a DUID for an IPv6 address, and the aliases of a host are DNS records which need configure_for_dns.
+func (r *RecordhostResource) checkAddresses(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordHostModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ConfigureForDns.IsUnknown() && !plan.ConfigureForDns.ValueBool() && !plan.Aliases.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("aliases"), "Aliases of a host not in DNS",
+			fmt.Sprintf("The host %s has aliases, which are DNS records: configure_for_dns must be true.", plan.Name.ValueString()))
+	}
+
+	if !plan.Ipv4addrs.IsUnknown() {
+		var ipv4addrs []RecordHostIpv4addrModel
+		resp.Diagnostics.Append(plan.Ipv4addrs.ElementsAs(ctx, &ipv4addrs, false)...)
+		for _, a := range ipv4addrs {
+			if a.ConfigureForDhcp.ValueBool() && !a.Mac.IsUnknown() && a.Mac.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(path.Root("ipv4addrs"), "Missing MAC address",
+					fmt.Sprintf("The host address %s is configured for DHCP and needs a MAC address.", a.Ipv4addr.ValueString()))
+			}
+		}
+	}
+	if !plan.Ipv6addrs.IsUnknown() {
+		var ipv6addrs []RecordHostIpv6addrModel
+		resp.Diagnostics.Append(plan.Ipv6addrs.ElementsAs(ctx, &ipv6addrs, false)...)
+		for _, a := range ipv6addrs {
+			if a.ConfigureForDhcp.ValueBool() && !a.Duid.IsUnknown() && a.Duid.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(path.Root("ipv6addrs"), "Missing DUID",
+					fmt.Sprintf("The IPv6 host address %s is configured for DHCP and needs a DUID.", a.Ipv6addr.ValueString()))
+			}
+		}
+	}
+}
+
+func (r *RecordhostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordHostModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordHost := data.Expand(ctx, &resp.Diagnostics, true)
+	recordHost.Extattrs = utils.MergeDefaultExtAttrs(recordHost.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	// Concurrent allocations from the same network or range could return the same address
+	unlock := lockFuncCalls(ctx, r.client, &resp.Diagnostics, hostFuncCalls(recordHost)...)
+	defer unlock()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordhostAPI.
+		Post(ctx).
+		RecordHost(*recordHost).
+		ReturnFields2(readableAttributesForRecordhost).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordhost", err, httpRes, RecordHostResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordhostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordHostModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordhostAPI.
+		RecordhostReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordhost).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordhost", err, httpRes, RecordHostResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordhostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordHostModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordHost := data.Expand(ctx, &resp.Diagnostics, false)
+	recordHost.Extattrs = utils.MergeDefaultExtAttrs(recordHost.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	// Concurrent allocations from the same network or range could return the same address
+	unlock := lockFuncCalls(ctx, r.client, &resp.Diagnostics, hostFuncCalls(recordHost)...)
+	defer unlock()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordhostAPI.
+		RecordhostReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordHost(*recordHost).
+		ReturnFields2(readableAttributesForRecordhost).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordhost", err, httpRes, RecordHostResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordhostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordHostModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordhostAPI.
+		RecordhostReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordhost", err, httpRes, RecordHostResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the record. The host addresses are kept as they are set in data, and a
+// host that is not in DNS, which WAPI returns without a view, keeps the view of data.
+func (r *RecordhostResource) flatten(ctx context.Context, data *RecordHostModel, res *dns.RecordHost, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	view := data.View
+	ipv4addrs, ipv6addrs := data.Ipv4addrs, data.Ipv6addrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+	data.Ipv4addrs = keepConfiguredAddresses(ctx, ipv4addrs, data.Ipv4addrs, "ipv4addr", RecordHostIpv4addrResourceSchemaAttributes, diags)
+	data.Ipv6addrs = keepConfiguredAddresses(ctx, ipv6addrs, data.Ipv6addrs, "ipv6addr", RecordHostIpv6addrResourceSchemaAttributes, diags)
+	if data.View.IsNull() {
+		data.View = view
+		if view.IsNull() {
+			data.View = types.StringValue("default")
+		}
+	}
+}
+
+// keepConfiguredAddresses returns the flattened host addresses as they are set in prior, the addresses being matched
+// by their key attribute. WAPI returns the optional attributes that are not set with their zero value, they are null
+// unless prior sets them, and an IPv6 address keeps the notation of prior. The addresses allocated by a function call
+// are unknown in prior, they are matched with the remaining flattened addresses and keep their function call.
+func keepConfiguredAddresses(ctx context.Context, prior, flattened types.Set, key string, attributes map[string]schema.Attribute, diags *diag.Diagnostics) types.Set {
+	if flattened.IsNull() || flattened.IsUnknown() {
+		return flattened
+	}
+
+	priorByAddress := make(map[string]map[string]attr.Value)
+	var allocated []map[string]attr.Value
+	if !prior.IsNull() && !prior.IsUnknown() {
+		for _, e := range prior.Elements() {
+			values := e.(types.Object).Attributes()
+			if address := hostAddressKey(values[key]); address != "" {
+				priorByAddress[address] = values
+			} else if !values["func_call"].IsNull() {
+				allocated = append(allocated, values)
+			}
+		}
+	}
+
+	elems := make([]attr.Value, 0, len(flattened.Elements()))
+	for _, e := range flattened.Elements() {
+		o := e.(types.Object)
+		values := maps.Clone(o.Attributes())
+		p, found := priorByAddress[hostAddressKey(values[key])]
+		if found {
+			values[key] = p[key]
+		} else if i := allocatedAddress(allocated, values["network"]); i >= 0 {
+			p, found = allocated[i], true
+			allocated = slices.Delete(allocated, i, i+1)
+		}
+		if found {
+			values["func_call"] = p["func_call"]
+		}
+		for name, a := range attributes {
+			if !a.IsOptional() || (found && !p[name].IsNull()) {
+				continue
+			}
+			switch v := values[name].(type) {
+			case types.Bool:
+				if !v.ValueBool() {
+					values[name] = types.BoolNull()
+				}
+			case types.String:
+				if v.ValueString() == "" {
+					values[name] = types.StringNull()
+				}
+			}
+		}
+		elem, d := types.ObjectValue(o.AttributeTypes(ctx), values)
+		diags.Append(d...)
+		elems = append(elems, elem)
+	}
+	s, d := types.SetValue(flattened.ElementType(ctx), elems)
+	diags.Append(d...)
+	return s
+}
+
+// allocatedAddress returns the index of the prior address whose function call allocated an address of the given
+// network, preferring the function calls searching this network, or -1 if there is none.
+func allocatedAddress(allocated []map[string]attr.Value, network attr.Value) int {
+	if len(allocated) == 0 {
+		return -1
+	}
+	for i, p := range allocated {
+		funcCall, ok := p["func_call"].(types.Object)
+		if !ok {
+			continue
+		}
+		if parameters, ok := funcCall.Attributes()["object_parameters"].(types.Map); ok {
+			if n, ok := parameters.Elements()["network"]; ok && n.Equal(network) {
+				return i
+			}
+		}
+	}
+	return 0
+}
+
+// hostFuncCalls returns the function calls allocating the addresses of a host.
+func hostFuncCalls(recordHost *dns.RecordHost) []*dns.RecordAIpv4addrOneOf {
+	var funcCalls []*dns.RecordAIpv4addrOneOf
+	for _, a := range recordHost.Ipv4addrs {
+		funcCalls = append(funcCalls, (*dns.RecordAIpv4addrOneOf)(a.GetIpv4addr().RecordHostIpv4addrIpv4addrOneOf))
+	}
+	for _, a := range recordHost.Ipv6addrs {
+		funcCalls = append(funcCalls, (*dns.RecordAIpv4addrOneOf)(a.GetIpv6addr().RecordHostIpv6addrIpv6addrOneOf))
+	}
+	return funcCalls
+}
+
+func (r *RecordhostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,805 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordhostResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostBasicConfig(name, ip, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{
+						"ipv4addr": ip,
+						"host":     name,
+					}),
+					// Test fields with default value
+					resource.TestCheckResourceAttr(resourceName, "configure_for_dns", "true"),
+					resource.TestCheckResourceAttr(resourceName, "network_view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "rrset_order", "cyclic"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_host_record.test"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordhostDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordhostBasicConfig(name, acctest.RandomIP(), "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					testAccCheckRecordhostDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordhostResource_Aliases(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_aliases"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostAliases(name, ip, []string{"www." + name}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aliases.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "aliases.*", "www."+name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostAliases(name, ip, []string{"www." + name, "ftp." + name}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "aliases.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "aliases.*", "ftp."+name),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_comment"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostComment(name, ip, "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostComment(name, ip, "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_ConfigureForDns(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_configure_for_dns"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostConfigureForDns(name, ip, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configure_for_dns", "false"),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostConfigureForDns(name, ip, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "configure_for_dns", "true"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_disable"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostDisable(name, ip, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostDisable(name, ip, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_extattrs"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostExtattrs(name, ip, map[string]string{"Site": "Blr"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", "Blr"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostExtattrs(name, ip, map[string]string{"Site": "Pune"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", "Pune"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_Ipv4addrs(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_ipv4addrs"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip1, ip2, ip3 := acctest.RandomIP(), acctest.RandomIP(), acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostIpv4addrs(name, ip1, ip2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{"ipv4addr": ip1}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{"ipv4addr": ip2}),
+				),
+			},
+			// The order of the addresses does not matter
+			{
+				Config:   testAccRecordhostIpv4addrs(name, ip2, ip1),
+				PlanOnly: true,
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostIpv4addrs(name, ip2, ip3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{"ipv4addr": ip3}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_Ipv4addrsConfigureForDhcp(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_ipv4addrs_dhcp"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostIpv4addrsConfigureForDhcp(name, ip, "00:1a:2b:3c:4d:5e"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{
+						"ipv4addr":           ip,
+						"configure_for_dhcp": "true",
+						"mac":                "00:1a:2b:3c:4d:5e",
+					}),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostIpv4addrsConfigureForDhcp(name, ip, "00:1a:2b:3c:4d:5f"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{
+						"mac": "00:1a:2b:3c:4d:5f",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_Ipv6addrs(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_ipv6addrs"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostIpv6addrs(name, "2001:db8::10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv6addrs.*", map[string]string{"ipv6addr": "2001:db8::10"}),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostIpv6addrs(name, "2001:db8::11"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv6addrs.*", map[string]string{"ipv6addr": "2001:db8::11"}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostBasicConfig(name, ip, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostBasicConfig("updated-"+name, ip, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", "updated-"+name),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{"host": "updated-" + name}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_ttl"
+	var v dns.RecordHost
+	name := acctest.RandomName() + ".example.com"
+	ip := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhostTtl(name, ip, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhostTtl(name, ip, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhostExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_ipv4addrs"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:host"),
+		Steps: []resource.TestStep{
+			// Create and Read, the fake WAPI returns the addresses sorted
+			{
+				Config: fake.ProviderConfig() + testAccRecordhostIpv4addrs(name, "10.0.0.9", "10.0.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{
+						"ipv4addr": "10.0.0.9",
+						"host":     name,
+						"network":  "10.0.0.0/24",
+					}),
+					resource.TestCheckNoResourceAttr(resourceName, "ipv4addrs.0.configure_for_dhcp"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+				),
+			},
+			// The order of the addresses does not matter
+			{
+				Config:   fake.ProviderConfig() + testAccRecordhostIpv4addrs(name, "10.0.0.10", "10.0.0.9"),
+				PlanOnly: true,
+			},
+			// Rename the host and replace an address
+			{
+				Config: fake.ProviderConfig() + testAccRecordhostIpv4addrs("updated-"+name, "10.0.0.10", "10.0.0.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{
+						"ipv4addr": "10.0.0.11",
+						"host":     "updated-" + name,
+					}),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordhostIpv4addrs("updated-"+name, "10.0.0.10", "10.0.0.11"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordhostImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_FakeWAPIAddresses(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_addresses"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:host"),
+		Steps: []resource.TestStep{
+			// Addresses configured for DHCP, and an IPv6 address in another notation than the WAPI one
+			{
+				Config: fake.ProviderConfig() + testAccRecordhostAddresses(name, `{
+		ipv4addr           = "10.0.0.9"
+		configure_for_dhcp = true
+		mac                = "00:1a:2b:3c:4d:5e"
+	}`, `{
+		ipv6addr = "2001:DB8:0::1"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{
+						"configure_for_dhcp": "true",
+						"mac":                "00:1a:2b:3c:4d:5e",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv6addrs.*", map[string]string{
+						"ipv6addr": "2001:DB8:0::1",
+						"network":  "2001:db8::/64",
+					}),
+				),
+			},
+			// Remove the IPv4 addresses
+			{
+				Config: fake.ProviderConfig() + testAccRecordhostAddresses(name, "", `{
+		ipv6addr = "2001:db8::1"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "ipv4addrs.#"),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addrs.#", "1"),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordhostAddresses(name, `{
+		ipv4addr           = "10.0.0.9"
+		configure_for_dhcp = true
+	}`, ""),
+				ExpectError: regexp.MustCompile("Missing MAC address"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_FakeWAPIFuncCall(t *testing.T) {
+	var resourceName = "nios_dns_host_record.test_addresses"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+	if _, err := fake.Create("network", map[string]interface{}{"network": "10.1.0.0/24"}); err != nil {
+		t.Fatal(err)
+	}
+	allocated := `{
+		func_call = {
+			object            = "network"
+			object_parameters = { network = "10.1.0.0/24" }
+		}
+	}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:host"),
+		Steps: []resource.TestStep{
+			// Allocate the next available address next to a literal one
+			{
+				Config: fake.ProviderConfig() + testAccRecordhostAddresses(name, `{ ipv4addr = "10.1.0.1" }, `+allocated, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ipv4addrs.*", map[string]string{
+						"ipv4addr": "10.1.0.2",
+					}),
+				),
+			},
+			// Removing the literal address keeps the allocated one
+			{
+				Config: fake.ProviderConfig() + testAccRecordhostAddresses(name, allocated, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addrs.0.ipv4addr", "10.1.0.2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhostResource_FakeWAPIInvalid(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      fake.ProviderConfig() + testAccRecordhostAddresses(name, "", ""),
+				ExpectError: regexp.MustCompile("At least one attribute out of"),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordhostAddresses(name, `{
+		ipv4addr = "10.0.0.9"
+		mac      = "00:1A:2B:3C:4D:5E"
+	}`, ""),
+				ExpectError: regexp.MustCompile("lowercase colon-separated form"),
+			},
+			{
+				Config:      fake.ProviderConfig() + testAccRecordhostConfigureForDns(name, "10.0.0.9", "false") + "\n# aliases\n" + testAccRecordhostAliasesWithoutDns(name),
+				ExpectError: regexp.MustCompile("Aliases of a host not in DNS"),
+			},
+		},
+	})
+}
+
+func testAccRecordhostImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordhostExists(ctx context.Context, resourceName string, v *dns.RecordHost) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aliases,cloud_info,comment,configure_for_dns,creation_time,ddns_protected,device_description,device_location,device_type,device_vendor,disable,disable_discovery,dns_aliases,dns_name,extattrs,ipv4addrs,ipv6addrs,last_queried,ms_ad_user_data,name,network_view,rrset_order,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordhostAPI.
+			RecordhostReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordhostDestroy(ctx context.Context, v *dns.RecordHost) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordhostAPI.
+			RecordhostReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordhostDisappears(ctx context.Context, v *dns.RecordHost) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordhostAPI.
+			RecordhostReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordhostBasicConfig(name, ipv4addr, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test" {
+	name = %q
+	view = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+}
+`, name, view, ipv4addr)
+}
+
+func testAccRecordhostAliases(name, ipv4addr string, aliases []string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_aliases" {
+	name = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+	aliases = [%s]
+}
+`, name, ipv4addr, quoteList(aliases))
+}
+
+func testAccRecordhostAliasesWithoutDns(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_aliases_without_dns" {
+	name = "aliases-%s"
+	configure_for_dns = false
+	ipv4addrs = [{
+		ipv4addr = "10.0.0.10"
+	}]
+	aliases = ["www.%s"]
+}
+`, name, name)
+}
+
+func testAccRecordhostComment(name, ipv4addr, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_comment" {
+	name = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+	comment = %q
+}
+`, name, ipv4addr, comment)
+}
+
+func testAccRecordhostConfigureForDns(name, ipv4addr, configureForDns string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_configure_for_dns" {
+	name = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+	configure_for_dns = %q
+}
+`, name, ipv4addr, configureForDns)
+}
+
+func testAccRecordhostDisable(name, ipv4addr, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_disable" {
+	name = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+	disable = %q
+}
+`, name, ipv4addr, disable)
+}
+
+func testAccRecordhostExtattrs(name, ipv4addr string, extAttrs map[string]string) string {
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		extattrsStr += fmt.Sprintf("\t\t%s = {\n\t\t\tvalue = %q\n\t\t}\n", k, v)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_extattrs" {
+	name = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+	extattrs = %s
+}
+`, name, ipv4addr, extattrsStr)
+}
+
+func testAccRecordhostIpv4addrs(name string, ipv4addrs ...string) string {
+	elems := make([]string, len(ipv4addrs))
+	for i, a := range ipv4addrs {
+		elems[i] = fmt.Sprintf("{ ipv4addr = %q }", a)
+	}
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_ipv4addrs" {
+	name = %q
+	ipv4addrs = [%s]
+}
+`, name, strings.Join(elems, ", "))
+}
+
+func testAccRecordhostIpv4addrsConfigureForDhcp(name, ipv4addr, mac string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_ipv4addrs_dhcp" {
+	name = %q
+	ipv4addrs = [{
+		ipv4addr           = %q
+		configure_for_dhcp = true
+		mac                = %q
+	}]
+}
+`, name, ipv4addr, mac)
+}
+
+func testAccRecordhostIpv6addrs(name, ipv6addr string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_ipv6addrs" {
+	name = %q
+	ipv6addrs = [{
+		ipv6addr = %q
+	}]
+}
+`, name, ipv6addr)
+}
+
+// testAccRecordhostAddresses returns a host record with the given IPv4 and IPv6 address objects, none if empty.
+func testAccRecordhostAddresses(name, ipv4addr, ipv6addr string) string {
+	addresses := ""
+	if ipv4addr != "" {
+		addresses += fmt.Sprintf("\tipv4addrs = [%s]\n", ipv4addr)
+	}
+	if ipv6addr != "" {
+		addresses += fmt.Sprintf("\tipv6addrs = [%s]\n", ipv6addr)
+	}
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_addresses" {
+	name = %q
+%s}
+`, name, addresses)
+}
+
+func testAccRecordhostTtl(name, ipv4addr string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_host_record" "test_ttl" {
+	name = %q
+	ipv4addrs = [{
+		ipv4addr = %q
+	}]
+	ttl = %d
+	use_ttl = true
+}
+`, name, ipv4addr, ttl)
+}
+
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
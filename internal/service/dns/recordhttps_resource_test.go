@@ -0,0 +1,847 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordhttpsResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_https_record.test"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordhttpsDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordhttpsBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					testAccCheckRecordhttpsDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_comment"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_creator"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsCreator(name, "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsCreator(name, "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_ddns_principal"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsDdnsPrincipal(name, "default", "host/https.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/https.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsDdnsPrincipal(name, "default", "host/https2.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/https2.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_ddns_protected"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsDdnsProtected(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsDdnsProtected(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_disable"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsDisable(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsDisable(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_extattrs"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_forbid_reclamation"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsForbidReclamation(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsForbidReclamation(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_name"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsName(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_ttl"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsTtl(name, "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsTtl(name, "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_use_ttl"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsUseTtl(name, "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsUseTtl(name, "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:https"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordhttpsComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "svc.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordhttpsComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordhttpsComment(name, "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordhttpsImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_Priority(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_priority"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsPriority(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsPriority(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_SvcParameters(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_svc_parameters"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsSvcParameters(name, `[
+		{
+			svc_key   = "alpn"
+			svc_value = ["h2", "h3"]
+			mandatory = true
+		},
+		{
+			svc_key   = "port"
+			svc_value = ["8443"]
+		},
+	]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.svc_key", "alpn"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.svc_value.0", "h2"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.mandatory", "true"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.1.svc_key", "port"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.1.svc_value.0", "8443"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.1.mandatory", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsSvcParameters(name, `[
+		{
+			svc_key   = "ipv6hint"
+			svc_value = ["2001:db8::1"]
+		},
+	]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.svc_key", "ipv6hint"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.svc_value.0", "2001:db8::1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_TargetName(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_target_name"
+	var v dns.RecordHttps
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordhttpsTargetName(name, "svc.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "svc.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordhttpsTargetName(name, "."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordhttpsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_AliasMode(t *testing.T) {
+	var resourceName = "nios_dns_https_record.test_alias_mode"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:https"),
+		Steps: []resource.TestStep{
+			// A record in ServiceMode has SVC parameters
+			{
+				Config: fake.ProviderConfig() + testAccRecordhttpsAliasMode(name, 1, `[{ svc_key = "port", svc_value = ["8443"] }]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.#", "1"),
+				),
+			},
+			// The SVC parameters are removed when the record switches to AliasMode
+			{
+				Config: fake.ProviderConfig() + testAccRecordhttpsAliasMode(name, 0, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "svc_parameters.#"),
+				),
+			},
+			// A record in AliasMode cannot have SVC parameters
+			{
+				Config:      fake.ProviderConfig() + testAccRecordhttpsAliasMode(name, 0, `[{ svc_key = "port", svc_value = ["8443"] }]`),
+				ExpectError: regexp.MustCompile("Invalid SVC parameters"),
+			},
+		},
+	})
+}
+
+func TestAccRecordhttpsResource_InvalidSvcParameters(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The addresses of ipv4hint are IPv4 addresses
+			{
+				Config:      fake.ProviderConfig() + testAccRecordhttpsSvcParameters(name, `[{ svc_key = "ipv4hint", svc_value = ["2001:db8::1"] }]`),
+				ExpectError: regexp.MustCompile("Invalid SVC parameter value"),
+			},
+			// A key appears once
+			{
+				Config:      fake.ProviderConfig() + testAccRecordhttpsSvcParameters(name, `[{ svc_key = "port", svc_value = ["443"] }, { svc_key = "port", svc_value = ["8443"] }]`),
+				ExpectError: regexp.MustCompile("Duplicate SVC parameter"),
+			},
+			// no-default-alpn requires alpn
+			{
+				Config:      fake.ProviderConfig() + testAccRecordhttpsSvcParameters(name, `[{ svc_key = "no-default-alpn" }]`),
+				ExpectError: regexp.MustCompile("requires the alpn parameter"),
+			},
+		},
+	})
+}
+
+func testAccRecordhttpsImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordhttpsExists(ctx context.Context, resourceName string, v *dns.RecordHttps) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,extattrs,forbid_reclamation,last_queried,name,priority,reclaimable,svc_parameters,target_name,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordhttpsAPI.
+			RecordhttpsReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordhttpsDestroy(ctx context.Context, v *dns.RecordHttps) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,extattrs,forbid_reclamation,last_queried,name,priority,reclaimable,svc_parameters,target_name,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordhttpsAPI.
+			RecordhttpsReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordhttpsDisappears(ctx context.Context, v *dns.RecordHttps) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordhttpsAPI.
+			RecordhttpsReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordhttpsBasicConfig(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+}
+`, name, view)
+}
+
+func testAccRecordhttpsComment(name, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_comment" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	comment = %q
+}
+`, name, view, comment)
+}
+
+func testAccRecordhttpsCreator(name, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_creator" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	creator = %q
+}
+`, name, view, creator)
+}
+
+func testAccRecordhttpsDdnsPrincipal(name, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_ddns_principal" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	ddns_principal = %q
+}
+`, name, view, ddnsPrincipal)
+}
+
+func testAccRecordhttpsDdnsProtected(name, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_ddns_protected" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	ddns_protected = %q
+}
+`, name, view, ddnsProtected)
+}
+
+func testAccRecordhttpsDisable(name, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_disable" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	disable = %q
+}
+`, name, view, disable)
+}
+
+func testAccRecordhttpsExtattrs(name, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_extattrs" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	extattrs = %s
+}
+`, name, view, extattrsStr)
+}
+
+func testAccRecordhttpsForbidReclamation(name, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_forbid_reclamation" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, view, forbidReclamation)
+}
+
+func testAccRecordhttpsName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_name" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+}
+`, name)
+}
+
+func testAccRecordhttpsTtl(name, view string, ttl int32, useTtl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_ttl" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, view, ttl, useTtl)
+}
+
+func testAccRecordhttpsUseTtl(name, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_use_ttl" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, view, useTtl, ttl)
+}
+
+func testAccRecordhttpsPriority(name string, priority int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_priority" {
+	name = %q
+	priority = %d
+	target_name = "svc.example.com"
+}
+`, name, priority)
+}
+
+func testAccRecordhttpsSvcParameters(name, svcParameters string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_svc_parameters" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	svc_parameters = %s
+}
+`, name, svcParameters)
+}
+
+func testAccRecordhttpsTargetName(name, targetName string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_target_name" {
+	name = %q
+	priority = 1
+	target_name = %q
+}
+`, name, targetName)
+}
+
+func testAccRecordhttpsAliasMode(name string, priority int32, svcParameters string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_https_record" "test_alias_mode" {
+	name = %q
+	priority = %d
+	target_name = "svc.example.com"
+	svc_parameters = %s
+}
+`, name, priority, svcParameters)
+}
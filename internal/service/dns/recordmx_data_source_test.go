@@ -0,0 +1,118 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordmxDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_mx_records.test"
+	resourceName := "nios_dns_mx_record.test"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordmxDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordmxDataSourceConfigFilters(name, "mail.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordmxResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordmxDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_mx_records.test"
+	resourceName := "nios_dns_mx_record.test"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordmxDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordmxDataSourceConfigTagFilters(name, "mail.example.com", "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordmxResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordmxResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_principal", dataSourceName, "result.0.ddns_principal"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_protected", dataSourceName, "result.0.ddns_protected"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "forbid_reclamation", dataSourceName, "result.0.forbid_reclamation"),
+		resource.TestCheckResourceAttrPair(resourceName, "mail_exchanger", dataSourceName, "result.0.mail_exchanger"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "preference", dataSourceName, "result.0.preference"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordmxDataSourceConfigFilters(name, mailExchanger, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+}
+
+data "nios_dns_mx_records" "test" {
+	filters = {
+		"name": nios_dns_mx_record.test.name
+	}
+}
+`, name, mailExchanger, view)
+}
+
+func testAccRecordmxDataSourceConfigTagFilters(name, mailExchanger, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_mx_records" "test" {
+	filters = {
+		"*Site" = nios_dns_mx_record.test.extattrs.Site.value
+	}
+}
+`, name, mailExchanger, view, extAttrsValue)
+}
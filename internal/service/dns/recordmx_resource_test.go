@@ -0,0 +1,713 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordmxResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxBasicConfig(name, "mail.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_mx_record.test"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordmxDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordmxBasicConfig(name, "mail.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					testAccCheckRecordmxDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordmxResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_comment"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxComment(name, "mail.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxComment(name, "mail.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_creator"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxCreator(name, "mail.example.com", "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxCreator(name, "mail.example.com", "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_ddns_principal"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxDdnsPrincipal(name, "mail.example.com", "default", "host/mx.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/mx.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxDdnsPrincipal(name, "mail.example.com", "default", "host/mx-updated.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/mx-updated.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_ddns_protected"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxDdnsProtected(name, "mail.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxDdnsProtected(name, "mail.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_disable"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxDisable(name, "mail.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxDisable(name, "mail.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_extattrs"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxExtattrs(name, "mail.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxExtattrs(name, "mail.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_forbid_reclamation"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxForbidReclamation(name, "mail.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxForbidReclamation(name, "mail.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_MailExchanger(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_mail_exchanger"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxMailExchanger(name, "mail.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "mail_exchanger", "mail.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxMailExchanger(name, "mail2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "mail_exchanger", "mail2.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_name"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxName(name, "mail.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxName(updatedName, "mail.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_ttl"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxTtl(name, "mail.example.com", "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxTtl(name, "mail.example.com", "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_use_ttl"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxUseTtl(name, "mail.example.com", "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxUseTtl(name, "mail.example.com", "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:mx"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordmxComment(name, "mail.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "mail_exchanger", "mail.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "dns_mail_exchanger", "mail.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordmxComment(name, "mail.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mail_exchanger", "mail.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordmxComment(name, "mail.example.com", "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordmxImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordmxResource_Preference(t *testing.T) {
+	var resourceName = "nios_dns_mx_record.test_preference"
+	var v dns.RecordMx
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordmxPreference(name, "mail.example.com", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "preference", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordmxPreference(name, "mail.example.com", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordmxExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "preference", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordmxImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordmxExists(ctx context.Context, resourceName string, v *dns.RecordMx) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_mail_exchanger,dns_name,extattrs,forbid_reclamation,last_queried,mail_exchanger,name,preference,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		//rs.Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordmxAPI.
+			RecordmxReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordmxDestroy(ctx context.Context, v *dns.RecordMx) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_mail_exchanger,dns_name,extattrs,forbid_reclamation,last_queried,mail_exchanger,name,preference,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordmxAPI.
+			RecordmxReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordmxDisappears(ctx context.Context, v *dns.RecordMx) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordmxAPI.
+			RecordmxReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordmxBasicConfig(name, mailExchanger, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+}
+`, name, mailExchanger, view)
+}
+
+func testAccRecordmxComment(name, mailExchanger, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_comment" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	comment = %q
+}
+`, name, mailExchanger, view, comment)
+}
+
+func testAccRecordmxCreator(name, mailExchanger, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_creator" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q  
+	creator = %q
+}
+`, name, mailExchanger, view, creator)
+}
+
+func testAccRecordmxDdnsPrincipal(name, mailExchanger, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_ddns_principal" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	ddns_principal = %q
+}
+`, name, mailExchanger, view, ddnsPrincipal)
+}
+
+func testAccRecordmxDdnsProtected(name, mailExchanger, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_ddns_protected" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	ddns_protected = %q
+}
+`, name, mailExchanger, view, ddnsProtected)
+}
+
+func testAccRecordmxDisable(name, mailExchanger, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_disable" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	disable = %q
+}
+`, name, mailExchanger, view, disable)
+}
+
+func testAccRecordmxExtattrs(name, mailExchanger, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_extattrs" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	extattrs = %s
+}
+`, name, mailExchanger, view, extattrsStr)
+}
+
+func testAccRecordmxForbidReclamation(name, mailExchanger, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_forbid_reclamation" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, mailExchanger, view, forbidReclamation)
+}
+
+func testAccRecordmxMailExchanger(name, mailExchanger string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_mail_exchanger" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+}
+`, name, mailExchanger)
+}
+
+func testAccRecordmxName(name, mailExchanger string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_name" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+}
+`, name, mailExchanger)
+}
+
+func testAccRecordmxTtl(name, mailExchanger, view string, ttl int32, use_ttl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_ttl" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, mailExchanger, view, ttl, use_ttl)
+}
+
+func testAccRecordmxUseTtl(name, mailExchanger, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_use_ttl" {
+	name = %q
+	mail_exchanger = %q
+	preference = 10
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, mailExchanger, view, useTtl, ttl)
+}
+
+func testAccRecordmxPreference(name, mailExchanger string, preference int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_mx_record" "test_preference" {
+	name = %q
+	mail_exchanger = %q
+	preference = %d
+}
+`, name, mailExchanger, preference)
+}
@@ -0,0 +1,121 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordnaptrDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_naptr_records.test"
+	resourceName := "nios_dns_naptr_record.test"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordnaptrDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnaptrDataSourceConfigFilters(name, "_sip._udp.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordnaptrResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordnaptrDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_naptr_records.test"
+	resourceName := "nios_dns_naptr_record.test"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordnaptrDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnaptrDataSourceConfigTagFilters(name, "_sip._udp.example.com", "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordnaptrResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordnaptrResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_principal", dataSourceName, "result.0.ddns_principal"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_protected", dataSourceName, "result.0.ddns_protected"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "forbid_reclamation", dataSourceName, "result.0.forbid_reclamation"),
+		resource.TestCheckResourceAttrPair(resourceName, "replacement", dataSourceName, "result.0.replacement"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "order", dataSourceName, "result.0.order"),
+		resource.TestCheckResourceAttrPair(resourceName, "preference", dataSourceName, "result.0.preference"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordnaptrDataSourceConfigFilters(name, replacement, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+}
+
+data "nios_dns_naptr_records" "test" {
+	filters = {
+		"name": nios_dns_naptr_record.test.name
+	}
+}
+`, name, replacement, view)
+}
+
+func testAccRecordnaptrDataSourceConfigTagFilters(name, replacement, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_naptr_records" "test" {
+	filters = {
+		"*Site" = nios_dns_naptr_record.test.extattrs.Site.value
+	}
+}
+`, name, replacement, view, extAttrsValue)
+}
@@ -0,0 +1,810 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordnaptrResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrBasicConfig(name, "_sip._udp.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_naptr_record.test"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordnaptrDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnaptrBasicConfig(name, "_sip._udp.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					testAccCheckRecordnaptrDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_comment"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrComment(name, "_sip._udp.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrComment(name, "_sip._udp.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_creator"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrCreator(name, "_sip._udp.example.com", "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrCreator(name, "_sip._udp.example.com", "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_ddns_principal"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrDdnsPrincipal(name, "_sip._udp.example.com", "default", "host/naptr.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/naptr.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrDdnsPrincipal(name, "_sip._udp.example.com", "default", "host/naptr-updated.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/naptr-updated.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_ddns_protected"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrDdnsProtected(name, "_sip._udp.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrDdnsProtected(name, "_sip._udp.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_disable"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrDisable(name, "_sip._udp.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrDisable(name, "_sip._udp.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_extattrs"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrExtattrs(name, "_sip._udp.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrExtattrs(name, "_sip._udp.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_forbid_reclamation"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrForbidReclamation(name, "_sip._udp.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrForbidReclamation(name, "_sip._udp.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Replacement(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_replacement"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrReplacement(name, "_sip._udp.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "replacement", "_sip._udp.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrReplacement(name, "_sips._tcp.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "replacement", "_sips._tcp.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_name"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrName(name, "_sip._udp.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrName(updatedName, "_sip._udp.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_ttl"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrTtl(name, "_sip._udp.example.com", "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrTtl(name, "_sip._udp.example.com", "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_use_ttl"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrUseTtl(name, "_sip._udp.example.com", "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrUseTtl(name, "_sip._udp.example.com", "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:naptr"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordnaptrComment(name, "_sip._udp.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "replacement", "_sip._udp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "dns_replacement", "_sip._udp.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordnaptrComment(name, "_sip._udp.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "replacement", "_sip._udp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordnaptrComment(name, "_sip._udp.example.com", "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordnaptrImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Order(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_order"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrOrder(name, "_sip._udp.example.com", 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "order", "100"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrOrder(name, "_sip._udp.example.com", 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "order", "200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_Preference(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_preference"
+	var v dns.RecordNaptr
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnaptrPreference(name, "_sip._udp.example.com", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "preference", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnaptrPreference(name, "_sip._udp.example.com", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnaptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "preference", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnaptrResource_RewriteRule(t *testing.T) {
+	var resourceName = "nios_dns_naptr_record.test_regexp"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:naptr"),
+		Steps: []resource.TestStep{
+			// A regular expression is used with the root replacement
+			{
+				Config: fake.ProviderConfig() + testAccRecordnaptrRegexp(name, "!^.*$!sip:info@example.com!", "."),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "regexp", "!^.*$!sip:info@example.com!"),
+					resource.TestCheckResourceAttr(resourceName, "replacement", "."),
+					resource.TestCheckResourceAttr(resourceName, "flags", "U"),
+					resource.TestCheckResourceAttr(resourceName, "services", "E2U+sip"),
+				),
+			},
+			// The regular expression and the replacement cannot be used together
+			{
+				Config:      fake.ProviderConfig() + testAccRecordnaptrRegexp(name, "!^.*$!sip:info@example.com!", "_sip._udp.example.com"),
+				ExpectError: regexp.MustCompile("Invalid NAPTR rewrite rule"),
+			},
+		},
+	})
+}
+
+func testAccRecordnaptrImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordnaptrExists(ctx context.Context, resourceName string, v *dns.RecordNaptr) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,dns_replacement,extattrs,flags,forbid_reclamation,last_queried,name,order,preference,reclaimable,regexp,replacement,services,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		//rs.Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordnaptrAPI.
+			RecordnaptrReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordnaptrDestroy(ctx context.Context, v *dns.RecordNaptr) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,dns_replacement,extattrs,flags,forbid_reclamation,last_queried,name,order,preference,reclaimable,regexp,replacement,services,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordnaptrAPI.
+			RecordnaptrReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordnaptrDisappears(ctx context.Context, v *dns.RecordNaptr) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordnaptrAPI.
+			RecordnaptrReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordnaptrBasicConfig(name, replacement, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+}
+`, name, replacement, view)
+}
+
+func testAccRecordnaptrComment(name, replacement, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_comment" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	comment = %q
+}
+`, name, replacement, view, comment)
+}
+
+func testAccRecordnaptrCreator(name, replacement, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_creator" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q  
+	creator = %q
+}
+`, name, replacement, view, creator)
+}
+
+func testAccRecordnaptrDdnsPrincipal(name, replacement, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_ddns_principal" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	ddns_principal = %q
+}
+`, name, replacement, view, ddnsPrincipal)
+}
+
+func testAccRecordnaptrDdnsProtected(name, replacement, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_ddns_protected" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	ddns_protected = %q
+}
+`, name, replacement, view, ddnsProtected)
+}
+
+func testAccRecordnaptrDisable(name, replacement, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_disable" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	disable = %q
+}
+`, name, replacement, view, disable)
+}
+
+func testAccRecordnaptrExtattrs(name, replacement, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_extattrs" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	extattrs = %s
+}
+`, name, replacement, view, extattrsStr)
+}
+
+func testAccRecordnaptrForbidReclamation(name, replacement, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_forbid_reclamation" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, replacement, view, forbidReclamation)
+}
+
+func testAccRecordnaptrReplacement(name, replacement string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_replacement" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+}
+`, name, replacement)
+}
+
+func testAccRecordnaptrName(name, replacement string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_name" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+}
+`, name, replacement)
+}
+
+func testAccRecordnaptrTtl(name, replacement, view string, ttl int32, use_ttl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_ttl" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, replacement, view, ttl, use_ttl)
+}
+
+func testAccRecordnaptrUseTtl(name, replacement, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_use_ttl" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = 10
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, replacement, view, useTtl, ttl)
+}
+
+func testAccRecordnaptrOrder(name, replacement string, order int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_order" {
+	name = %q
+	replacement = %q
+	order = %d
+	preference = 10
+}
+`, name, replacement, order)
+}
+
+func testAccRecordnaptrPreference(name, replacement string, preference int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_preference" {
+	name = %q
+	replacement = %q
+	order = 100
+	preference = %d
+}
+`, name, replacement, preference)
+}
+
+func testAccRecordnaptrRegexp(name, expression, replacement string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_naptr_record" "test_regexp" {
+	name = %q
+	order = 100
+	preference = 10
+	flags = "U"
+	services = "E2U+sip"
+	regexp = %q
+	replacement = %q
+}
+`, name, expression, replacement)
+}
@@ -0,0 +1,67 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordnsDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_ns_records.test"
+	resourceName := "nios_dns_ns_record.test"
+	var v dns.RecordNs
+	name := "sub." + acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordnsDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnsDataSourceConfigFilters(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordnsResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordnsResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "addresses", dataSourceName, "result.0.addresses"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "nameserver", dataSourceName, "result.0.nameserver"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordnsDataSourceConfigFilters(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ns_record" "test" {
+	name = %q
+	nameserver = "ns1.example.net"
+	addresses = [
+		{
+			address = "192.0.2.53"
+		},
+	]
+	view = %q
+}
+
+data "nios_dns_ns_records" "test" {
+	filters = {
+		"name": nios_dns_ns_record.test.name
+	}
+}
+`, name, view)
+}
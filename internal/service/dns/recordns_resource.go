@@ -0,0 +1,225 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"net/netip"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+)
+
+var readableAttributesForRecordns = "addresses,cloud_info,creator,dns_name,last_queried,ms_delegation_name,name,nameserver,policy,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordnsResource{}
+var _ resource.ResourceWithImportState = &RecordnsResource{}
+var _ resource.ResourceWithModifyPlan = &RecordnsResource{}
+
+func NewRecordnsResource() resource.Resource {
+	return &RecordnsResource{}
+}
+
+// RecordnsResource defines the resource implementation.
+type RecordnsResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordnsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_ns_record"
+}
+
+func (r *RecordnsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordNSResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordnsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordnsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:ns", readableAttributesForRecordns) {
+		return
+	}
+
+	utils.PlanRefOnChange(ctx, req, resp, "name", "nameserver", "view")
+	r.checkAddresses(ctx, req, resp)
+}
+
+// checkAddresses rejects an NS record that lists an address of the name server more than once, WAPI would reject
+// the record without telling which address.
+func (r *RecordnsResource) checkAddresses(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordNSModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Addresses.IsUnknown() {
+		return
+	}
+	var addresses []RecordNsAddressesModel
+	resp.Diagnostics.Append(plan.Addresses.ElementsAs(ctx, &addresses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[netip.Addr]bool{}
+	for i, address := range addresses {
+		if address.Address.IsUnknown() {
+			continue
+		}
+		addr, err := netip.ParseAddr(address.Address.ValueString())
+		if err != nil {
+			continue
+		}
+		if seen[addr] {
+			resp.Diagnostics.AddAttributeError(path.Root("addresses").AtListIndex(i).AtName("address"), "Duplicate name server address",
+				fmt.Sprintf("The address %s of the name server %s is listed more than once.", address.Address.ValueString(), plan.Nameserver.ValueString()))
+		}
+		seen[addr] = true
+	}
+}
+
+func (r *RecordnsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordNSModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordNs := data.Expand(ctx, &resp.Diagnostics, true)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordnsAPI.
+		Post(ctx).
+		RecordNs(*recordNs).
+		ReturnFields2(readableAttributesForRecordns).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordns", err, httpRes, RecordNSResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordnsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordNSModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordnsAPI.
+		RecordnsReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordns).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordns", err, httpRes, RecordNSResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordnsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordNSModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordNs := data.Expand(ctx, &resp.Diagnostics, false)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordnsAPI.
+		RecordnsReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordNs(*recordNs).
+		ReturnFields2(readableAttributesForRecordns).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordns", err, httpRes, RecordNSResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordnsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordNSModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordnsAPI.
+		RecordnsReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordns", err, httpRes, RecordNSResourceSchemaAttributes)
+		return
+	}
+}
+
+func (r *RecordnsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
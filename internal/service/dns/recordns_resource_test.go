@@ -0,0 +1,348 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordnsResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_ns_record.test"
+	var v dns.RecordNs
+	name := "sub." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnsBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnsResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_ns_record.test"
+	var v dns.RecordNs
+	name := "sub." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordnsDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordnsBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					testAccCheckRecordnsDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordnsResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_ns_record.test_name"
+	var v dns.RecordNs
+	name := "sub." + acctest.RandomName() + ".example.com"
+	updatedName := "sub." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnsName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnsName(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnsResource_Nameserver(t *testing.T) {
+	var resourceName = "nios_dns_ns_record.test_nameserver"
+	var v dns.RecordNs
+	name := "sub." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnsNameserver(name, "ns1.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "nameserver", "ns1.example.net"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnsNameserver(name, "ns2.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "nameserver", "ns2.example.net"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnsResource_Addresses(t *testing.T) {
+	var resourceName = "nios_dns_ns_record.test_addresses"
+	var v dns.RecordNs
+	name := "sub." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordnsAddresses(name, "192.0.2.53", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.address", "192.0.2.53"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordnsAddresses(name, "2001:db8::53", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordnsExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.address", "2001:db8::53"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordnsResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_ns_record.test_addresses"
+	fake := acctest.NewFakeWAPI(t)
+	name := "sub." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:ns"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordnsAddresses(name, "192.0.2.53", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "nameserver", "ns1.example.net"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.address", "192.0.2.53"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.auto_create_ptr", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dns_name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordnsAddresses(name, "2001:db8::53", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.address", "2001:db8::53"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.auto_create_ptr", "false"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordnsAddresses(name, "2001:db8::53", "false"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordnsImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// An address cannot be listed twice
+			{
+				Config:      fake.ProviderConfig() + testAccRecordnsDuplicateAddresses(name, "192.0.2.53", "192.0.2.53"),
+				ExpectError: regexp.MustCompile("Duplicate name server address"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordnsImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordnsExists(ctx context.Context, resourceName string, v *dns.RecordNs) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "addresses,cloud_info,creator,dns_name,last_queried,ms_delegation_name,name,nameserver,policy,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordnsAPI.
+			RecordnsReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordnsDestroy(ctx context.Context, v *dns.RecordNs) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "addresses,cloud_info,creator,dns_name,last_queried,ms_delegation_name,name,nameserver,policy,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordnsAPI.
+			RecordnsReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordnsDisappears(ctx context.Context, v *dns.RecordNs) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordnsAPI.
+			RecordnsReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordnsBasicConfig(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ns_record" "test" {
+	name = %q
+	nameserver = "ns1.example.net"
+	addresses = [
+		{
+			address = "192.0.2.53"
+		},
+	]
+	view = %q
+}
+`, name, view)
+}
+
+func testAccRecordnsName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ns_record" "test_name" {
+	name = %q
+	nameserver = "ns1.example.net"
+	addresses = [
+		{
+			address = "192.0.2.53"
+		},
+	]
+}
+`, name)
+}
+
+func testAccRecordnsNameserver(name, nameserver string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ns_record" "test_nameserver" {
+	name = %q
+	nameserver = %q
+	addresses = [
+		{
+			address = "192.0.2.53"
+		},
+	]
+}
+`, name, nameserver)
+}
+
+func testAccRecordnsAddresses(name, address, autoCreatePtr string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ns_record" "test_addresses" {
+	name = %q
+	nameserver = "ns1.example.net"
+	addresses = [
+		{
+			address         = %q
+			auto_create_ptr = %q
+		},
+	]
+}
+`, name, address, autoCreatePtr)
+}
+
+func testAccRecordnsDuplicateAddresses(name, address1, address2 string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ns_record" "test_addresses" {
+	name = %q
+	nameserver = "ns1.example.net"
+	addresses = [
+		{
+			address = %q
+		},
+		{
+			address = %q
+		},
+	]
+}
+`, name, address1, address2)
+}
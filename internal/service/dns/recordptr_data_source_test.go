@@ -0,0 +1,116 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordptrDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_ptr_records.test"
+	resourceName := "nios_dns_ptr_record.test"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordptrDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordptrDataSourceConfigFilters(ipv4addr, "host.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordptrResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordptrDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_ptr_records.test"
+	resourceName := "nios_dns_ptr_record.test"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordptrDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordptrDataSourceConfigTagFilters(ipv4addr, "host.example.com", "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordptrResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordptrResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_principal", dataSourceName, "result.0.ddns_principal"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_protected", dataSourceName, "result.0.ddns_protected"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "forbid_reclamation", dataSourceName, "result.0.forbid_reclamation"),
+		resource.TestCheckResourceAttrPair(resourceName, "ptrdname", dataSourceName, "result.0.ptrdname"),
+		resource.TestCheckResourceAttrPair(resourceName, "ipv4addr", dataSourceName, "result.0.ipv4addr"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordptrDataSourceConfigFilters(ipv4addr, ptrdname, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+}
+
+data "nios_dns_ptr_records" "test" {
+	filters = {
+		"ipv4addr": nios_dns_ptr_record.test.ipv4addr
+	}
+}
+`, ipv4addr, ptrdname, view)
+}
+
+func testAccRecordptrDataSourceConfigTagFilters(ipv4addr, ptrdname, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_ptr_records" "test" {
+	filters = {
+		"*Site" = nios_dns_ptr_record.test.extattrs.Site.value
+	}
+}
+`, ipv4addr, ptrdname, view, extAttrsValue)
+}
@@ -0,0 +1,275 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/customtypes"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordptr = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,dns_ptrdname,extattrs,forbid_reclamation,ipv4addr,ipv6addr,last_queried,ms_ad_user_data,name,ptrdname,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordptrResource{}
+var _ resource.ResourceWithImportState = &RecordptrResource{}
+var _ resource.ResourceWithModifyPlan = &RecordptrResource{}
+
+func NewRecordptrResource() resource.Resource {
+	return &RecordptrResource{}
+}
+
+// RecordptrResource defines the resource implementation.
+type RecordptrResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordptrResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_ptr_record"
+}
+
+func (r *RecordptrResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordPTRResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordptrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordptrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:ptr", readableAttributesForRecordptr) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the record
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	r.planNameAndAddress(ctx, req, resp)
+	utils.PlanRefOnChange(ctx, req, resp, "name", "view")
+}
+
+// planNameAndAddress plans the form of the record the user did not write: the reverse name from the address, or the
+// address from the reverse name. Only one of ipv4addr, ipv6addr and name is configured.
+func (r *RecordptrResource) planNameAndAddress(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config, plan RecordPTRModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !config.Ipv4addr.IsNull():
+		plan.Name = types.StringUnknown()
+		if addr, err := netip.ParseAddr(plan.Ipv4addr.ValueString()); err == nil {
+			plan.Name = types.StringValue(utils.ReverseName(addr))
+		}
+		plan.Ipv6addr = customtypes.NewIPv6AddressNull()
+	case !config.Ipv6addr.IsNull():
+		plan.Name = types.StringUnknown()
+		if addr, err := netip.ParseAddr(plan.Ipv6addr.ValueString()); err == nil {
+			plan.Name = types.StringValue(utils.ReverseName(addr))
+		}
+		plan.Ipv4addr = types.StringNull()
+	case !config.Name.IsNull():
+		plan.Ipv4addr, plan.Ipv6addr = types.StringNull(), customtypes.NewIPv6AddressNull()
+		if addr, ok := utils.AddrFromReverseName(plan.Name.ValueString()); ok {
+			if addr.Is4() {
+				plan.Ipv4addr = types.StringValue(addr.String())
+			} else {
+				plan.Ipv6addr = customtypes.NewIPv6AddressValue(addr.String())
+			}
+		} else if plan.Name.IsUnknown() || isReverseName(plan.Name.ValueString()) {
+			// The grid may compute an address from the names of a classless reverse zone
+			plan.Ipv4addr, plan.Ipv6addr = types.StringUnknown(), customtypes.NewIPv6AddressUnknown()
+			if !req.State.Raw.IsNull() {
+				var state RecordPTRModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if state.Name.Equal(plan.Name) {
+					plan.Ipv4addr, plan.Ipv6addr = state.Ipv4addr, state.Ipv6addr
+				}
+			}
+		}
+	default:
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), plan.Name)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ipv4addr"), plan.Ipv4addr)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ipv6addr"), plan.Ipv6addr)...)
+}
+
+// isReverseName returns true if name is in the in-addr.arpa or ip6.arpa domains.
+func isReverseName(name string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	return strings.HasSuffix(name, ".in-addr.arpa") || strings.HasSuffix(name, ".ip6.arpa")
+}
+
+func (r *RecordptrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordPTRModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordPtr := data.Expand(ctx, &resp.Diagnostics, true)
+	recordPtr.Extattrs = utils.MergeDefaultExtAttrs(recordPtr.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordptrAPI.
+		Post(ctx).
+		RecordPtr(*recordPtr).
+		ReturnFields2(readableAttributesForRecordptr).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordptr", err, httpRes, RecordPTRResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordptrResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordPTRModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordptrAPI.
+		RecordptrReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordptr).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordptr", err, httpRes, RecordPTRResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordptrResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordPTRModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordPtr := data.Expand(ctx, &resp.Diagnostics, false)
+	recordPtr.Extattrs = utils.MergeDefaultExtAttrs(recordPtr.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordptrAPI.
+		RecordptrReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordPtr(*recordPtr).
+		ReturnFields2(readableAttributesForRecordptr).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordptr", err, httpRes, RecordPTRResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordptrResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordPTRModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordptrAPI.
+		RecordptrReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordptr", err, httpRes, RecordPTRResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the record.
+func (r *RecordptrResource) flatten(ctx context.Context, data *RecordPTRModel, res *dns.RecordPtr, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *RecordptrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,759 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordptrResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrBasicConfig(ipv4addr, "host.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_ptr_record.test"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordptrDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordptrBasicConfig(ipv4addr, "host.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					testAccCheckRecordptrDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordptrResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_comment"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrComment(ipv4addr, "host.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrComment(ipv4addr, "host.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_creator"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrCreator(ipv4addr, "host.example.com", "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrCreator(ipv4addr, "host.example.com", "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_ddns_principal"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrDdnsPrincipal(ipv4addr, "host.example.com", "default", "host/ptr.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/ptr.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrDdnsPrincipal(ipv4addr, "host.example.com", "default", "host/ptr-updated.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/ptr-updated.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_ddns_protected"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrDdnsProtected(ipv4addr, "host.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrDdnsProtected(ipv4addr, "host.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_disable"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrDisable(ipv4addr, "host.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrDisable(ipv4addr, "host.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_extattrs"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrExtattrs(ipv4addr, "host.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrExtattrs(ipv4addr, "host.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_forbid_reclamation"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrForbidReclamation(ipv4addr, "host.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrForbidReclamation(ipv4addr, "host.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_Ptrdname(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_ptrdname"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrPtrdname(ipv4addr, "target1.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ptrdname", "target1.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrPtrdname(ipv4addr, "target2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ptrdname", "target2.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_name"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+	updatedIpv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrName(reverseName(ipv4addr), "host.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", reverseName(ipv4addr)),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addr", ipv4addr),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrName(reverseName(updatedIpv4addr), "host.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", reverseName(updatedIpv4addr)),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addr", updatedIpv4addr),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_Ipv6addr(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_ipv6addr"
+	var v dns.RecordPtr
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrIpv6addr("2001:db8::10", "host.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:db8::10"),
+					resource.TestCheckResourceAttr(resourceName, "name", reverseName("2001:db8::10")),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrIpv6addr("2001:db8::11", "host.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:db8::11"),
+					resource.TestCheckResourceAttr(resourceName, "name", reverseName("2001:db8::11")),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_ttl"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrTtl(ipv4addr, "host.example.com", "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrTtl(ipv4addr, "host.example.com", "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_use_ttl"
+	var v dns.RecordPtr
+	ipv4addr := acctest.RandomIP()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordptrUseTtl(ipv4addr, "host.example.com", "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordptrUseTtl(ipv4addr, "host.example.com", "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordptrExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:ptr"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordptrComment("192.0.2.10", "host.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "ptrdname", "host.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+					resource.TestCheckResourceAttr(resourceName, "name", "10.2.0.192.in-addr.arpa"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addr", "192.0.2.10"),
+					resource.TestCheckNoResourceAttr(resourceName, "ipv6addr"),
+					resource.TestCheckResourceAttr(resourceName, "dns_ptrdname", "host.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordptrComment("192.0.2.10", "host.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ptrdname", "host.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordptrComment("192.0.2.10", "host.example.com", "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordptrImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordptrResource_FakeWAPIName(t *testing.T) {
+	var resourceName = "nios_dns_ptr_record.test_name"
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:ptr"),
+		Steps: []resource.TestStep{
+			// The address is computed from a reverse name
+			{
+				Config: fake.ProviderConfig() + testAccRecordptrName("10.2.0.192.in-addr.arpa", "host.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4addr", "192.0.2.10"),
+					resource.TestCheckNoResourceAttr(resourceName, "ipv6addr"),
+				),
+			},
+			// A name outside of the reverse domains has no address
+			{
+				Config: fake.ProviderConfig() + testAccRecordptrName("_http._tcp.example.com", "host.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "_http._tcp.example.com"),
+					resource.TestCheckNoResourceAttr(resourceName, "ipv4addr"),
+					resource.TestCheckNoResourceAttr(resourceName, "ipv6addr"),
+				),
+			},
+			// The name is computed from an IPv6 address
+			{
+				Config: fake.ProviderConfig() + testAccRecordptrIpv6addr("2001:db8::10", "host.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nios_dns_ptr_record.test_ipv6addr", "name", reverseName("2001:db8::10")),
+					resource.TestCheckNoResourceAttr("nios_dns_ptr_record.test_ipv6addr", "ipv4addr"),
+				),
+			},
+			// Exactly one of the name and the addresses is required
+			{
+				Config: fake.ProviderConfig() + `
+resource "nios_dns_ptr_record" "test_name" {
+	name = "10.2.0.192.in-addr.arpa"
+	ipv4addr = "192.0.2.10"
+	ptrdname = "host.example.com"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccRecordptrImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordptrExists(ctx context.Context, resourceName string, v *dns.RecordPtr) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,dns_ptrdname,extattrs,forbid_reclamation,ipv4addr,ipv6addr,last_queried,ms_ad_user_data,name,ptrdname,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		//rs.Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordptrAPI.
+			RecordptrReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordptrDestroy(ctx context.Context, v *dns.RecordPtr) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,discovered_data,dns_name,dns_ptrdname,extattrs,forbid_reclamation,ipv4addr,ipv6addr,last_queried,ms_ad_user_data,name,ptrdname,reclaimable,shared_record_group,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordptrAPI.
+			RecordptrReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordptrDisappears(ctx context.Context, v *dns.RecordPtr) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordptrAPI.
+			RecordptrReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordptrBasicConfig(ipv4addr, ptrdname, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+}
+`, ipv4addr, ptrdname, view)
+}
+
+func testAccRecordptrComment(ipv4addr, ptrdname, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_comment" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	comment = %q
+}
+`, ipv4addr, ptrdname, view, comment)
+}
+
+func testAccRecordptrCreator(ipv4addr, ptrdname, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_creator" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q  
+	creator = %q
+}
+`, ipv4addr, ptrdname, view, creator)
+}
+
+func testAccRecordptrDdnsPrincipal(ipv4addr, ptrdname, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_ddns_principal" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	ddns_principal = %q
+}
+`, ipv4addr, ptrdname, view, ddnsPrincipal)
+}
+
+func testAccRecordptrDdnsProtected(ipv4addr, ptrdname, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_ddns_protected" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	ddns_protected = %q
+}
+`, ipv4addr, ptrdname, view, ddnsProtected)
+}
+
+func testAccRecordptrDisable(ipv4addr, ptrdname, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_disable" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	disable = %q
+}
+`, ipv4addr, ptrdname, view, disable)
+}
+
+func testAccRecordptrExtattrs(ipv4addr, ptrdname, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_extattrs" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	extattrs = %s
+}
+`, ipv4addr, ptrdname, view, extattrsStr)
+}
+
+func testAccRecordptrForbidReclamation(ipv4addr, ptrdname, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_forbid_reclamation" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	forbid_reclamation = %q
+}
+`, ipv4addr, ptrdname, view, forbidReclamation)
+}
+
+func testAccRecordptrPtrdname(ipv4addr, ptrdname string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_ptrdname" {
+	ipv4addr = %q
+	ptrdname = %q
+}
+`, ipv4addr, ptrdname)
+}
+
+func testAccRecordptrName(name, ptrdname string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_name" {
+	name = %q
+	ptrdname = %q
+}
+`, name, ptrdname)
+}
+
+func testAccRecordptrIpv6addr(ipv6addr, ptrdname string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_ipv6addr" {
+	ipv6addr = %q
+	ptrdname = %q
+}
+`, ipv6addr, ptrdname)
+}
+
+func testAccRecordptrTtl(ipv4addr, ptrdname, view string, ttl int32, use_ttl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_ttl" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, ipv4addr, ptrdname, view, ttl, use_ttl)
+}
+
+func testAccRecordptrUseTtl(ipv4addr, ptrdname, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_ptr_record" "test_use_ttl" {
+	ipv4addr = %q
+	ptrdname = %q
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, ipv4addr, ptrdname, view, useTtl, ttl)
+}
+
+func reverseName(addr string) string {
+	return utils.ReverseName(netip.MustParseAddr(addr))
+}
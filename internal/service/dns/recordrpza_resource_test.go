@@ -0,0 +1,287 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForRecordRpzATest = "comment,disable,extattrs,ipv4addr,name,rp_zone,ttl,use_ttl,view,zone"
+
+func TestAccRecordRpzAResource_basic(t *testing.T) {
+	var resourceName = "nios_rpz_a_rule.test"
+	var v dns.RecordRpzA
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzABasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "rp_zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzAResource_disappears(t *testing.T) {
+	resourceName := "nios_rpz_a_rule.test"
+	var v dns.RecordRpzA
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordRpzADestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordRpzABasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAExists(context.Background(), resourceName, &v),
+					testAccCheckRecordRpzADisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordRpzAResource_Comment(t *testing.T) {
+	var resourceName = "nios_rpz_a_rule.test_comment"
+	var v dns.RecordRpzA
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzAComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzAComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzAResource_Ipv4addr(t *testing.T) {
+	var resourceName = "nios_rpz_a_rule.test_ipv4addr"
+	var v dns.RecordRpzA
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzAIpv4addr(rpZone, name, "192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addr", "192.0.2.10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzAIpv4addr(rpZone, name, "192.0.2.20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv4addr", "192.0.2.20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzAResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_rpz_a_rule.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:rpz:a"),
+		Steps: []resource.TestStep{
+			// The name of a rule ends with the name of its zone
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzAComment(rpZone, "bad.example.org", "This is a new rule"),
+				ExpectError: regexp.MustCompile("Invalid rule name"),
+			},
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzAComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzAComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordRpzAComment(rpZone, name, "This is an updated rule"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordRpzAImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordRpzAImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordRpzAExists(ctx context.Context, resourceName string, v *dns.RecordRpzA) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzaAPI.
+			RecordrpzaReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForRecordRpzATest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordRpzADestroy(ctx context.Context, v *dns.RecordRpzA) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzaAPI.
+			RecordrpzaReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordRpzATest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordRpzADisappears(ctx context.Context, v *dns.RecordRpzA) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzaAPI.
+			RecordrpzaReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordRpzABasicConfig(rpZone, name string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_a_rule" "test" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	ipv4addr = "192.0.2.10"
+}
+`, rpZone, name)
+}
+
+func testAccRecordRpzAComment(rpZone, name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_a_rule" "test_comment" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	ipv4addr = "192.0.2.10"
+	comment = %q
+}
+`, rpZone, name, comment)
+}
+
+func testAccRecordRpzAIpv4addr(rpZone, name, ipv4addr string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_a_rule" "test_ipv4addr" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	ipv4addr = %q
+}
+`, rpZone, name, ipv4addr)
+}
@@ -0,0 +1,287 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForRecordRpzAaaaTest = "comment,disable,extattrs,ipv6addr,name,rp_zone,ttl,use_ttl,view,zone"
+
+func TestAccRecordRpzAaaaResource_basic(t *testing.T) {
+	var resourceName = "nios_rpz_aaaa_rule.test"
+	var v dns.RecordRpzAaaa
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzAaaaBasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "rp_zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzAaaaResource_disappears(t *testing.T) {
+	resourceName := "nios_rpz_aaaa_rule.test"
+	var v dns.RecordRpzAaaa
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordRpzAaaaDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordRpzAaaaBasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAaaaExists(context.Background(), resourceName, &v),
+					testAccCheckRecordRpzAaaaDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordRpzAaaaResource_Comment(t *testing.T) {
+	var resourceName = "nios_rpz_aaaa_rule.test_comment"
+	var v dns.RecordRpzAaaa
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzAaaaComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzAaaaComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzAaaaResource_Ipv6addr(t *testing.T) {
+	var resourceName = "nios_rpz_aaaa_rule.test_ipv6addr"
+	var v dns.RecordRpzAaaa
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzAaaaIpv6addr(rpZone, name, "2001:db8::10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:db8::10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzAaaaIpv6addr(rpZone, name, "2001:db8::20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzAaaaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ipv6addr", "2001:db8::20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzAaaaResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_rpz_aaaa_rule.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:rpz:aaaa"),
+		Steps: []resource.TestStep{
+			// The name of a rule ends with the name of its zone
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzAaaaComment(rpZone, "bad.example.org", "This is a new rule"),
+				ExpectError: regexp.MustCompile("Invalid rule name"),
+			},
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzAaaaComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzAaaaComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordRpzAaaaComment(rpZone, name, "This is an updated rule"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordRpzAaaaImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordRpzAaaaImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordRpzAaaaExists(ctx context.Context, resourceName string, v *dns.RecordRpzAaaa) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzaaaaAPI.
+			RecordrpzaaaaReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForRecordRpzAaaaTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordRpzAaaaDestroy(ctx context.Context, v *dns.RecordRpzAaaa) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzaaaaAPI.
+			RecordrpzaaaaReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordRpzAaaaTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordRpzAaaaDisappears(ctx context.Context, v *dns.RecordRpzAaaa) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzaaaaAPI.
+			RecordrpzaaaaReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordRpzAaaaBasicConfig(rpZone, name string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_aaaa_rule" "test" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	ipv6addr = "2001:db8::10"
+}
+`, rpZone, name)
+}
+
+func testAccRecordRpzAaaaComment(rpZone, name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_aaaa_rule" "test_comment" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	ipv6addr = "2001:db8::10"
+	comment = %q
+}
+`, rpZone, name, comment)
+}
+
+func testAccRecordRpzAaaaIpv6addr(rpZone, name, ipv6addr string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_aaaa_rule" "test_ipv6addr" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	ipv6addr = %q
+}
+`, rpZone, name, ipv6addr)
+}
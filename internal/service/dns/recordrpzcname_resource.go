@@ -0,0 +1,259 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordrpzcname = "canonical,comment,disable,extattrs,name,rp_zone,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordrpzcnameResource{}
+var _ resource.ResourceWithImportState = &RecordrpzcnameResource{}
+var _ resource.ResourceWithModifyPlan = &RecordrpzcnameResource{}
+
+func NewRecordrpzcnameResource() resource.Resource {
+	return &RecordrpzcnameResource{}
+}
+
+// RecordrpzcnameResource defines the resource implementation.
+type RecordrpzcnameResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordrpzcnameResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "rpz_cname_rule"
+}
+
+func (r *RecordrpzcnameResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordRPZCNAMEResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordrpzcnameResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordrpzcnameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:rpz:cname", readableAttributesForRecordrpzcname) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the rule
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name")
+	r.checkName(ctx, req, resp)
+	r.checkCanonical(ctx, req, resp)
+}
+
+// checkName rejects a name that is not the domain name the rule applies to followed by the name of its response
+// policy zone.
+func (r *RecordrpzcnameResource) checkName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordRPZCNAMEModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.RpZone.IsUnknown() {
+		return
+	}
+
+	if err := checkRPZRuleName(plan.Name.ValueString(), plan.RpZone.ValueString(), false); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid rule name",
+			fmt.Sprintf("Attribute name %s, got: %s", err, plan.Name.ValueString()))
+	}
+}
+
+// checkCanonical rejects a canonical name on the rules that do not substitute the queried name, and requires it on
+// the ones that do.
+func (r *RecordrpzcnameResource) checkCanonical(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordRPZCNAMEModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkRPZRuleCanonical(plan.RuleType, plan.Canonical, &resp.Diagnostics)
+}
+
+// checkRPZRuleCanonical checks the canonical name of a rule of the given type.
+func checkRPZRuleCanonical(ruleType, canonical types.String, diags *diag.Diagnostics) {
+	if ruleType.IsUnknown() || canonical.IsUnknown() {
+		return
+	}
+
+	substitute := ruleType.ValueString() == rpzRuleTypeSubstitute
+	if substitute && canonical.IsNull() {
+		diags.AddAttributeError(path.Root("canonical"), "Missing canonical name",
+			fmt.Sprintf("Attribute canonical must be set when rule_type is %s.", rpzRuleTypeSubstitute))
+	}
+	if !substitute && !canonical.IsNull() {
+		diags.AddAttributeError(path.Root("canonical"), "Unexpected canonical name",
+			fmt.Sprintf("Attribute canonical can only be set when rule_type is %s, got rule_type: %s", rpzRuleTypeSubstitute, ruleType.ValueString()))
+	}
+}
+
+func (r *RecordrpzcnameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordRPZCNAMEModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordRpzCname := data.Expand(ctx, &resp.Diagnostics, true)
+	recordRpzCname.Extattrs = utils.MergeDefaultExtAttrs(recordRpzCname.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameAPI.
+		Post(ctx).
+		RecordRpzCname(*recordRpzCname).
+		ReturnFields2(readableAttributesForRecordrpzcname).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordrpzcname", err, httpRes, RecordRPZCNAMEResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordRPZCNAMEModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameAPI.
+		RecordrpzcnameReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordrpzcname).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordrpzcname", err, httpRes, RecordRPZCNAMEResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordRPZCNAMEModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordRpzCname := data.Expand(ctx, &resp.Diagnostics, false)
+	recordRpzCname.Extattrs = utils.MergeDefaultExtAttrs(recordRpzCname.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameAPI.
+		RecordrpzcnameReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordRpzCname(*recordRpzCname).
+		ReturnFields2(readableAttributesForRecordrpzcname).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordrpzcname", err, httpRes, RecordRPZCNAMEResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordRPZCNAMEModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameAPI.
+		RecordrpzcnameReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordrpzcname", err, httpRes, RecordRPZCNAMEResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the rule.
+func (r *RecordrpzcnameResource) flatten(ctx context.Context, data *RecordRPZCNAMEModel, res *dns.RecordRpzCname, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *RecordrpzcnameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,382 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForRecordRpzCnameTest = "canonical,comment,disable,extattrs,name,rp_zone,ttl,use_ttl,view,zone"
+
+func TestAccRecordRpzCnameResource_basic(t *testing.T) {
+	var resourceName = "nios_rpz_cname_rule.test"
+	var v dns.RecordRpzCname
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameBasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "rp_zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "NXDOMAIN"),
+					resource.TestCheckNoResourceAttr(resourceName, "canonical"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameResource_disappears(t *testing.T) {
+	resourceName := "nios_rpz_cname_rule.test"
+	var v dns.RecordRpzCname
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordRpzCnameDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordRpzCnameBasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameExists(context.Background(), resourceName, &v),
+					testAccCheckRecordRpzCnameDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordRpzCnameResource_Comment(t *testing.T) {
+	var resourceName = "nios_rpz_cname_rule.test_comment"
+	var v dns.RecordRpzCname
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzCnameComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameResource_RuleType(t *testing.T) {
+	var resourceName = "nios_rpz_cname_rule.test_rule_type"
+	var v dns.RecordRpzCname
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameRuleType(rpZone, name, "NODATA", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "NODATA"),
+					resource.TestCheckNoResourceAttr(resourceName, "canonical"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzCnameRuleType(rpZone, name, "SUBSTITUTE", "garden.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr(resourceName, "canonical", "garden.example.net"),
+				),
+			},
+			{
+				Config: testAccRecordRpzCnameRuleType(rpZone, name, "PASSTHRU", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "PASSTHRU"),
+					resource.TestCheckNoResourceAttr(resourceName, "canonical"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_rpz_cname_rule.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "bad.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:rpz:cname"),
+		Steps: []resource.TestStep{
+			// The name of a rule ends with the name of its zone
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzCnameComment(rpZone, "bad.example.org", "This is a new rule"),
+				ExpectError: regexp.MustCompile("Invalid rule name"),
+			},
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordRpzCnameComment(rpZone, name, "This is an updated rule"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordRpzCnameImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameResource_FakeWAPIRuleType(t *testing.T) {
+	var resourceName = "nios_rpz_cname_rule.test_rule_type"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "*.example.org." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:rpz:cname"),
+		Steps: []resource.TestStep{
+			// The canonical name is only set on the rules substituting the queried name
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzCnameRuleType(rpZone, name, "SUBSTITUTE", ""),
+				ExpectError: regexp.MustCompile("Missing canonical name"),
+			},
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzCnameRuleType(rpZone, name, "NXDOMAIN", "garden.example.net"),
+				ExpectError: regexp.MustCompile("Unexpected canonical name"),
+			},
+			// The type of the rule is encoded in its canonical name
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameRuleType(rpZone, name, "NXDOMAIN", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "NXDOMAIN"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname", ""),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameRuleType(rpZone, name, "NODATA", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "NODATA"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname", "*"),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameRuleType(rpZone, name, "PASSTHRU", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "PASSTHRU"),
+					resource.TestCheckNoResourceAttr(resourceName, "canonical"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname", "*.example.org"),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameRuleType(rpZone, name, "SUBSTITUTE", "garden.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr(resourceName, "canonical", "garden.example.net"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname", "garden.example.net"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordRpzCnameRuleType(rpZone, name, "SUBSTITUTE", "garden.example.net"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordRpzCnameImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckFakeRPZRuleCanonical checks the canonical name of the rule of the given type of the fake WAPI server.
+func testAccCheckFakeRPZRuleCanonical(fake *acctest.FakeWAPI, objectType, canonical string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		rules := fake.Objects(objectType)
+		if len(rules) != 1 {
+			return fmt.Errorf("expected 1 rule, got %d", len(rules))
+		}
+		if rules[0]["canonical"] != canonical {
+			return fmt.Errorf("expected the canonical name %q, got %q", canonical, rules[0]["canonical"])
+		}
+		return nil
+	}
+}
+
+func testAccRecordRpzCnameImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordRpzCnameExists(ctx context.Context, resourceName string, v *dns.RecordRpzCname) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameAPI.
+			RecordrpzcnameReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForRecordRpzCnameTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordRpzCnameDestroy(ctx context.Context, v *dns.RecordRpzCname) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameAPI.
+			RecordrpzcnameReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordRpzCnameTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordRpzCnameDisappears(ctx context.Context, v *dns.RecordRpzCname) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameAPI.
+			RecordrpzcnameReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordRpzCnameBasicConfig(rpZone, name string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_rule" "test" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "NXDOMAIN"
+}
+`, rpZone, name)
+}
+
+func testAccRecordRpzCnameComment(rpZone, name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_rule" "test_comment" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "NXDOMAIN"
+	comment = %q
+}
+`, rpZone, name, comment)
+}
+
+func testAccRecordRpzCnameRuleType(rpZone, name, ruleType, canonical string) string {
+	canonicalStr := ""
+	if canonical != "" {
+		canonicalStr = fmt.Sprintf("canonical = %q", canonical)
+	}
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_rule" "test_rule_type" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = %q
+	%s
+}
+`, rpZone, name, ruleType, canonicalStr)
+}
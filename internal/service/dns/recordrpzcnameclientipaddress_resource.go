@@ -0,0 +1,230 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordrpzcnameclientipaddress = "canonical,comment,disable,extattrs,is_ipv4,name,rp_zone,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordrpzcnameclientipaddressResource{}
+var _ resource.ResourceWithImportState = &RecordrpzcnameclientipaddressResource{}
+var _ resource.ResourceWithModifyPlan = &RecordrpzcnameclientipaddressResource{}
+
+func NewRecordrpzcnameclientipaddressResource() resource.Resource {
+	return &RecordrpzcnameclientipaddressResource{}
+}
+
+// RecordrpzcnameclientipaddressResource defines the resource implementation.
+type RecordrpzcnameclientipaddressResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordrpzcnameclientipaddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "rpz_cname_clientipaddress_rule"
+}
+
+func (r *RecordrpzcnameclientipaddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordRPZCNAMEClientipaddressResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordrpzcnameclientipaddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordrpzcnameclientipaddressResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:rpz:cname:clientipaddress", readableAttributesForRecordrpzcnameclientipaddress) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the rule
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name")
+	r.checkName(ctx, req, resp)
+}
+
+// checkName rejects a name that is not the IP address or network the rule applies to followed by the name of its
+// response policy zone.
+func (r *RecordrpzcnameclientipaddressResource) checkName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordRPZCNAMEClientipaddressModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.RpZone.IsUnknown() {
+		return
+	}
+
+	if err := checkRPZRuleName(plan.Name.ValueString(), plan.RpZone.ValueString(), true); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid rule name",
+			fmt.Sprintf("Attribute name %s, got: %s", err, plan.Name.ValueString()))
+	}
+}
+
+func (r *RecordrpzcnameclientipaddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordRPZCNAMEClientipaddressModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordRpzCnameClientipaddress := data.Expand(ctx, &resp.Diagnostics, true)
+	recordRpzCnameClientipaddress.Extattrs = utils.MergeDefaultExtAttrs(recordRpzCnameClientipaddress.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameclientipaddressAPI.
+		Post(ctx).
+		RecordRpzCnameClientipaddress(*recordRpzCnameClientipaddress).
+		ReturnFields2(readableAttributesForRecordrpzcnameclientipaddress).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordrpzcnameclientipaddress", err, httpRes, RecordRPZCNAMEClientipaddressResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameclientipaddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordRPZCNAMEClientipaddressModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameclientipaddressAPI.
+		RecordrpzcnameclientipaddressReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordrpzcnameclientipaddress).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordrpzcnameclientipaddress", err, httpRes, RecordRPZCNAMEClientipaddressResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameclientipaddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordRPZCNAMEClientipaddressModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordRpzCnameClientipaddress := data.Expand(ctx, &resp.Diagnostics, false)
+	recordRpzCnameClientipaddress.Extattrs = utils.MergeDefaultExtAttrs(recordRpzCnameClientipaddress.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameclientipaddressAPI.
+		RecordrpzcnameclientipaddressReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordRpzCnameClientipaddress(*recordRpzCnameClientipaddress).
+		ReturnFields2(readableAttributesForRecordrpzcnameclientipaddress).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordrpzcnameclientipaddress", err, httpRes, RecordRPZCNAMEClientipaddressResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameclientipaddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordRPZCNAMEClientipaddressModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameclientipaddressAPI.
+		RecordrpzcnameclientipaddressReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordrpzcnameclientipaddress", err, httpRes, RecordRPZCNAMEClientipaddressResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the rule.
+func (r *RecordrpzcnameclientipaddressResource) flatten(ctx context.Context, data *RecordRPZCNAMEClientipaddressModel, res *dns.RecordRpzCnameClientipaddress, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *RecordrpzcnameclientipaddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
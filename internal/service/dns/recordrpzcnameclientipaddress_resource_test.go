@@ -0,0 +1,333 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForRecordRpzCnameClientipaddressTest = "canonical,comment,disable,extattrs,is_ipv4,name,rp_zone,ttl,use_ttl,view,zone"
+
+func TestAccRecordRpzCnameClientipaddressResource_basic(t *testing.T) {
+	var resourceName = "nios_rpz_cname_clientipaddress_rule.test"
+	var v dns.RecordRpzCnameClientipaddress
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "2001:db8::/32." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameClientipaddressBasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameClientipaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "rp_zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_ipv4", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameClientipaddressResource_disappears(t *testing.T) {
+	resourceName := "nios_rpz_cname_clientipaddress_rule.test"
+	var v dns.RecordRpzCnameClientipaddress
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "2001:db8::/32." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordRpzCnameClientipaddressDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordRpzCnameClientipaddressBasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameClientipaddressExists(context.Background(), resourceName, &v),
+					testAccCheckRecordRpzCnameClientipaddressDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordRpzCnameClientipaddressResource_Comment(t *testing.T) {
+	var resourceName = "nios_rpz_cname_clientipaddress_rule.test_comment"
+	var v dns.RecordRpzCnameClientipaddress
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "2001:db8::/32." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameClientipaddressComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameClientipaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzCnameClientipaddressComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameClientipaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameClientipaddressResource_RuleType(t *testing.T) {
+	var resourceName = "nios_rpz_cname_clientipaddress_rule.test_rule_type"
+	var v dns.RecordRpzCnameClientipaddress
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "2001:db8::/32." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameClientipaddressRuleType(rpZone, name, "DROP"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameClientipaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "DROP"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzCnameClientipaddressRuleType(rpZone, name, "TCP_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameClientipaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "TCP_ONLY"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameClientipaddressResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_rpz_cname_clientipaddress_rule.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "2001:db8::/32." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:rpz:cname:clientipaddress"),
+		Steps: []resource.TestStep{
+			// The network of a rule is in its canonical form
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzCnameClientipaddressComment(rpZone, "192.0.2.1/24."+rpZone, "This is a new rule"),
+				ExpectError: regexp.MustCompile("Invalid rule name"),
+			},
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameClientipaddressComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "is_ipv4", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameClientipaddressComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordRpzCnameClientipaddressComment(rpZone, name, "This is an updated rule"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordRpzCnameClientipaddressImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameClientipaddressResource_FakeWAPIRuleType(t *testing.T) {
+	var resourceName = "nios_rpz_cname_clientipaddress_rule.test_rule_type"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "2001:db8::/32." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:rpz:cname:clientipaddress"),
+		Steps: []resource.TestStep{
+			// A rule on the addresses of the clients cannot substitute the queried name
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzCnameClientipaddressRuleType(rpZone, name, "SUBSTITUTE"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// The type of the rule is encoded in its canonical name
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameClientipaddressRuleType(rpZone, name, "DROP"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "DROP"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname:clientipaddress", "rpz-drop"),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameClientipaddressRuleType(rpZone, name, "TCP_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "TCP_ONLY"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname:clientipaddress", "rpz-tcp-only"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordRpzCnameClientipaddressRuleType(rpZone, name, "TCP_ONLY"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordRpzCnameClientipaddressImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordRpzCnameClientipaddressImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordRpzCnameClientipaddressExists(ctx context.Context, resourceName string, v *dns.RecordRpzCnameClientipaddress) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameclientipaddressAPI.
+			RecordrpzcnameclientipaddressReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForRecordRpzCnameClientipaddressTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordRpzCnameClientipaddressDestroy(ctx context.Context, v *dns.RecordRpzCnameClientipaddress) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameclientipaddressAPI.
+			RecordrpzcnameclientipaddressReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordRpzCnameClientipaddressTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordRpzCnameClientipaddressDisappears(ctx context.Context, v *dns.RecordRpzCnameClientipaddress) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameclientipaddressAPI.
+			RecordrpzcnameclientipaddressReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordRpzCnameClientipaddressBasicConfig(rpZone, name string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_clientipaddress_rule" "test" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "NXDOMAIN"
+}
+`, rpZone, name)
+}
+
+func testAccRecordRpzCnameClientipaddressComment(rpZone, name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_clientipaddress_rule" "test_comment" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "NXDOMAIN"
+	comment = %q
+}
+`, rpZone, name, comment)
+}
+
+func testAccRecordRpzCnameClientipaddressRuleType(rpZone, name, ruleType string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_clientipaddress_rule" "test_rule_type" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = %q
+}
+`, rpZone, name, ruleType)
+}
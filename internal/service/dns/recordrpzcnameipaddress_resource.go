@@ -0,0 +1,242 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForRecordrpzcnameipaddress = "canonical,comment,disable,extattrs,is_ipv4,name,rp_zone,ttl,use_ttl,view,zone"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordrpzcnameipaddressResource{}
+var _ resource.ResourceWithImportState = &RecordrpzcnameipaddressResource{}
+var _ resource.ResourceWithModifyPlan = &RecordrpzcnameipaddressResource{}
+
+func NewRecordrpzcnameipaddressResource() resource.Resource {
+	return &RecordrpzcnameipaddressResource{}
+}
+
+// RecordrpzcnameipaddressResource defines the resource implementation.
+type RecordrpzcnameipaddressResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *RecordrpzcnameipaddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "rpz_cname_ipaddress_rule"
+}
+
+func (r *RecordrpzcnameipaddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          RecordRPZCNAMEIpaddressResourceSchemaAttributes,
+	}
+}
+
+func (r *RecordrpzcnameipaddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecordrpzcnameipaddressResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "record:rpz:cname:ipaddress", readableAttributesForRecordrpzcnameipaddress) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the rule
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name")
+	r.checkName(ctx, req, resp)
+	r.checkCanonical(ctx, req, resp)
+}
+
+// checkName rejects a name that is not the IP address or network the rule applies to followed by the name of its
+// response policy zone.
+func (r *RecordrpzcnameipaddressResource) checkName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordRPZCNAMEIpaddressModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.RpZone.IsUnknown() {
+		return
+	}
+
+	if err := checkRPZRuleName(plan.Name.ValueString(), plan.RpZone.ValueString(), true); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid rule name",
+			fmt.Sprintf("Attribute name %s, got: %s", err, plan.Name.ValueString()))
+	}
+}
+
+// checkCanonical rejects a canonical name on the rules that do not substitute the queried name, and requires it on
+// the ones that do.
+func (r *RecordrpzcnameipaddressResource) checkCanonical(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan RecordRPZCNAMEIpaddressModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkRPZRuleCanonical(plan.RuleType, plan.Canonical, &resp.Diagnostics)
+}
+
+func (r *RecordrpzcnameipaddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordRPZCNAMEIpaddressModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordRpzCnameIpaddress := data.Expand(ctx, &resp.Diagnostics, true)
+	recordRpzCnameIpaddress.Extattrs = utils.MergeDefaultExtAttrs(recordRpzCnameIpaddress.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameipaddressAPI.
+		Post(ctx).
+		RecordRpzCnameIpaddress(*recordRpzCnameIpaddress).
+		ReturnFields2(readableAttributesForRecordrpzcnameipaddress).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "Recordrpzcnameipaddress", err, httpRes, RecordRPZCNAMEIpaddressResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameipaddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordRPZCNAMEIpaddressModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameipaddressAPI.
+		RecordrpzcnameipaddressReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForRecordrpzcnameipaddress).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "Recordrpzcnameipaddress", err, httpRes, RecordRPZCNAMEIpaddressResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameipaddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecordRPZCNAMEIpaddressModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the record is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordRpzCnameIpaddress := data.Expand(ctx, &resp.Diagnostics, false)
+	recordRpzCnameIpaddress.Extattrs = utils.MergeDefaultExtAttrs(recordRpzCnameIpaddress.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameipaddressAPI.
+		RecordrpzcnameipaddressReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		RecordRpzCnameIpaddress(*recordRpzCnameIpaddress).
+		ReturnFields2(readableAttributesForRecordrpzcnameipaddress).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "Recordrpzcnameipaddress", err, httpRes, RecordRPZCNAMEIpaddressResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordrpzcnameipaddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordRPZCNAMEIpaddressModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		RecordrpzcnameipaddressAPI.
+		RecordrpzcnameipaddressReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "Recordrpzcnameipaddress", err, httpRes, RecordRPZCNAMEIpaddressResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the rule.
+func (r *RecordrpzcnameipaddressResource) flatten(ctx context.Context, data *RecordRPZCNAMEIpaddressModel, res *dns.RecordRpzCnameIpaddress, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *RecordrpzcnameipaddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
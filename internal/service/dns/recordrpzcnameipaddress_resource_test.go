@@ -0,0 +1,368 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForRecordRpzCnameIpaddressTest = "canonical,comment,disable,extattrs,is_ipv4,name,rp_zone,ttl,use_ttl,view,zone"
+
+func TestAccRecordRpzCnameIpaddressResource_basic(t *testing.T) {
+	var resourceName = "nios_rpz_cname_ipaddress_rule.test"
+	var v dns.RecordRpzCnameIpaddress
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "192.0.2.0/24." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameIpaddressBasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameIpaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "rp_zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_ipv4", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameIpaddressResource_disappears(t *testing.T) {
+	resourceName := "nios_rpz_cname_ipaddress_rule.test"
+	var v dns.RecordRpzCnameIpaddress
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "192.0.2.0/24." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordRpzCnameIpaddressDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordRpzCnameIpaddressBasicConfig(rpZone, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameIpaddressExists(context.Background(), resourceName, &v),
+					testAccCheckRecordRpzCnameIpaddressDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordRpzCnameIpaddressResource_Comment(t *testing.T) {
+	var resourceName = "nios_rpz_cname_ipaddress_rule.test_comment"
+	var v dns.RecordRpzCnameIpaddress
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "192.0.2.0/24." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameIpaddressComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameIpaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzCnameIpaddressComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameIpaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameIpaddressResource_RuleType(t *testing.T) {
+	var resourceName = "nios_rpz_cname_ipaddress_rule.test_rule_type"
+	var v dns.RecordRpzCnameIpaddress
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "192.0.2.0/24." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "NXDOMAIN", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameIpaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "NXDOMAIN"),
+					resource.TestCheckNoResourceAttr(resourceName, "canonical"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "PASSTHRU", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameIpaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "PASSTHRU"),
+					resource.TestCheckNoResourceAttr(resourceName, "canonical"),
+				),
+			},
+			{
+				Config: testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "SUBSTITUTE", "garden.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordRpzCnameIpaddressExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr(resourceName, "canonical", "garden.example.net"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameIpaddressResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_rpz_cname_ipaddress_rule.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "192.0.2.0/24." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:rpz:cname:ipaddress"),
+		Steps: []resource.TestStep{
+			// The network of a rule is in its canonical form
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzCnameIpaddressComment(rpZone, "192.0.2.1/24."+rpZone, "This is a new rule"),
+				ExpectError: regexp.MustCompile("Invalid rule name"),
+			},
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameIpaddressComment(rpZone, name, "This is a new rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "zone", rpZone),
+					resource.TestCheckResourceAttr(resourceName, "is_ipv4", "true"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new rule"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameIpaddressComment(rpZone, name, "This is an updated rule"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated rule"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordRpzCnameIpaddressComment(rpZone, name, "This is an updated rule"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordRpzCnameIpaddressImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordRpzCnameIpaddressResource_FakeWAPIRuleType(t *testing.T) {
+	var resourceName = "nios_rpz_cname_ipaddress_rule.test_rule_type"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+	name := "192.0.2.0/24." + rpZone
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:rpz:cname:ipaddress"),
+		Steps: []resource.TestStep{
+			// The canonical name is only set on the rules substituting the queried name
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "SUBSTITUTE", ""),
+				ExpectError: regexp.MustCompile("Missing canonical name"),
+			},
+			{
+				Config:      fake.ProviderConfig() + testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "PASSTHRU", "garden.example.net"),
+				ExpectError: regexp.MustCompile("Unexpected canonical name"),
+			},
+			// The type of the rule is encoded in its canonical name
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "NXDOMAIN", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "NXDOMAIN"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname:ipaddress", ""),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "NODATA", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "NODATA"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname:ipaddress", "*"),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "PASSTHRU", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "PASSTHRU"),
+					resource.TestCheckNoResourceAttr(resourceName, "canonical"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname:ipaddress", "rpz-passthru"),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "SUBSTITUTE", "garden.example.net"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule_type", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr(resourceName, "canonical", "garden.example.net"),
+					testAccCheckFakeRPZRuleCanonical(fake, "record:rpz:cname:ipaddress", "garden.example.net"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordRpzCnameIpaddressRuleType(rpZone, name, "SUBSTITUTE", "garden.example.net"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordRpzCnameIpaddressImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordRpzCnameIpaddressImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordRpzCnameIpaddressExists(ctx context.Context, resourceName string, v *dns.RecordRpzCnameIpaddress) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameipaddressAPI.
+			RecordrpzcnameipaddressReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForRecordRpzCnameIpaddressTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordRpzCnameIpaddressDestroy(ctx context.Context, v *dns.RecordRpzCnameIpaddress) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameipaddressAPI.
+			RecordrpzcnameipaddressReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordRpzCnameIpaddressTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordRpzCnameIpaddressDisappears(ctx context.Context, v *dns.RecordRpzCnameIpaddress) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordrpzcnameipaddressAPI.
+			RecordrpzcnameipaddressReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordRpzCnameIpaddressBasicConfig(rpZone, name string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_ipaddress_rule" "test" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "NXDOMAIN"
+}
+`, rpZone, name)
+}
+
+func testAccRecordRpzCnameIpaddressComment(rpZone, name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_ipaddress_rule" "test_comment" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "NXDOMAIN"
+	comment = %q
+}
+`, rpZone, name, comment)
+}
+
+func testAccRecordRpzCnameIpaddressRuleType(rpZone, name, ruleType, canonical string) string {
+	canonicalStr := ""
+	if canonical != "" {
+		canonicalStr = fmt.Sprintf("canonical = %q", canonical)
+	}
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+resource "nios_rpz_cname_ipaddress_rule" "test_rule_type" {
+	name = %q
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = %q
+	%s
+}
+`, rpZone, name, ruleType, canonicalStr)
+}
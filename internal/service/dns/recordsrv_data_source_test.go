@@ -0,0 +1,124 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordsrvDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_srv_records.test"
+	resourceName := "nios_dns_srv_record.test"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsrvDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsrvDataSourceConfigFilters(name, "sip.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordsrvResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordsrvDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_srv_records.test"
+	resourceName := "nios_dns_srv_record.test"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsrvDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsrvDataSourceConfigTagFilters(name, "sip.example.com", "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordsrvResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordsrvResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_principal", dataSourceName, "result.0.ddns_principal"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_protected", dataSourceName, "result.0.ddns_protected"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "forbid_reclamation", dataSourceName, "result.0.forbid_reclamation"),
+		resource.TestCheckResourceAttrPair(resourceName, "target", dataSourceName, "result.0.target"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "priority", dataSourceName, "result.0.priority"),
+		resource.TestCheckResourceAttrPair(resourceName, "weight", dataSourceName, "result.0.weight"),
+		resource.TestCheckResourceAttrPair(resourceName, "port", dataSourceName, "result.0.port"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordsrvDataSourceConfigFilters(name, target, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+}
+
+data "nios_dns_srv_records" "test" {
+	filters = {
+		"name": nios_dns_srv_record.test.name
+	}
+}
+`, name, target, view)
+}
+
+func testAccRecordsrvDataSourceConfigTagFilters(name, target, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_srv_records" "test" {
+	filters = {
+		"*Site" = nios_dns_srv_record.test.extattrs.Site.value
+	}
+}
+`, name, target, view, extAttrsValue)
+}
@@ -0,0 +1,823 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordsrvResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvBasicConfig(name, "sip.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_srv_record.test"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsrvDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsrvBasicConfig(name, "sip.example.com", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					testAccCheckRecordsrvDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_comment"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvComment(name, "sip.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvComment(name, "sip.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_creator"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvCreator(name, "sip.example.com", "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvCreator(name, "sip.example.com", "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_ddns_principal"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvDdnsPrincipal(name, "sip.example.com", "default", "host/srv.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/srv.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvDdnsPrincipal(name, "sip.example.com", "default", "host/srv-updated.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/srv-updated.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_ddns_protected"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvDdnsProtected(name, "sip.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvDdnsProtected(name, "sip.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_disable"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvDisable(name, "sip.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvDisable(name, "sip.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_extattrs"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvExtattrs(name, "sip.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvExtattrs(name, "sip.example.com", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_forbid_reclamation"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvForbidReclamation(name, "sip.example.com", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvForbidReclamation(name, "sip.example.com", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Target(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_target"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvTarget(name, "sip.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target", "sip.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvTarget(name, "sip2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target", "sip2.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_name"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvName(name, "sip.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvName(updatedName, "sip.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_ttl"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvTtl(name, "sip.example.com", "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvTtl(name, "sip.example.com", "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_use_ttl"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvUseTtl(name, "sip.example.com", "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvUseTtl(name, "sip.example.com", "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:srv"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordsrvComment(name, "sip.example.com", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "target", "sip.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "dns_target", "sip.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordsrvComment(name, "sip.example.com", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target", "sip.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordsrvComment(name, "sip.example.com", "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordsrvImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Priority(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_priority"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvPriority(name, "sip.example.com", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvPriority(name, "sip.example.com", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Weight(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_weight"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvWeight(name, "sip.example.com", 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "weight", "5"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvWeight(name, "sip.example.com", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "weight", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsrvResource_Port(t *testing.T) {
+	var resourceName = "nios_dns_srv_record.test_port"
+	var v dns.RecordSrv
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsrvPort(name, "sip.example.com", 5060),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "port", "5060"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsrvPort(name, "sip.example.com", 5061),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsrvExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "port", "5061"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordsrvImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordsrvExists(ctx context.Context, resourceName string, v *dns.RecordSrv) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,dns_target,extattrs,forbid_reclamation,last_queried,name,port,priority,reclaimable,shared_record_group,target,ttl,use_ttl,view,weight,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		//rs.Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordsrvAPI.
+			RecordsrvReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordsrvDestroy(ctx context.Context, v *dns.RecordSrv) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,dns_target,extattrs,forbid_reclamation,last_queried,name,port,priority,reclaimable,shared_record_group,target,ttl,use_ttl,view,weight,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordsrvAPI.
+			RecordsrvReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordsrvDisappears(ctx context.Context, v *dns.RecordSrv) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordsrvAPI.
+			RecordsrvReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordsrvBasicConfig(name, target, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+}
+`, name, target, view)
+}
+
+func testAccRecordsrvComment(name, target, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_comment" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	comment = %q
+}
+`, name, target, view, comment)
+}
+
+func testAccRecordsrvCreator(name, target, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_creator" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q  
+	creator = %q
+}
+`, name, target, view, creator)
+}
+
+func testAccRecordsrvDdnsPrincipal(name, target, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_ddns_principal" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	ddns_principal = %q
+}
+`, name, target, view, ddnsPrincipal)
+}
+
+func testAccRecordsrvDdnsProtected(name, target, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_ddns_protected" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	ddns_protected = %q
+}
+`, name, target, view, ddnsProtected)
+}
+
+func testAccRecordsrvDisable(name, target, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_disable" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	disable = %q
+}
+`, name, target, view, disable)
+}
+
+func testAccRecordsrvExtattrs(name, target, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_extattrs" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	extattrs = %s
+}
+`, name, target, view, extattrsStr)
+}
+
+func testAccRecordsrvForbidReclamation(name, target, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_forbid_reclamation" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, target, view, forbidReclamation)
+}
+
+func testAccRecordsrvTarget(name, target string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_target" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+}
+`, name, target)
+}
+
+func testAccRecordsrvName(name, target string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_name" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+}
+`, name, target)
+}
+
+func testAccRecordsrvTtl(name, target, view string, ttl int32, use_ttl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_ttl" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, target, view, ttl, use_ttl)
+}
+
+func testAccRecordsrvUseTtl(name, target, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_use_ttl" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = 5060
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, target, view, useTtl, ttl)
+}
+
+func testAccRecordsrvPriority(name, target string, priority int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_priority" {
+	name = %q
+	target = %q
+	priority = %d
+	weight = 5
+	port = 5060
+}
+`, name, target, priority)
+}
+
+func testAccRecordsrvWeight(name, target string, weight int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_weight" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = %d
+	port = 5060
+}
+`, name, target, weight)
+}
+
+func testAccRecordsrvPort(name, target string, port int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_srv_record" "test_port" {
+	name = %q
+	target = %q
+	priority = 10
+	weight = 5
+	port = %d
+}
+`, name, target, port)
+}
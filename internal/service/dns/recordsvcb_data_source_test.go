@@ -0,0 +1,119 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordsvcbDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_svcb_records.test"
+	resourceName := "nios_dns_svcb_record.test"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsvcbDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsvcbDataSourceConfigFilters(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordsvcbResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordsvcbDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_svcb_records.test"
+	resourceName := "nios_dns_svcb_record.test"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsvcbDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsvcbDataSourceConfigTagFilters(name, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordsvcbResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordsvcbResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_principal", dataSourceName, "result.0.ddns_principal"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_protected", dataSourceName, "result.0.ddns_protected"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "forbid_reclamation", dataSourceName, "result.0.forbid_reclamation"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "priority", dataSourceName, "result.0.priority"),
+		resource.TestCheckResourceAttrPair(resourceName, "svc_parameters", dataSourceName, "result.0.svc_parameters"),
+		resource.TestCheckResourceAttrPair(resourceName, "target_name", dataSourceName, "result.0.target_name"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordsvcbDataSourceConfigFilters(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+}
+
+data "nios_dns_svcb_records" "test" {
+	filters = {
+		"name": nios_dns_svcb_record.test.name
+	}
+}
+`, name, view)
+}
+
+func testAccRecordsvcbDataSourceConfigTagFilters(name, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_svcb_records" "test" {
+	filters = {
+		"*Site" = nios_dns_svcb_record.test.extattrs.Site.value
+	}
+}
+`, name, view, extAttrsValue)
+}
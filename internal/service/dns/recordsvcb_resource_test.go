@@ -0,0 +1,847 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordsvcbResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_svcb_record.test"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsvcbDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsvcbBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					testAccCheckRecordsvcbDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_comment"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_creator"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbCreator(name, "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbCreator(name, "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_ddns_principal"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbDdnsPrincipal(name, "default", "host/svcb.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/svcb.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbDdnsPrincipal(name, "default", "host/svcb2.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/svcb2.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_ddns_protected"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbDdnsProtected(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbDdnsProtected(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_disable"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbDisable(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbDisable(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_extattrs"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_forbid_reclamation"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbForbidReclamation(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbForbidReclamation(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_name"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbName(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_ttl"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbTtl(name, "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbTtl(name, "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_use_ttl"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbUseTtl(name, "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbUseTtl(name, "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:svcb"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordsvcbComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "svc.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordsvcbComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordsvcbComment(name, "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordsvcbImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_Priority(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_priority"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbPriority(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbPriority(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_SvcParameters(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_svc_parameters"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbSvcParameters(name, `[
+		{
+			svc_key   = "alpn"
+			svc_value = ["dot"]
+			mandatory = true
+		},
+		{
+			svc_key   = "port"
+			svc_value = ["8443"]
+		},
+	]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.svc_key", "alpn"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.svc_value.0", "dot"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.mandatory", "true"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.1.svc_key", "port"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.1.svc_value.0", "8443"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.1.mandatory", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbSvcParameters(name, `[
+		{
+			svc_key   = "ipv6hint"
+			svc_value = ["2001:db8::1"]
+		},
+	]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.svc_key", "ipv6hint"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.0.svc_value.0", "2001:db8::1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_TargetName(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_target_name"
+	var v dns.RecordSvcb
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordsvcbTargetName(name, "svc.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "svc.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordsvcbTargetName(name, "."),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsvcbExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "target_name", "."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_AliasMode(t *testing.T) {
+	var resourceName = "nios_dns_svcb_record.test_alias_mode"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:svcb"),
+		Steps: []resource.TestStep{
+			// A record in ServiceMode has SVC parameters
+			{
+				Config: fake.ProviderConfig() + testAccRecordsvcbAliasMode(name, 1, `[{ svc_key = "port", svc_value = ["8443"] }]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "svc_parameters.#", "1"),
+				),
+			},
+			// The SVC parameters are removed when the record switches to AliasMode
+			{
+				Config: fake.ProviderConfig() + testAccRecordsvcbAliasMode(name, 0, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+					resource.TestCheckNoResourceAttr(resourceName, "svc_parameters.#"),
+				),
+			},
+			// A record in AliasMode cannot have SVC parameters
+			{
+				Config:      fake.ProviderConfig() + testAccRecordsvcbAliasMode(name, 0, `[{ svc_key = "port", svc_value = ["8443"] }]`),
+				ExpectError: regexp.MustCompile("Invalid SVC parameters"),
+			},
+		},
+	})
+}
+
+func TestAccRecordsvcbResource_InvalidSvcParameters(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The addresses of ipv4hint are IPv4 addresses
+			{
+				Config:      fake.ProviderConfig() + testAccRecordsvcbSvcParameters(name, `[{ svc_key = "ipv4hint", svc_value = ["2001:db8::1"] }]`),
+				ExpectError: regexp.MustCompile("Invalid SVC parameter value"),
+			},
+			// A key appears once
+			{
+				Config:      fake.ProviderConfig() + testAccRecordsvcbSvcParameters(name, `[{ svc_key = "port", svc_value = ["443"] }, { svc_key = "port", svc_value = ["8443"] }]`),
+				ExpectError: regexp.MustCompile("Duplicate SVC parameter"),
+			},
+			// no-default-alpn requires alpn
+			{
+				Config:      fake.ProviderConfig() + testAccRecordsvcbSvcParameters(name, `[{ svc_key = "no-default-alpn" }]`),
+				ExpectError: regexp.MustCompile("requires the alpn parameter"),
+			},
+		},
+	})
+}
+
+func testAccRecordsvcbImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordsvcbExists(ctx context.Context, resourceName string, v *dns.RecordSvcb) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,extattrs,forbid_reclamation,last_queried,name,priority,reclaimable,svc_parameters,target_name,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordsvcbAPI.
+			RecordsvcbReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordsvcbDestroy(ctx context.Context, v *dns.RecordSvcb) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,extattrs,forbid_reclamation,last_queried,name,priority,reclaimable,svc_parameters,target_name,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordsvcbAPI.
+			RecordsvcbReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordsvcbDisappears(ctx context.Context, v *dns.RecordSvcb) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordsvcbAPI.
+			RecordsvcbReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordsvcbBasicConfig(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+}
+`, name, view)
+}
+
+func testAccRecordsvcbComment(name, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_comment" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	comment = %q
+}
+`, name, view, comment)
+}
+
+func testAccRecordsvcbCreator(name, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_creator" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	creator = %q
+}
+`, name, view, creator)
+}
+
+func testAccRecordsvcbDdnsPrincipal(name, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_ddns_principal" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	ddns_principal = %q
+}
+`, name, view, ddnsPrincipal)
+}
+
+func testAccRecordsvcbDdnsProtected(name, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_ddns_protected" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	ddns_protected = %q
+}
+`, name, view, ddnsProtected)
+}
+
+func testAccRecordsvcbDisable(name, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_disable" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	disable = %q
+}
+`, name, view, disable)
+}
+
+func testAccRecordsvcbExtattrs(name, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_extattrs" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	extattrs = %s
+}
+`, name, view, extattrsStr)
+}
+
+func testAccRecordsvcbForbidReclamation(name, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_forbid_reclamation" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, view, forbidReclamation)
+}
+
+func testAccRecordsvcbName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_name" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+}
+`, name)
+}
+
+func testAccRecordsvcbTtl(name, view string, ttl int32, useTtl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_ttl" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, view, ttl, useTtl)
+}
+
+func testAccRecordsvcbUseTtl(name, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_use_ttl" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, view, useTtl, ttl)
+}
+
+func testAccRecordsvcbPriority(name string, priority int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_priority" {
+	name = %q
+	priority = %d
+	target_name = "svc.example.com"
+}
+`, name, priority)
+}
+
+func testAccRecordsvcbSvcParameters(name, svcParameters string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_svc_parameters" {
+	name = %q
+	priority = 1
+	target_name = "svc.example.com"
+	svc_parameters = %s
+}
+`, name, svcParameters)
+}
+
+func testAccRecordsvcbTargetName(name, targetName string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_target_name" {
+	name = %q
+	priority = 1
+	target_name = %q
+}
+`, name, targetName)
+}
+
+func testAccRecordsvcbAliasMode(name string, priority int32, svcParameters string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_svcb_record" "test_alias_mode" {
+	name = %q
+	priority = %d
+	target_name = "svc.example.com"
+	svc_parameters = %s
+}
+`, name, priority, svcParameters)
+}
@@ -0,0 +1,121 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordtlsaDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_tlsa_records.test"
+	resourceName := "nios_dns_tlsa_record.test"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordtlsaDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordtlsaDataSourceConfigFilters(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordtlsaResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordtlsaDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_tlsa_records.test"
+	resourceName := "nios_dns_tlsa_record.test"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordtlsaDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordtlsaDataSourceConfigTagFilters(name, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordtlsaResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordtlsaResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "certificate_data", dataSourceName, "result.0.certificate_data"),
+		resource.TestCheckResourceAttrPair(resourceName, "certificate_usage", dataSourceName, "result.0.certificate_usage"),
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "matched_type", dataSourceName, "result.0.matched_type"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "selector", dataSourceName, "result.0.selector"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordtlsaDataSourceConfigFilters(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+}
+
+data "nios_dns_tlsa_records" "test" {
+	filters = {
+		"name": nios_dns_tlsa_record.test.name
+	}
+}
+`, name, view)
+}
+
+func testAccRecordtlsaDataSourceConfigTagFilters(name, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_tlsa_records" "test" {
+	filters = {
+		"*Site" = nios_dns_tlsa_record.test.extattrs.Site.value
+	}
+}
+`, name, view, extAttrsValue)
+}
@@ -0,0 +1,723 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordtlsaResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_tlsa_record.test"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordtlsaDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordtlsaBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					testAccCheckRecordtlsaDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_comment"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_creator"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaCreator(name, "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaCreator(name, "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_disable"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaDisable(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaDisable(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_extattrs"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_name"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+	updatedName := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaName(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_ttl"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaTtl(name, "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaTtl(name, "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_use_ttl"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaUseTtl(name, "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaUseTtl(name, "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:tlsa"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordtlsaComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_usage", "3"),
+					resource.TestCheckResourceAttr(resourceName, "selector", "1"),
+					resource.TestCheckResourceAttr(resourceName, "matched_type", "1"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_data", "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"),
+					resource.TestCheckResourceAttr(resourceName, "dns_name", name),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordtlsaComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordtlsaComment(name, "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordtlsaImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_CertificateData(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_certificate_data"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaCertificateData(name, "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_data", "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaCertificateData(name, "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_data", "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_CertificateUsage(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_certificate_usage"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaCertificateUsage(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_usage", "3"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaCertificateUsage(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate_usage", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_MatchedType(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_matched_type"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaMatchedType(name, 1, "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "matched_type", "1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaMatchedType(name, 2, "ccb3537a8806e3ca0d9a82598ae0d085ec4edf232e2e733d42367428e690537fed5d39dae8144e26e2a168b533ba36fdda2e454d37e8256599a013bbd783ca17"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "matched_type", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_Selector(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_selector"
+	var v dns.RecordTlsa
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtlsaSelector(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "selector", "1"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtlsaSelector(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtlsaExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "selector", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtlsaResource_CertificateDataLength(t *testing.T) {
+	var resourceName = "nios_dns_tlsa_record.test_matched_type"
+	fake := acctest.NewFakeWAPI(t)
+	name := "_443._tcp." + acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:tlsa"),
+		Steps: []resource.TestStep{
+			// The full data has any length
+			{
+				Config: fake.ProviderConfig() + testAccRecordtlsaMatchedType(name, 0, "3059301306072a8648ce3d020106082a8648ce3d03010703420004"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "matched_type", "0"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_data", "3059301306072a8648ce3d020106082a8648ce3d03010703420004"),
+				),
+			},
+			// A SHA-512 hash is 64 bytes
+			{
+				Config:      fake.ProviderConfig() + testAccRecordtlsaMatchedType(name, 2, "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"),
+				ExpectError: regexp.MustCompile("Invalid TLSA certificate data"),
+			},
+			// The certificate data is hexadecimal
+			{
+				Config:      fake.ProviderConfig() + testAccRecordtlsaMatchedType(name, 0, "not hexadecimal"),
+				ExpectError: regexp.MustCompile("hexadecimal digits"),
+			},
+		},
+	})
+}
+
+func testAccRecordtlsaImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordtlsaExists(ctx context.Context, resourceName string, v *dns.RecordTlsa) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "certificate_data,certificate_usage,cloud_info,comment,creator,disable,dns_name,extattrs,last_queried,matched_type,name,selector,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordtlsaAPI.
+			RecordtlsaReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordtlsaDestroy(ctx context.Context, v *dns.RecordTlsa) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "certificate_data,certificate_usage,cloud_info,comment,creator,disable,dns_name,extattrs,last_queried,matched_type,name,selector,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordtlsaAPI.
+			RecordtlsaReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordtlsaDisappears(ctx context.Context, v *dns.RecordTlsa) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordtlsaAPI.
+			RecordtlsaReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordtlsaBasicConfig(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+}
+`, name, view)
+}
+
+func testAccRecordtlsaComment(name, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_comment" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+	comment = %q
+}
+`, name, view, comment)
+}
+
+func testAccRecordtlsaCreator(name, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_creator" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+	creator = %q
+}
+`, name, view, creator)
+}
+
+func testAccRecordtlsaDisable(name, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_disable" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+	disable = %q
+}
+`, name, view, disable)
+}
+
+func testAccRecordtlsaExtattrs(name, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_extattrs" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+	extattrs = %s
+}
+`, name, view, extattrsStr)
+}
+
+func testAccRecordtlsaName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_name" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+}
+`, name)
+}
+
+func testAccRecordtlsaTtl(name, view string, ttl int32, useTtl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_ttl" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, view, ttl, useTtl)
+}
+
+func testAccRecordtlsaUseTtl(name, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_use_ttl" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, view, useTtl, ttl)
+}
+
+func testAccRecordtlsaCertificateData(name, certificateData string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_certificate_data" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = 1
+	certificate_data = %q
+}
+`, name, certificateData)
+}
+
+func testAccRecordtlsaCertificateUsage(name string, certificateUsage int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_certificate_usage" {
+	name = %q
+	certificate_usage = %d
+	selector = 1
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+}
+`, name, certificateUsage)
+}
+
+func testAccRecordtlsaMatchedType(name string, matchedType int32, certificateData string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_matched_type" {
+	name = %q
+	certificate_usage = 3
+	selector = 1
+	matched_type = %d
+	certificate_data = %q
+}
+`, name, matchedType, certificateData)
+}
+
+func testAccRecordtlsaSelector(name string, selector int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_tlsa_record" "test_selector" {
+	name = %q
+	certificate_usage = 3
+	selector = %d
+	matched_type = 1
+	certificate_data = "8cb0fc6c527506a053f4f14c8464bebbd6dede2738d11468dd953d7d6a3021f1"
+}
+`, name, selector)
+}
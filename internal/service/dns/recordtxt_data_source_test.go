@@ -0,0 +1,116 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordtxtDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_txt_records.test"
+	resourceName := "nios_dns_txt_record.test"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordtxtDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordtxtDataSourceConfigFilters(name, "v=spf1 mx -all", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordtxtResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordtxtDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_txt_records.test"
+	resourceName := "nios_dns_txt_record.test"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordtxtDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordtxtDataSourceConfigTagFilters(name, "v=spf1 mx -all", "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordtxtResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordtxtResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_principal", dataSourceName, "result.0.ddns_principal"),
+		resource.TestCheckResourceAttrPair(resourceName, "ddns_protected", dataSourceName, "result.0.ddns_protected"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "forbid_reclamation", dataSourceName, "result.0.forbid_reclamation"),
+		resource.TestCheckResourceAttrPair(resourceName, "text", dataSourceName, "result.0.text"),
+		resource.TestCheckResourceAttrPair(resourceName, "character_strings", dataSourceName, "result.0.character_strings"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordtxtDataSourceConfigFilters(name, text, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test" {
+	name = %q
+	text = %q
+	view = %q
+}
+
+data "nios_dns_txt_records" "test" {
+	filters = {
+		"name": nios_dns_txt_record.test.name
+	}
+}
+`, name, text, view)
+}
+
+func testAccRecordtxtDataSourceConfigTagFilters(name, text, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test" {
+	name = %q
+	text = %q
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_txt_records" "test" {
+	filters = {
+		"*Site" = nios_dns_txt_record.test.extattrs.Site.value
+	}
+}
+`, name, text, view, extAttrsValue)
+}
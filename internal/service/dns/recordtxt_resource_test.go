@@ -0,0 +1,781 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordtxtResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtBasicConfig(name, "v=spf1 mx -all", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_txt_record.test"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordtxtDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordtxtBasicConfig(name, "v=spf1 mx -all", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					testAccCheckRecordtxtDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordtxtResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_comment"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtComment(name, "v=spf1 mx -all", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtComment(name, "v=spf1 mx -all", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_creator"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtCreator(name, "v=spf1 mx -all", "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtCreator(name, "v=spf1 mx -all", "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_DdnsPrincipal(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_ddns_principal"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtDdnsPrincipal(name, "v=spf1 mx -all", "default", "host/txt.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/txt.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtDdnsPrincipal(name, "v=spf1 mx -all", "default", "host/txt-updated.example.com@EXAMPLE.COM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_principal", "host/txt-updated.example.com@EXAMPLE.COM"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_DdnsProtected(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_ddns_protected"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtDdnsProtected(name, "v=spf1 mx -all", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtDdnsProtected(name, "v=spf1 mx -all", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ddns_protected", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_disable"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtDisable(name, "v=spf1 mx -all", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtDisable(name, "v=spf1 mx -all", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_extattrs"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtExtattrs(name, "v=spf1 mx -all", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtExtattrs(name, "v=spf1 mx -all", "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_ForbidReclamation(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_forbid_reclamation"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtForbidReclamation(name, "v=spf1 mx -all", "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtForbidReclamation(name, "v=spf1 mx -all", "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forbid_reclamation", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_Text(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_text"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtText(name, "v=spf1 mx -all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "text", "v=spf1 mx -all"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtText(name, "v=spf1 -all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "text", "v=spf1 -all"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_name"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtName(name, "v=spf1 mx -all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtName(updatedName, "v=spf1 mx -all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_ttl"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtTtl(name, "v=spf1 mx -all", "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtTtl(name, "v=spf1 mx -all", "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_use_ttl"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtUseTtl(name, "v=spf1 mx -all", "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtUseTtl(name, "v=spf1 mx -all", "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:txt"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordtxtComment(name, "v=spf1 mx -all", "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "text", "v=spf1 mx -all"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordtxtComment(name, "v=spf1 mx -all", "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "text", "v=spf1 mx -all"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordtxtComment(name, "v=spf1 mx -all", "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordtxtImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_CharacterStrings(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_character_strings"
+	var v dns.RecordTxt
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordtxtCharacterStrings(name, []string{"part 1", "part 2"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.0", "part 1"),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.1", "part 2"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordtxtCharacterStrings(name, []string{`say "hi"`}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordtxtExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.0", `say "hi"`),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordtxtResource_FakeWAPILongText(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_text"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+	key := strings.Repeat("k", 300)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:txt"),
+		Steps: []resource.TestStep{
+			// The text is split in character-strings of 255 bytes, and stays as configured although the grid quotes them
+			{
+				Config: fake.ProviderConfig() + testAccRecordtxtText(name, "v=DKIM1; p="+key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "text", "v=DKIM1; p="+key),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.0", "v=DKIM1; p="+key[:244]),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.1", key[244:]),
+				),
+			},
+			// The same value in the WAPI syntax has no diff
+			{
+				Config:   fake.ProviderConfig() + testAccRecordtxtText(name, `"v=DKIM1; p=`+key[:244]+`" "`+key[244:]+`"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordtxtResource_FakeWAPICharacterStrings(t *testing.T) {
+	var resourceName = "nios_dns_txt_record.test_character_strings"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+	long := strings.Repeat("a", 300)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:txt"),
+		Steps: []resource.TestStep{
+			// The character-strings are quoted and escaped in the text
+			{
+				Config: fake.ProviderConfig() + testAccRecordtxtCharacterStrings(name, []string{"part 1", `say "hi"`}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "text", `"part 1" "say \"hi\""`),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.#", "2"),
+				),
+			},
+			// A character-string longer than 255 bytes is split, and stays as configured
+			{
+				Config: fake.ProviderConfig() + testAccRecordtxtCharacterStrings(name, []string{long}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "text", `"`+long[:255]+`" "`+long[255:]+`"`),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "character_strings.0", long),
+				),
+			},
+			// The text and the character-strings cannot be both set
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_character_strings" {
+	name = %q
+	text = "v=spf1 -all"
+	character_strings = ["v=spf1 -all"]
+}
+`, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccRecordtxtImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordtxtExists(ctx context.Context, resourceName string, v *dns.RecordTxt) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,extattrs,forbid_reclamation,last_queried,name,reclaimable,shared_record_group,text,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		//rs.Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordtxtAPI.
+			RecordtxtReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordtxtDestroy(ctx context.Context, v *dns.RecordTxt) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "aws_rte53_record_info,cloud_info,comment,creation_time,creator,ddns_principal,ddns_protected,disable,dns_name,extattrs,forbid_reclamation,last_queried,name,reclaimable,shared_record_group,text,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordtxtAPI.
+			RecordtxtReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordtxtDisappears(ctx context.Context, v *dns.RecordTxt) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordtxtAPI.
+			RecordtxtReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordtxtBasicConfig(name, text, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test" {
+	name = %q
+	text = %q
+	view = %q
+}
+`, name, text, view)
+}
+
+func testAccRecordtxtComment(name, text, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_comment" {
+	name = %q
+	text = %q
+	view = %q
+	comment = %q
+}
+`, name, text, view, comment)
+}
+
+func testAccRecordtxtCreator(name, text, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_creator" {
+	name = %q
+	text = %q
+	view = %q  
+	creator = %q
+}
+`, name, text, view, creator)
+}
+
+func testAccRecordtxtDdnsPrincipal(name, text, view, ddnsPrincipal string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_ddns_principal" {
+	name = %q
+	text = %q
+	view = %q
+	ddns_principal = %q
+}
+`, name, text, view, ddnsPrincipal)
+}
+
+func testAccRecordtxtDdnsProtected(name, text, view, ddnsProtected string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_ddns_protected" {
+	name = %q
+	text = %q
+	view = %q
+	ddns_protected = %q
+}
+`, name, text, view, ddnsProtected)
+}
+
+func testAccRecordtxtDisable(name, text, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_disable" {
+	name = %q
+	text = %q
+	view = %q
+	disable = %q
+}
+`, name, text, view, disable)
+}
+
+func testAccRecordtxtExtattrs(name, text, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_extattrs" {
+	name = %q
+	text = %q
+	view = %q
+	extattrs = %s
+}
+`, name, text, view, extattrsStr)
+}
+
+func testAccRecordtxtForbidReclamation(name, text, view, forbidReclamation string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_forbid_reclamation" {
+	name = %q
+	text = %q
+	view = %q
+	forbid_reclamation = %q
+}
+`, name, text, view, forbidReclamation)
+}
+
+func testAccRecordtxtText(name, text string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_text" {
+	name = %q
+	text = %q
+}
+`, name, text)
+}
+
+func testAccRecordtxtName(name, text string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_name" {
+	name = %q
+	text = %q
+}
+`, name, text)
+}
+
+func testAccRecordtxtTtl(name, text, view string, ttl int32, use_ttl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_ttl" {
+	name = %q
+	text = %q
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, text, view, ttl, use_ttl)
+}
+
+func testAccRecordtxtUseTtl(name, text, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_use_ttl" {
+	name = %q
+	text = %q
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, text, view, useTtl, ttl)
+}
+
+func testAccRecordtxtCharacterStrings(name string, characterStrings []string) string {
+	quoted := make([]string, len(characterStrings))
+	for i, s := range characterStrings {
+		quoted[i] = strconv.Quote(s)
+	}
+	return fmt.Sprintf(`
+resource "nios_dns_txt_record" "test_character_strings" {
+	name = %q
+	character_strings = [%s]
+}
+`, name, strings.Join(quoted, ", "))
+}
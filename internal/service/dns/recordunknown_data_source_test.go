@@ -0,0 +1,125 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordunknownDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_unknown_records.test"
+	resourceName := "nios_dns_unknown_record.test"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordunknownDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordunknownDataSourceConfigFilters(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordunknownResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRecordunknownDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_unknown_records.test"
+	resourceName := "nios_dns_unknown_record.test"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordunknownDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordunknownDataSourceConfigTagFilters(name, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					}, testAccCheckRecordunknownResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRecordunknownResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "creator", dataSourceName, "result.0.creator"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "display_rdata", dataSourceName, "result.0.display_rdata"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "result.0.name"),
+		resource.TestCheckResourceAttrPair(resourceName, "record_type", dataSourceName, "result.0.record_type"),
+		resource.TestCheckResourceAttrPair(resourceName, "ttl", dataSourceName, "result.0.ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_ttl", dataSourceName, "result.0.use_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+	}
+}
+
+func testAccRecordunknownDataSourceConfigFilters(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+}
+
+data "nios_dns_unknown_records" "test" {
+	filters = {
+		"name": nios_dns_unknown_record.test.name
+	}
+}
+`, name, view)
+}
+
+func testAccRecordunknownDataSourceConfigTagFilters(name, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_unknown_records" "test" {
+	filters = {
+		"*Site" = nios_dns_unknown_record.test.extattrs.Site.value
+	}
+}
+`, name, view, extAttrsValue)
+}
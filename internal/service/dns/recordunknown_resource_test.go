@@ -0,0 +1,647 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRecordunknownResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					// TODO: check and validate these
+					// Test Read Only fields
+					// Test fields with default value
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_unknown_record.test"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckRecordunknownDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordunknownBasicConfig(name, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					testAccCheckRecordunknownDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRecordunknownResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_comment"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordunknownComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_Creator(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_creator"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownCreator(name, "default", "STATIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "STATIC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordunknownCreator(name, "default", "DYNAMIC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "creator", "DYNAMIC"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_disable"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownDisable(name, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordunknownDisable(name, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_extattrs"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordunknownExtattrs(name, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_Name(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_name"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+	updatedName := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordunknownName(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_Ttl(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_ttl"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownTtl(name, "default", 10, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "10"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordunknownTtl(name, "default", 30, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_UseTtl(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_use_ttl"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownUseTtl(name, "default", "true", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordunknownUseTtl(name, "default", "false", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_ttl", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:unknown"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordunknownComment(name, "default", "This is a new record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "record_type", "65280"),
+					resource.TestCheckResourceAttr(resourceName, "display_rdata", "0a0b0c"),
+					resource.TestCheckResourceAttr(resourceName, "dns_name", name),
+					resource.TestCheckResourceAttr(resourceName, "zone", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new record"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordunknownComment(name, "default", "This is an updated record"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated record"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccRecordunknownComment(name, "default", "This is an updated record"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordunknownImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_RecordType(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_record_type"
+	var v dns.RecordUnknown
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccRecordunknownRecordType(name, 65280),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "record_type", "65280"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccRecordunknownRecordType(name, 65281),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordunknownExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "record_type", "65281"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecordunknownResource_SubfieldValues(t *testing.T) {
+	var resourceName = "nios_dns_unknown_record.test_subfield_values"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("record:unknown"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordunknownSubfieldValues(name, "S", "10", "N", "host.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "subfield_values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subfield_values.0.include_length", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "subfield_values.1.field_value", "host.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "display_rdata", "10 host.example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccRecordunknownSubfieldValues(name, "S", "20", "4", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "subfield_values.1.field_type", "4"),
+					resource.TestCheckResourceAttr(resourceName, "display_rdata", "20 192.0.2.1"),
+				),
+			},
+			// A value must have the format of its field type
+			{
+				Config:      fake.ProviderConfig() + testAccRecordunknownSubfieldValues(name, "B", "256", "4", "192.0.2.1"),
+				ExpectError: regexp.MustCompile("Invalid RDATA subfield value"),
+			},
+			// The meta types cannot be records
+			{
+				Config:      fake.ProviderConfig() + testAccRecordunknownRecordType(name, 255),
+				ExpectError: regexp.MustCompile("Invalid Record Type"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecordunknownImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckRecordunknownExists(ctx context.Context, resourceName string, v *dns.RecordUnknown) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	var readableAttributes = "cloud_info,comment,creator,disable,display_rdata,dns_name,enable_host_name_policy,extattrs,last_queried,name,policy,record_type,subfield_values,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		state.RootModule().Resources[resourceName].Primary.ID = utils.ExtractResourceRef(rs.Primary.Attributes["ref"])
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			RecordunknownAPI.
+			RecordunknownReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributes).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckRecordunknownDestroy(ctx context.Context, v *dns.RecordUnknown) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	var readableAttributes = "cloud_info,comment,creator,disable,display_rdata,dns_name,enable_host_name_policy,extattrs,last_queried,name,policy,record_type,subfield_values,ttl,use_ttl,view,zone"
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			RecordunknownAPI.
+			RecordunknownReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributes).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckRecordunknownDisappears(ctx context.Context, v *dns.RecordUnknown) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			RecordunknownAPI.
+			RecordunknownReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccRecordunknownBasicConfig(name, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+}
+`, name, view)
+}
+
+func testAccRecordunknownComment(name, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_comment" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+	comment = %q
+}
+`, name, view, comment)
+}
+
+func testAccRecordunknownCreator(name, view, creator string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_creator" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+	creator = %q
+}
+`, name, view, creator)
+}
+
+func testAccRecordunknownDisable(name, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_disable" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+	disable = %q
+}
+`, name, view, disable)
+}
+
+func testAccRecordunknownExtattrs(name, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_extattrs" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+	extattrs = %s
+}
+`, name, view, extattrsStr)
+}
+
+func testAccRecordunknownName(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_name" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+}
+`, name)
+}
+
+func testAccRecordunknownTtl(name, view string, ttl int32, useTtl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_ttl" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+	ttl = %d
+	use_ttl = %q
+}
+`, name, view, ttl, useTtl)
+}
+
+func testAccRecordunknownUseTtl(name, view, useTtl string, ttl int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_use_ttl" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = "X"
+			field_value = "0a0b0c"
+		},
+	]
+	view = %q
+	use_ttl = %q
+	ttl = %d
+}
+`, name, view, useTtl, ttl)
+}
+
+func testAccRecordunknownRecordType(name string, recordType int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_record_type" {
+	name = %q
+	record_type = %d
+}
+`, name, recordType)
+}
+
+func testAccRecordunknownSubfieldValues(name, fieldType1, fieldValue1, fieldType2, fieldValue2 string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_unknown_record" "test_subfield_values" {
+	name = %q
+	record_type = 65280
+	subfield_values = [
+		{
+			field_type  = %q
+			field_value = %q
+		},
+		{
+			field_type  = %q
+			field_value = %q
+		},
+	]
+}
+`, name, fieldType1, fieldValue1, fieldType2, fieldValue2)
+}
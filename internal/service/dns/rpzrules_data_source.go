@@ -0,0 +1,270 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/flex"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RPZRulesDataSource{}
+
+func NewRPZRulesDataSource() datasource.DataSource {
+	return &RPZRulesDataSource{}
+}
+
+// RPZRulesDataSource defines the data source implementation. It lists the rules of a response policy zone, with a
+// list for each type of rule.
+type RPZRulesDataSource struct {
+	client *niosclient.APIClient
+}
+
+func (d *RPZRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "rpz_rules"
+}
+
+type RPZRulesModel struct {
+	RpZone                    types.String `tfsdk:"rp_zone"`
+	View                      types.String `tfsdk:"view"`
+	ARules                    types.List   `tfsdk:"a_rules"`
+	AaaaRules                 types.List   `tfsdk:"aaaa_rules"`
+	CnameClientipaddressRules types.List   `tfsdk:"cname_clientipaddress_rules"`
+	CnameIpaddressRules       types.List   `tfsdk:"cname_ipaddress_rules"`
+	CnameRules                types.List   `tfsdk:"cname_rules"`
+}
+
+func (d *RPZRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	rules := func(attributes map[string]schema.Attribute, description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: attributes,
+			},
+			Computed:            true,
+			MarkdownDescription: description,
+		}
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes: map[string]schema.Attribute{
+			"rp_zone": schema.StringAttribute{
+				Required:            true,
+				Validators:          []validator.String{domainNameValidator()},
+				MarkdownDescription: "The name of the response policy zone whose rules are listed.",
+			},
+			"view": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The DNS view of the response policy zone. Defaults to `default`.",
+			},
+			"a_rules": rules(utils.DataSourceAttributeMap(RecordRPZAResourceSchemaAttributes, &resp.Diagnostics),
+				"The rules substituting an IPv4 address for a domain name."),
+			"aaaa_rules": rules(utils.DataSourceAttributeMap(RecordRPZAAAAResourceSchemaAttributes, &resp.Diagnostics),
+				"The rules substituting an IPv6 address for a domain name."),
+			"cname_clientipaddress_rules": rules(utils.DataSourceAttributeMap(RecordRPZCNAMEClientipaddressResourceSchemaAttributes, &resp.Diagnostics),
+				"The rules on the IP addresses of the clients."),
+			"cname_ipaddress_rules": rules(utils.DataSourceAttributeMap(RecordRPZCNAMEIpaddressResourceSchemaAttributes, &resp.Diagnostics),
+				"The rules on the IP addresses in the answers."),
+			"cname_rules": rules(utils.DataSourceAttributeMap(RecordRPZCNAMEResourceSchemaAttributes, &resp.Diagnostics),
+				"The rules on the queried domain names."),
+		},
+	}
+}
+
+func (d *RPZRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RPZRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RPZRulesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.View.IsNull() {
+		data.View = types.StringValue("default")
+	}
+	// The rules of a response policy zone have the zone as their zone
+	filters := map[string]interface{}{
+		"zone": data.RpZone.ValueString(),
+		"view": data.View.ValueString(),
+	}
+
+	utils.CheckWAPIFieldsSupport(ctx, d.client, "record:rpz:a", readableAttributesForRecordrpza, &resp.Diagnostics)
+	utils.CheckWAPIFieldsSupport(ctx, d.client, "record:rpz:aaaa", readableAttributesForRecordrpzaaaa, &resp.Diagnostics)
+	utils.CheckWAPIFieldsSupport(ctx, d.client, "record:rpz:cname:clientipaddress", readableAttributesForRecordrpzcnameclientipaddress, &resp.Diagnostics)
+	utils.CheckWAPIFieldsSupport(ctx, d.client, "record:rpz:cname:ipaddress", readableAttributesForRecordrpzcnameipaddress, &resp.Diagnostics)
+	utils.CheckWAPIFieldsSupport(ctx, d.client, "record:rpz:cname", readableAttributesForRecordrpzcname, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A response policy zone can hold more rules than WAPI returns at once
+	var httpRes *http.Response
+	aRules, err := utils.ReadWithPageIds(func(pageId string, limit int32) ([]dns.RecordRpzA, string, error) {
+		req := d.client.DNSAPI.
+			RecordrpzaAPI.
+			Get(ctx).
+			Filters(filters).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordrpza).
+			Paging(1).
+			MaxResults(limit)
+		if pageId != "" {
+			req = req.PageId(pageId)
+		}
+		var res *dns.ListRecordRpzAResponse
+		var err error
+		res, httpRes, err = req.Execute()
+		if err != nil {
+			return nil, "", err
+		}
+		nextPageId, _ := res.ListRecordRpzAResponseObject.AdditionalProperties["next_page_id"].(string)
+		return res.ListRecordRpzAResponseObject.GetResult(), nextPageId, nil
+	})
+	if err != nil {
+		utils.AddWAPIError(&resp.Diagnostics, "read", "Recordrpza", err, httpRes)
+		return
+	}
+	data.ARules = flex.FlattenFrameworkListNestedBlock(ctx, aRules, RecordRPZAAttrTypes, &resp.Diagnostics, FlattenRecordRPZA)
+
+	aaaaRules, err := utils.ReadWithPageIds(func(pageId string, limit int32) ([]dns.RecordRpzAaaa, string, error) {
+		req := d.client.DNSAPI.
+			RecordrpzaaaaAPI.
+			Get(ctx).
+			Filters(filters).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordrpzaaaa).
+			Paging(1).
+			MaxResults(limit)
+		if pageId != "" {
+			req = req.PageId(pageId)
+		}
+		var res *dns.ListRecordRpzAaaaResponse
+		var err error
+		res, httpRes, err = req.Execute()
+		if err != nil {
+			return nil, "", err
+		}
+		nextPageId, _ := res.ListRecordRpzAaaaResponseObject.AdditionalProperties["next_page_id"].(string)
+		return res.ListRecordRpzAaaaResponseObject.GetResult(), nextPageId, nil
+	})
+	if err != nil {
+		utils.AddWAPIError(&resp.Diagnostics, "read", "Recordrpzaaaa", err, httpRes)
+		return
+	}
+	data.AaaaRules = flex.FlattenFrameworkListNestedBlock(ctx, aaaaRules, RecordRPZAAAAAttrTypes, &resp.Diagnostics, FlattenRecordRPZAAAA)
+
+	clientRules, err := utils.ReadWithPageIds(func(pageId string, limit int32) ([]dns.RecordRpzCnameClientipaddress, string, error) {
+		req := d.client.DNSAPI.
+			RecordrpzcnameclientipaddressAPI.
+			Get(ctx).
+			Filters(filters).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordrpzcnameclientipaddress).
+			Paging(1).
+			MaxResults(limit)
+		if pageId != "" {
+			req = req.PageId(pageId)
+		}
+		var res *dns.ListRecordRpzCnameClientipaddressResponse
+		var err error
+		res, httpRes, err = req.Execute()
+		if err != nil {
+			return nil, "", err
+		}
+		nextPageId, _ := res.ListRecordRpzCnameClientipaddressResponseObject.AdditionalProperties["next_page_id"].(string)
+		return res.ListRecordRpzCnameClientipaddressResponseObject.GetResult(), nextPageId, nil
+	})
+	if err != nil {
+		utils.AddWAPIError(&resp.Diagnostics, "read", "Recordrpzcnameclientipaddress", err, httpRes)
+		return
+	}
+	data.CnameClientipaddressRules = flex.FlattenFrameworkListNestedBlock(ctx, clientRules, RecordRPZCNAMEClientipaddressAttrTypes, &resp.Diagnostics, FlattenRecordRPZCNAMEClientipaddress)
+
+	ipRules, err := utils.ReadWithPageIds(func(pageId string, limit int32) ([]dns.RecordRpzCnameIpaddress, string, error) {
+		req := d.client.DNSAPI.
+			RecordrpzcnameipaddressAPI.
+			Get(ctx).
+			Filters(filters).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordrpzcnameipaddress).
+			Paging(1).
+			MaxResults(limit)
+		if pageId != "" {
+			req = req.PageId(pageId)
+		}
+		var res *dns.ListRecordRpzCnameIpaddressResponse
+		var err error
+		res, httpRes, err = req.Execute()
+		if err != nil {
+			return nil, "", err
+		}
+		nextPageId, _ := res.ListRecordRpzCnameIpaddressResponseObject.AdditionalProperties["next_page_id"].(string)
+		return res.ListRecordRpzCnameIpaddressResponseObject.GetResult(), nextPageId, nil
+	})
+	if err != nil {
+		utils.AddWAPIError(&resp.Diagnostics, "read", "Recordrpzcnameipaddress", err, httpRes)
+		return
+	}
+	data.CnameIpaddressRules = flex.FlattenFrameworkListNestedBlock(ctx, ipRules, RecordRPZCNAMEIpaddressAttrTypes, &resp.Diagnostics, FlattenRecordRPZCNAMEIpaddress)
+
+	cnameRules, err := utils.ReadWithPageIds(func(pageId string, limit int32) ([]dns.RecordRpzCname, string, error) {
+		req := d.client.DNSAPI.
+			RecordrpzcnameAPI.
+			Get(ctx).
+			Filters(filters).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForRecordrpzcname).
+			Paging(1).
+			MaxResults(limit)
+		if pageId != "" {
+			req = req.PageId(pageId)
+		}
+		var res *dns.ListRecordRpzCnameResponse
+		var err error
+		res, httpRes, err = req.Execute()
+		if err != nil {
+			return nil, "", err
+		}
+		nextPageId, _ := res.ListRecordRpzCnameResponseObject.AdditionalProperties["next_page_id"].(string)
+		return res.ListRecordRpzCnameResponseObject.GetResult(), nextPageId, nil
+	})
+	if err != nil {
+		utils.AddWAPIError(&resp.Diagnostics, "read", "Recordrpzcname", err, httpRes)
+		return
+	}
+	data.CnameRules = flex.FlattenFrameworkListNestedBlock(ctx, cnameRules, RecordRPZCNAMEAttrTypes, &resp.Diagnostics, FlattenRecordRPZCNAME)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
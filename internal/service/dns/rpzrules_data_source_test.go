@@ -0,0 +1,168 @@
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccRPZRulesDataSource_RpZone(t *testing.T) {
+	dataSourceName := "data.nios_rpz_rules.test"
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRPZRulesDataSourceConfigRpZone(rpZone),
+				Check:  resource.ComposeTestCheckFunc(testAccCheckRPZRulesResourceAttrPair(dataSourceName)...),
+			},
+		},
+	})
+}
+
+func TestAccRPZRulesDataSource_FakeWAPI(t *testing.T) {
+	dataSourceName := "data.nios_rpz_rules.test"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_rp"),
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + testAccRPZRulesDataSourceConfigRpZone(rpZone),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						resource.TestCheckResourceAttr(dataSourceName, "view", "default"),
+						resource.TestCheckResourceAttr(dataSourceName, "cname_rules.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "cname_ipaddress_rules.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "cname_clientipaddress_rules.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "a_rules.#", "1"),
+						resource.TestCheckResourceAttr(dataSourceName, "aaaa_rules.#", "1"),
+					}, testAccCheckRPZRulesResourceAttrPair(dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccRPZRulesDataSource_FakeWAPIPaging(t *testing.T) {
+	dataSourceName := "data.nios_rpz_rules.test"
+	fake := acctest.NewFakeWAPI(t)
+	rpZone := acctest.RandomName() + ".rpz.example.com"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_rp"),
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + testAccRPZRulesDataSourceConfigEmpty(rpZone),
+				Check:  resource.TestCheckResourceAttr(dataSourceName, "cname_rules.#", "0"),
+			},
+			// The rules are read in pages of 1000
+			{
+				PreConfig: func() {
+					for i := 0; i < 2500; i++ {
+						if _, err := fake.Create("record:rpz:cname", map[string]interface{}{
+							"name":      fmt.Sprintf("host%d.example.org.%s", i, rpZone),
+							"rp_zone":   rpZone,
+							"canonical": "",
+						}); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config: fake.ProviderConfig() + testAccRPZRulesDataSourceConfigEmpty(rpZone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cname_rules.#", "2500"),
+					resource.TestCheckResourceAttr(dataSourceName, "cname_rules.2499.name", "host2499.example.org."+rpZone),
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckRPZRulesResourceAttrPair(dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair("nios_rpz_cname_rule.test", "ref", dataSourceName, "cname_rules.0.ref"),
+		resource.TestCheckResourceAttrPair("nios_rpz_cname_rule.test", "rule_type", dataSourceName, "cname_rules.0.rule_type"),
+		resource.TestCheckResourceAttrPair("nios_rpz_cname_rule.test", "name", dataSourceName, "cname_rules.0.name"),
+		resource.TestCheckResourceAttrPair("nios_rpz_cname_ipaddress_rule.test", "ref", dataSourceName, "cname_ipaddress_rules.0.ref"),
+		resource.TestCheckResourceAttrPair("nios_rpz_cname_ipaddress_rule.test", "rule_type", dataSourceName, "cname_ipaddress_rules.0.rule_type"),
+		resource.TestCheckResourceAttrPair("nios_rpz_cname_ipaddress_rule.test", "is_ipv4", dataSourceName, "cname_ipaddress_rules.0.is_ipv4"),
+		resource.TestCheckResourceAttrPair("nios_rpz_cname_clientipaddress_rule.test", "ref", dataSourceName, "cname_clientipaddress_rules.0.ref"),
+		resource.TestCheckResourceAttrPair("nios_rpz_cname_clientipaddress_rule.test", "rule_type", dataSourceName, "cname_clientipaddress_rules.0.rule_type"),
+		resource.TestCheckResourceAttrPair("nios_rpz_a_rule.test", "ref", dataSourceName, "a_rules.0.ref"),
+		resource.TestCheckResourceAttrPair("nios_rpz_a_rule.test", "ipv4addr", dataSourceName, "a_rules.0.ipv4addr"),
+		resource.TestCheckResourceAttrPair("nios_rpz_aaaa_rule.test", "ref", dataSourceName, "aaaa_rules.0.ref"),
+		resource.TestCheckResourceAttrPair("nios_rpz_aaaa_rule.test", "ipv6addr", dataSourceName, "aaaa_rules.0.ipv6addr"),
+	}
+}
+
+func testAccRPZRulesDataSourceConfigRpZone(rpZone string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %[1]q
+}
+
+resource "nios_rpz_cname_rule" "test" {
+	name = "bad.example.org.%[1]s"
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "NODATA"
+}
+
+resource "nios_rpz_cname_ipaddress_rule" "test" {
+	name = "192.0.2.0/24.%[1]s"
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "PASSTHRU"
+}
+
+resource "nios_rpz_cname_clientipaddress_rule" "test" {
+	name = "198.51.100.7.%[1]s"
+	rp_zone = nios_rpz_zone.test.fqdn
+	rule_type = "DROP"
+}
+
+resource "nios_rpz_a_rule" "test" {
+	name = "bad.example.org.%[1]s"
+	rp_zone = nios_rpz_zone.test.fqdn
+	ipv4addr = "192.0.2.10"
+}
+
+resource "nios_rpz_aaaa_rule" "test" {
+	name = "bad.example.org.%[1]s"
+	rp_zone = nios_rpz_zone.test.fqdn
+	ipv6addr = "2001:db8::10"
+}
+
+data "nios_rpz_rules" "test" {
+	rp_zone = nios_rpz_zone.test.fqdn
+	depends_on = [
+		nios_rpz_cname_rule.test,
+		nios_rpz_cname_ipaddress_rule.test,
+		nios_rpz_cname_clientipaddress_rule.test,
+		nios_rpz_a_rule.test,
+		nios_rpz_aaaa_rule.test,
+	]
+}
+`, rpZone)
+}
+
+func testAccRPZRulesDataSourceConfigEmpty(rpZone string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+}
+
+data "nios_rpz_rules" "test" {
+	rp_zone = nios_rpz_zone.test.fqdn
+}
+`, rpZone)
+}
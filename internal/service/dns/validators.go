@@ -0,0 +1,334 @@
+package dns
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// domainLabel matches a label of a domain name. Internationalized names are accepted, WAPI converts them to punycode.
+const domainLabel = `[\p{L}\p{N}_]([\p{L}\p{N}_-]{0,61}[\p{L}\p{N}_])?`
+
+var (
+	domainNameRegex         = regexp.MustCompile(`^(` + domainLabel + `\.)*` + domainLabel + `$`)
+	wildcardDomainNameRegex = regexp.MustCompile(`^(\*\.)?(` + domainLabel + `\.)*` + domainLabel + `$`)
+	domainNameOrRootRegex   = regexp.MustCompile(`^(\.|(` + domainLabel + `\.)*` + domainLabel + `)$`)
+	naptrFlagsRegex         = regexp.MustCompile(`^[A-Za-z0-9]*$`)
+	macAddressRegex         = regexp.MustCompile(`^(([0-9a-f]{2}:){5}[0-9a-f]{2})?$`)
+	duidRegex               = regexp.MustCompile(`^([0-9a-f]{2}(:[0-9a-f]{2})*)?$`)
+	caaTagRegex             = regexp.MustCompile(`^[A-Za-z0-9]{1,15}$`)
+	caaIssuerRegex          = regexp.MustCompile(`^\s*((` + domainLabel + `\.)*` + domainLabel + `)?\s*(;.*)?$`)
+	tlsaNameRegex           = regexp.MustCompile(`^_[0-9]{1,5}\._[A-Za-z0-9-]+\.(` + domainLabel + `\.)*` + domainLabel + `$`)
+	hexStringRegex          = regexp.MustCompile(`^([0-9A-Fa-f]{2})+$`)
+	svcParamKeyRegex        = regexp.MustCompile(`^(alpn|no-default-alpn|port|ipv4hint|ech|ipv6hint|dohpath|ohttp|key[0-9]{1,5})$`)
+)
+
+// domainNameValidator validates a domain name in FQDN format, without the trailing dot.
+func domainNameValidator() validator.String {
+	return stringvalidator.RegexMatches(domainNameRegex, "must be a domain name in FQDN format, without the trailing dot")
+}
+
+// recordNameValidator validates the name of a record, which may be a wildcard name such as `*.example.com`.
+func recordNameValidator() validator.String {
+	return stringvalidator.RegexMatches(wildcardDomainNameRegex, "must be a domain name in FQDN format, without the trailing dot")
+}
+
+// domainNameOrRootValidator validates a domain name in FQDN format, or the root domain `.`, which the SRV and NAPTR
+// records use when there is no target.
+func domainNameOrRootValidator() validator.String {
+	return stringvalidator.RegexMatches(domainNameOrRootRegex, "must be a domain name in FQDN format, without the trailing dot, or \".\"")
+}
+
+// naptrFlagsValidator validates the flags of a NAPTR record, each flag is a single letter or digit.
+func naptrFlagsValidator() validator.String {
+	return stringvalidator.RegexMatches(naptrFlagsRegex, "must only contain letters and digits")
+}
+
+// macAddressValidator validates a MAC address in the lowercase colon-separated form WAPI returns, or an empty
+// string when there is no MAC address.
+func macAddressValidator() validator.String {
+	return stringvalidator.RegexMatches(macAddressRegex, "must be a MAC address in lowercase colon-separated form, such as 00:1a:2b:3c:4d:5e")
+}
+
+// duidValidator validates a DHCPv6 Unique Identifier in the lowercase colon-separated form WAPI returns, or an
+// empty string when there is no DUID.
+func duidValidator() validator.String {
+	return stringvalidator.RegexMatches(duidRegex, "must be a DUID in lowercase colon-separated form, such as 00:01:00:01:2a:3b:4c:5d")
+}
+
+// aclAddressValidator validates the address of an ACL rule: an IPv4 or IPv6 address, a network in CIDR notation, or
+// `Any`.
+func aclAddressValidator() validator.String {
+	return stringvalidator.Any(ipv4AddressValidator{}, ipv6AddressValidator{}, networkValidator{}, stringvalidator.OneOf("Any"))
+}
+
+// caaTagValidator validates the property tag of a CAA record: RFC 8659 allows 1 to 15 ASCII letters and digits.
+func caaTagValidator() validator.String {
+	return stringvalidator.RegexMatches(caaTagRegex, "must be 1 to 15 letters and digits")
+}
+
+// tlsaNameValidator validates the name of a TLSA record, prefixed with the port and the protocol of the TLS server
+// as RFC 6698 requires, such as `_443._tcp.www.example.com`.
+func tlsaNameValidator() validator.String {
+	return stringvalidator.RegexMatches(tlsaNameRegex, "must be a domain name in FQDN format prefixed with the port and the protocol, such as _443._tcp.www.example.com")
+}
+
+// hexStringValidator validates binary data in hexadecimal, such as the certificate data of a TLSA record.
+func hexStringValidator() validator.String {
+	return stringvalidator.RegexMatches(hexStringRegex, "must be an even number of hexadecimal digits")
+}
+
+// svcParamKeyValidator validates the key of an SVC parameter: one of the keys named by RFC 9460 and the later RFCs,
+// or keyNNNNN. The mandatory key is not a parameter of its own in WAPI, each parameter has a mandatory flag.
+func svcParamKeyValidator() validator.String {
+	return stringvalidator.RegexMatches(svcParamKeyRegex, "must be alpn, no-default-alpn, port, ipv4hint, ech, ipv6hint, dohpath, ohttp or keyNNNNN")
+}
+
+// checkCAAValue checks the value of a CAA property of the given tag, for the tags RFC 8659 defines: an issuer domain
+// name optionally followed by parameters for issue and issuewild, and a mailto, http or https URL for iodef.
+func checkCAAValue(tag, value string) error {
+	switch strings.ToLower(tag) {
+	case "issue", "issuewild":
+		if !caaIssuerRegex.MatchString(value) {
+			return errors.New("must be an issuer domain name, optionally followed by parameters separated by \";\"")
+		}
+	case "iodef":
+		if !strings.HasPrefix(value, "mailto:") && !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
+			return errors.New("must be a mailto, http or https URL")
+		}
+	}
+	return nil
+}
+
+// checkSvcParameterValues checks the values of an SVC parameter, for the keys whose format RFC 9460, RFC 9461 and
+// RFC 9540 define. The values of the other keys are not checked.
+func checkSvcParameterValues(key string, values []string) error {
+	switch key {
+	case "no-default-alpn", "ohttp":
+		if len(values) > 0 {
+			return errors.New("must be empty")
+		}
+	case "alpn":
+		if len(values) == 0 || slices.Contains(values, "") {
+			return errors.New("must be one or more protocol identifiers")
+		}
+	case "port":
+		if len(values) != 1 {
+			return errors.New("must be a single port number")
+		}
+		if _, err := strconv.ParseUint(values[0], 10, 16); err != nil {
+			return errors.New("must be a port number, from 0 to 65535")
+		}
+	case "ipv4hint", "ipv6hint":
+		family := "IPv4"
+		if key == "ipv6hint" {
+			family = "IPv6"
+		}
+		if len(values) == 0 {
+			return fmt.Errorf("must be one or more %s addresses", family)
+		}
+		for _, v := range values {
+			if addr, err := netip.ParseAddr(v); err != nil || addr.Is4() != (family == "IPv4") || addr.Zone() != "" {
+				return fmt.Errorf("must be %s addresses, got: %s", family, v)
+			}
+		}
+	case "ech":
+		if len(values) != 1 {
+			return errors.New("must be a single ECHConfigList")
+		}
+		if _, err := base64.StdEncoding.DecodeString(values[0]); err != nil {
+			return errors.New("must be an ECHConfigList in base64")
+		}
+	case "dohpath":
+		if len(values) != 1 || !strings.HasPrefix(values[0], "/") || !strings.Contains(values[0], "{?dns}") {
+			return errors.New("must be a single relative URI template with the dns variable, such as /dns-query{?dns}")
+		}
+	}
+	return nil
+}
+
+// checkRdataSubfieldValue checks a subfield value of the RDATA of an unknown record against the field type WAPI
+// encodes it with.
+func checkRdataSubfieldValue(fieldType, value string) error {
+	switch fieldType {
+	case "B", "S", "I":
+		bits := map[string]int{"B": 8, "S": 16, "I": 32}[fieldType]
+		if _, err := strconv.ParseUint(value, 10, bits); err != nil {
+			return fmt.Errorf("must be an unsigned %d-bit integer", bits)
+		}
+	case "4", "6":
+		if addr, err := netip.ParseAddr(value); err != nil || addr.Is4() != (fieldType == "4") || addr.Zone() != "" {
+			return fmt.Errorf("must be an IPv%s address", fieldType)
+		}
+	case "X":
+		if !hexStringRegex.MatchString(value) {
+			return errors.New("must be an even number of hexadecimal digits")
+		}
+	case "H":
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			return errors.New("must be base64 data")
+		}
+	case "N":
+		if !domainNameOrRootRegex.MatchString(value) {
+			return errors.New("must be a domain name")
+		}
+	}
+	return nil
+}
+
+// checkZoneFqdn checks the name of a zone against its format: a domain name for a forward zone, and
+// the network of a reverse zone in CIDR notation, such as `192.0.2.0/24`, for an IPv4 or IPv6 reverse zone. The
+// network must be in the canonical form WAPI returns it in.
+func checkZoneFqdn(fqdn, zoneFormat string) error {
+	prefix, err := netip.ParsePrefix(fqdn)
+	if zoneFormat == "FORWARD" {
+		if err == nil {
+			format := "IPV4"
+			if prefix.Addr().Is6() {
+				format = "IPV6"
+			}
+			return fmt.Errorf("is a network, the zone_format of a reverse zone is %s", format)
+		}
+		if !domainNameRegex.MatchString(fqdn) {
+			return errors.New("must be a domain name")
+		}
+		return nil
+	}
+
+	family, example := "IPv4", "192.0.2.0/24"
+	if zoneFormat == "IPV6" {
+		family, example = "IPv6", "2001:db8::/32"
+	}
+	if err != nil || prefix.Addr().Is4() != (zoneFormat == "IPV4") {
+		return fmt.Errorf("must be an %s network in CIDR notation for a reverse zone, such as %s", family, example)
+	}
+	if canonical := prefix.Masked().String(); canonical != fqdn {
+		return fmt.Errorf("must be the network in its canonical form, %s", canonical)
+	}
+	return nil
+}
+
+// checkRPZRuleName checks the name of a response policy rule: its trigger followed by the name of its response
+// policy zone. The trigger is a domain name, which may be a wildcard name, for the rules on the queried names, and an
+// IPv4 or IPv6 address or network in the canonical form WAPI returns it in for the rules on IP addresses.
+func checkRPZRuleName(name, rpZone string, ipTrigger bool) error {
+	suffix := "." + rpZone
+	if len(name) <= len(suffix) || !strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return fmt.Errorf("must end with the name of the response policy zone of the rule, %s", suffix)
+	}
+	trigger := name[:len(name)-len(suffix)]
+	if !ipTrigger {
+		if !wildcardDomainNameRegex.MatchString(trigger) {
+			return fmt.Errorf("must be a domain name followed by the name of the response policy zone, such as example.com%s", suffix)
+		}
+		return nil
+	}
+
+	if prefix, err := netip.ParsePrefix(trigger); err == nil {
+		if canonical := prefix.Masked().String(); canonical != trigger {
+			return fmt.Errorf("must have the network in its canonical form, %s%s", canonical, suffix)
+		}
+		return nil
+	}
+	if addr, err := netip.ParseAddr(trigger); err == nil && addr.Zone() == "" {
+		if canonical := addr.String(); canonical != trigger {
+			return fmt.Errorf("must have the address in its canonical form, %s%s", canonical, suffix)
+		}
+		return nil
+	}
+	return fmt.Errorf("must be an IPv4 or IPv6 address or network followed by the name of the response policy zone, such as 192.0.2.0/24%s", suffix)
+}
+
+// networkValidator validates an IPv4 or IPv6 network in CIDR notation, such as `192.0.2.0/24`.
+type networkValidator struct{}
+
+func (v networkValidator) Description(_ context.Context) string {
+	return "must be a network in CIDR notation"
+}
+
+func (v networkValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v networkValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if prefix, err := netip.ParsePrefix(req.ConfigValue.ValueString()); err != nil || prefix.Masked() != prefix {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Network",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
+
+// ipv4AddressValidator validates an IPv4 address in dotted decimal notation.
+type ipv4AddressValidator struct{}
+
+func (v ipv4AddressValidator) Description(_ context.Context) string {
+	return "must be an IPv4 address"
+}
+
+func (v ipv4AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4AddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if addr, err := netip.ParseAddr(req.ConfigValue.ValueString()); err != nil || !addr.Is4() {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv4 Address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
+
+// ipv6AddressValidator validates an IPv6 address, in any of its notations.
+type ipv6AddressValidator struct{}
+
+func (v ipv6AddressValidator) Description(_ context.Context) string {
+	return "must be an IPv6 address"
+}
+
+func (v ipv6AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv6AddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if addr, err := netip.ParseAddr(req.ConfigValue.ValueString()); err != nil || !addr.Is6() || addr.Zone() != "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IPv6 Address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
+
+// recordTypeValidator validates the number of the type of an unknown record: 1 to 65535, except the meta types and
+// query types RFC 6895 reserves, OPT (41) and 128 to 255, which are not stored in a zone.
+type recordTypeValidator struct{}
+
+func (v recordTypeValidator) Description(_ context.Context) string {
+	return "must be a record type from 1 to 65535, other than 41 and 128 to 255"
+}
+
+func (v recordTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v recordTypeValidator) ValidateInt32(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if t := req.ConfigValue.ValueInt32(); t < 1 || t > 65535 || t == 41 || (t >= 128 && t <= 255) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Record Type",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), t))
+	}
+}
@@ -0,0 +1,409 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDomainNameValidators(t *testing.T) {
+	tests := []struct {
+		value        string
+		wantDomain   bool
+		wantWildcard bool
+		wantRoot     bool
+	}{
+		{"example.com", true, true, true},
+		{"www.example.com", true, true, true},
+		{"_sip._tcp.example.com", true, true, true},
+		{"xn--bcher-kva.example", true, true, true},
+		{"bücher.example", true, true, true},
+		{"localhost", true, true, true},
+		{"*.example.com", false, true, false},
+		{".", false, false, true},
+		{"www.*.example.com", false, false, false},
+		{"example.com.", false, false, false},
+		{"-www.example.com", false, false, false},
+		{"www-.example.com", false, false, false},
+		{"www..example.com", false, false, false},
+		{"bad name.example.com", false, false, false},
+		{"", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := validates(domainNameValidator(), tt.value); got != tt.wantDomain {
+				t.Errorf("domainNameValidator(%q) = %t, want %t", tt.value, got, tt.wantDomain)
+			}
+			if got := validates(recordNameValidator(), tt.value); got != tt.wantWildcard {
+				t.Errorf("recordNameValidator(%q) = %t, want %t", tt.value, got, tt.wantWildcard)
+			}
+			if got := validates(domainNameOrRootValidator(), tt.value); got != tt.wantRoot {
+				t.Errorf("domainNameOrRootValidator(%q) = %t, want %t", tt.value, got, tt.wantRoot)
+			}
+		})
+	}
+}
+
+func validates(v validator.String, value string) bool {
+	resp := &validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("name"),
+		ConfigValue: types.StringValue(value),
+	}, resp)
+	return !resp.Diagnostics.HasError()
+}
+
+func TestIPv4AddressValidator(t *testing.T) {
+	tests := map[string]bool{
+		"192.0.2.10":       true,
+		"0.0.0.0":          true,
+		"192.0.2":          false,
+		"192.0.2.256":      false,
+		"192.000.002.010":  false,
+		"2001:db8::1":      false,
+		"::ffff:192.0.2.1": false,
+		"host.example.com": false,
+	}
+
+	for value, want := range tests {
+		t.Run(value, func(t *testing.T) {
+			if got := validates(ipv4AddressValidator{}, value); got != want {
+				t.Errorf("ipv4AddressValidator(%q) = %t, want %t", value, got, want)
+			}
+		})
+	}
+}
+
+func TestIPv6AddressValidator(t *testing.T) {
+	tests := map[string]bool{
+		"2001:db8::1":          true,
+		"2001:DB8:0:0:0:0:0:1": true,
+		"::ffff:192.0.2.1":     true,
+		"::":                   true,
+		"fe80::1%eth0":         false,
+		"2001:db8::g":          false,
+		"192.0.2.10":           false,
+		"host.example.com":     false,
+	}
+
+	for value, want := range tests {
+		t.Run(value, func(t *testing.T) {
+			if got := validates(ipv6AddressValidator{}, value); got != want {
+				t.Errorf("ipv6AddressValidator(%q) = %t, want %t", value, got, want)
+			}
+		})
+	}
+}
+
+func TestMACAddressAndDUIDValidators(t *testing.T) {
+	tests := []struct {
+		value    string
+		wantMAC  bool
+		wantDUID bool
+	}{
+		{"", true, true},
+		{"00:1a:2b:3c:4d:5e", true, true},
+		{"00:01:00:01:2a:3b:4c:5d:6e:7f", false, true},
+		{"00:1A:2B:3C:4D:5E", false, false},
+		{"00-1a-2b-3c-4d-5e", false, false},
+		{"00:1a:2b:3c:4d", false, true},
+		{"0:1a:2b:3c:4d:5e", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := validates(macAddressValidator(), tt.value); got != tt.wantMAC {
+				t.Errorf("macAddressValidator(%q) = %t, want %t", tt.value, got, tt.wantMAC)
+			}
+			if got := validates(duidValidator(), tt.value); got != tt.wantDUID {
+				t.Errorf("duidValidator(%q) = %t, want %t", tt.value, got, tt.wantDUID)
+			}
+		})
+	}
+}
+
+func TestCAAValidators(t *testing.T) {
+	tags := map[string]bool{
+		"issue":            true,
+		"issuewild":        true,
+		"iodef":            true,
+		"contactemail":     true,
+		"":                 false,
+		"issue-wild":       false,
+		"abcdefghijklmnop": false,
+	}
+	for value, want := range tags {
+		t.Run(value, func(t *testing.T) {
+			if got := validates(caaTagValidator(), value); got != want {
+				t.Errorf("caaTagValidator(%q) = %t, want %t", value, got, want)
+			}
+		})
+	}
+
+	values := []struct {
+		tag   string
+		value string
+		want  bool
+	}{
+		{"issue", "ca.example.net", true},
+		{"issue", "ca.example.net; account=230123", true},
+		{"issue", ";", true},
+		{"IssueWild", "ca.example.net", true},
+		{"issue", "https://ca.example.net", false},
+		{"issuewild", "ca example net", false},
+		{"iodef", "mailto:security@example.com", true},
+		{"iodef", "https://iodef.example.com/", true},
+		{"iodef", "security@example.com", false},
+		{"contactemail", "security@example.com", true},
+	}
+	for _, tt := range values {
+		t.Run(tt.tag+" "+tt.value, func(t *testing.T) {
+			if err := checkCAAValue(tt.tag, tt.value); (err == nil) != tt.want {
+				t.Errorf("checkCAAValue(%q, %q) = %v, want valid %t", tt.tag, tt.value, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestTLSAValidators(t *testing.T) {
+	names := map[string]bool{
+		"_443._tcp.www.example.com": true,
+		"_25._tcp.mail.example.com": true,
+		"_853._udp.example.com":     true,
+		"www.example.com":           false,
+		"_https._tcp.example.com":   false,
+		"443._tcp.example.com":      false,
+		"_443.example.com":          false,
+	}
+	for value, want := range names {
+		t.Run(value, func(t *testing.T) {
+			if got := validates(tlsaNameValidator(), value); got != want {
+				t.Errorf("tlsaNameValidator(%q) = %t, want %t", value, got, want)
+			}
+		})
+	}
+
+	data := map[string]bool{
+		"0123456789abcdef": true,
+		"0123456789ABCDEF": true,
+		"abc":              false,
+		"":                 false,
+		"0x12":             false,
+		"zz":               false,
+	}
+	for value, want := range data {
+		t.Run(value, func(t *testing.T) {
+			if got := validates(hexStringValidator(), value); got != want {
+				t.Errorf("hexStringValidator(%q) = %t, want %t", value, got, want)
+			}
+		})
+	}
+}
+
+func TestSvcParameterValidators(t *testing.T) {
+	keys := map[string]bool{
+		"alpn":            true,
+		"no-default-alpn": true,
+		"port":            true,
+		"ipv4hint":        true,
+		"ech":             true,
+		"ipv6hint":        true,
+		"dohpath":         true,
+		"ohttp":           true,
+		"key65000":        true,
+		"mandatory":       false,
+		"key":             false,
+		"ALPN":            false,
+	}
+	for value, want := range keys {
+		t.Run(value, func(t *testing.T) {
+			if got := validates(svcParamKeyValidator(), value); got != want {
+				t.Errorf("svcParamKeyValidator(%q) = %t, want %t", value, got, want)
+			}
+		})
+	}
+
+	values := []struct {
+		key    string
+		values []string
+		want   bool
+	}{
+		{"alpn", []string{"h2", "h3"}, true},
+		{"alpn", nil, false},
+		{"alpn", []string{"h2", ""}, false},
+		{"no-default-alpn", nil, true},
+		{"no-default-alpn", []string{"h2"}, false},
+		{"ohttp", nil, true},
+		{"port", []string{"8443"}, true},
+		{"port", []string{"65536"}, false},
+		{"port", []string{"443", "8443"}, false},
+		{"ipv4hint", []string{"192.0.2.1", "192.0.2.2"}, true},
+		{"ipv4hint", []string{"2001:db8::1"}, false},
+		{"ipv6hint", []string{"2001:db8::1"}, true},
+		{"ipv6hint", []string{"192.0.2.1"}, false},
+		{"ipv6hint", nil, false},
+		{"ech", []string{"AEX+DQBBpAAgACD="}, true},
+		{"ech", []string{"not base64"}, false},
+		{"dohpath", []string{"/dns-query{?dns}"}, true},
+		{"dohpath", []string{"/dns-query"}, false},
+		{"key65000", []string{"anything"}, true},
+	}
+	for _, tt := range values {
+		t.Run(fmt.Sprintf("%s %v", tt.key, tt.values), func(t *testing.T) {
+			if err := checkSvcParameterValues(tt.key, tt.values); (err == nil) != tt.want {
+				t.Errorf("checkSvcParameterValues(%q, %q) = %v, want valid %t", tt.key, tt.values, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRdataSubfieldValueValidator(t *testing.T) {
+	tests := []struct {
+		fieldType string
+		value     string
+		want      bool
+	}{
+		{"B", "255", true},
+		{"B", "256", false},
+		{"S", "65535", true},
+		{"S", "-1", false},
+		{"I", "4294967295", true},
+		{"I", "4294967296", false},
+		{"4", "192.0.2.1", true},
+		{"4", "2001:db8::1", false},
+		{"6", "2001:db8::1", true},
+		{"6", "192.0.2.1", false},
+		{"X", "0a1B", true},
+		{"X", "0a1", false},
+		{"H", "aGVsbG8=", true},
+		{"H", "not base64", false},
+		{"N", "host.example.com", true},
+		{"N", ".", true},
+		{"N", "host..example.com", false},
+		{"T", "any text", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fieldType+" "+tt.value, func(t *testing.T) {
+			if err := checkRdataSubfieldValue(tt.fieldType, tt.value); (err == nil) != tt.want {
+				t.Errorf("checkRdataSubfieldValue(%q, %q) = %v, want valid %t", tt.fieldType, tt.value, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordTypeValidator(t *testing.T) {
+	tests := map[int32]bool{
+		1:     true,
+		40:    true,
+		41:    false,
+		127:   true,
+		128:   false,
+		255:   false,
+		256:   true,
+		65280: true,
+		65535: true,
+		0:     false,
+		65536: false,
+	}
+	for value, want := range tests {
+		t.Run(fmt.Sprint(value), func(t *testing.T) {
+			resp := &validator.Int32Response{}
+			recordTypeValidator{}.ValidateInt32(context.Background(), validator.Int32Request{
+				Path:        path.Root("record_type"),
+				ConfigValue: types.Int32Value(value),
+			}, resp)
+			if got := !resp.Diagnostics.HasError(); got != want {
+				t.Errorf("recordTypeValidator(%d) = %t, want %t", value, got, want)
+			}
+		})
+	}
+}
+
+func TestACLAddressValidators(t *testing.T) {
+	tests := []struct {
+		value       string
+		wantNetwork bool
+		wantACL     bool
+	}{
+		{"192.0.2.0/24", true, true},
+		{"2001:db8::/32", true, true},
+		{"192.0.2.1/24", false, false},
+		{"192.0.2.0/33", false, false},
+		{"192.0.2.1", false, true},
+		{"2001:db8::1", false, true},
+		{"Any", false, true},
+		{"any", false, false},
+		{"host.example.com", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := validates(networkValidator{}, tt.value); got != tt.wantNetwork {
+				t.Errorf("networkValidator(%q) = %t, want %t", tt.value, got, tt.wantNetwork)
+			}
+			if got := validates(aclAddressValidator(), tt.value); got != tt.wantACL {
+				t.Errorf("aclAddressValidator(%q) = %t, want %t", tt.value, got, tt.wantACL)
+			}
+		})
+	}
+}
+
+func TestZoneFqdnValidator(t *testing.T) {
+	tests := []struct {
+		fqdn       string
+		zoneFormat string
+		want       bool
+	}{
+		{"example.com", "FORWARD", true},
+		{"host..example.com", "FORWARD", false},
+		{"192.0.2.0/24", "FORWARD", false},
+		{"192.0.2.0/24", "IPV4", true},
+		{"192.0.2.128/26", "IPV4", true},
+		{"192.0.2.1/24", "IPV4", false},
+		{"example.com", "IPV4", false},
+		{"2001:db8::/32", "IPV4", false},
+		{"2001:db8::/32", "IPV6", true},
+		{"2001:DB8::/32", "IPV6", false},
+		{"192.0.2.0/24", "IPV6", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.zoneFormat+" "+tt.fqdn, func(t *testing.T) {
+			if err := checkZoneFqdn(tt.fqdn, tt.zoneFormat); (err == nil) != tt.want {
+				t.Errorf("checkZoneFqdn(%q, %q) = %v, want valid %t", tt.fqdn, tt.zoneFormat, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRPZRuleNameValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		ipTrigger bool
+		want      bool
+	}{
+		{"bad.example.com.rpz.example.org", false, true},
+		{"*.example.com.rpz.example.org", false, true},
+		{"bad.example.com.RPZ.example.org", false, true},
+		{"rpz.example.org", false, false},
+		{"bad.example.com", false, false},
+		{"bad..example.com.rpz.example.org", false, false},
+		{"192.0.2.1.rpz.example.org", true, true},
+		{"192.0.2.0/24.rpz.example.org", true, true},
+		{"192.0.2.1/24.rpz.example.org", true, false},
+		{"2001:db8::/32.rpz.example.org", true, true},
+		{"2001:DB8::1.rpz.example.org", true, false},
+		{"bad.example.com.rpz.example.org", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkRPZRuleName(tt.name, "rpz.example.org", tt.ipTrigger); (err == nil) != tt.want {
+				t.Errorf("checkRPZRuleName(%q, %t) = %v, want valid %t", tt.name, tt.ipTrigger, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"strings"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForView = "comment,disable,dns64_enabled,dns64_groups,extattrs,forward_only,forwarders,is_default,match_clients,match_destinations,name,network_view,recursion,response_rate_limiting,use_dns64,use_forwarders,use_recursion,use_response_rate_limiting"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ViewResource{}
+var _ resource.ResourceWithImportState = &ViewResource{}
+var _ resource.ResourceWithModifyPlan = &ViewResource{}
+
+func NewViewResource() resource.Resource {
+	return &ViewResource{}
+}
+
+// ViewResource defines the resource implementation.
+type ViewResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *ViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_view"
+}
+
+func (r *ViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          ViewResourceSchemaAttributes,
+	}
+}
+
+func (r *ViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "view", readableAttributesForView) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the view
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	utils.PlanRefOnChange(ctx, req, resp, "name")
+	r.checkACLs(ctx, req, resp)
+}
+
+func (r *ViewResource) checkACLs(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config ViewModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkAddressACs(ctx, "match_clients", config.MatchClients, &resp.Diagnostics)
+	checkAddressACs(ctx, "match_destinations", config.MatchDestinations, &resp.Diagnostics)
+}
+
+func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ViewModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	view := data.Expand(ctx, &resp.Diagnostics, true)
+	view.Extattrs = utils.MergeDefaultExtAttrs(view.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ViewAPI.
+		Post(ctx).
+		View(*view).
+		ReturnFields2(readableAttributesForView).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "View", err, httpRes, ViewResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ViewModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ViewAPI.
+		ViewReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForView).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "View", err, httpRes, ViewResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ViewModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The planned reference is unknown when the view is renamed
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ref"), &data.Ref)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	view := data.Expand(ctx, &resp.Diagnostics, false)
+	view.Extattrs = utils.MergeDefaultExtAttrs(view.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ViewAPI.
+		ViewReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		View(*view).
+		ReturnFields2(readableAttributesForView).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "View", err, httpRes, ViewResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ViewModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// WAPI deletes the zones of a view with it
+	if !data.ForceDelete.ValueBool() {
+		zones, httpRes, err := viewZones(ctx, r.client, data.Name.ValueString())
+		if err != nil {
+			utils.AddWAPIError(&resp.Diagnostics, "delete", "View", err, httpRes)
+			return
+		}
+		if len(zones) > 0 {
+			resp.Diagnostics.AddError("View contains zones",
+				fmt.Sprintf("The DNS view %s contains the zones %s. Delete the zones first, or set force_delete to true "+
+					"and apply it before deleting the view to delete its zones with it.", data.Name.ValueString(), strings.Join(zones, ", ")))
+			return
+		}
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		ViewAPI.
+		ViewReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "View", err, httpRes, ViewResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the view.
+func (r *ViewResource) flatten(ctx context.Context, data *ViewModel, res *dns.View, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
+
+// viewZoneLimit is the number of zones viewZones returns at most for each zone type.
+const viewZoneLimit = 5
+
+// viewZones returns the names of some of the zones of a DNS view, none when the view has no zones.
+func viewZones(ctx context.Context, client *niosclient.APIClient, view string) ([]string, *http.Response, error) {
+	filters := map[string]interface{}{"view": view}
+	var zones []string
+
+	authRes, httpRes, err := client.DNSAPI.ZoneAuthAPI.Get(ctx).
+		Filters(filters).ReturnFields("fqdn").MaxResults(-viewZoneLimit).ReturnAsObject(1).Execute()
+	if err != nil {
+		return nil, httpRes, err
+	}
+	for _, zone := range authRes.ListZoneAuthResponseObject.GetResult() {
+		zones = append(zones, zone.Fqdn)
+	}
+
+	forwardRes, httpRes, err := client.DNSAPI.ZoneForwardAPI.Get(ctx).
+		Filters(filters).ReturnFields("fqdn").MaxResults(-viewZoneLimit).ReturnAsObject(1).Execute()
+	if err != nil {
+		return nil, httpRes, err
+	}
+	for _, zone := range forwardRes.ListZoneForwardResponseObject.GetResult() {
+		zones = append(zones, zone.Fqdn)
+	}
+
+	delegatedRes, httpRes, err := client.DNSAPI.ZoneDelegatedAPI.Get(ctx).
+		Filters(filters).ReturnFields("fqdn").MaxResults(-viewZoneLimit).ReturnAsObject(1).Execute()
+	if err != nil {
+		return nil, httpRes, err
+	}
+	for _, zone := range delegatedRes.ListZoneDelegatedResponseObject.GetResult() {
+		zones = append(zones, zone.Fqdn)
+	}
+
+	stubRes, httpRes, err := client.DNSAPI.ZoneStubAPI.Get(ctx).
+		Filters(filters).ReturnFields("fqdn").MaxResults(-viewZoneLimit).ReturnAsObject(1).Execute()
+	if err != nil {
+		return nil, httpRes, err
+	}
+	for _, zone := range stubRes.ListZoneStubResponseObject.GetResult() {
+		zones = append(zones, zone.Fqdn)
+	}
+
+	rpRes, httpRes, err := client.DNSAPI.ZoneRpAPI.Get(ctx).
+		Filters(filters).ReturnFields("fqdn").MaxResults(-viewZoneLimit).ReturnAsObject(1).Execute()
+	if err != nil {
+		return nil, httpRes, err
+	}
+	for _, zone := range rpRes.ListZoneRpResponseObject.GetResult() {
+		zones = append(zones, zone.Fqdn)
+	}
+
+	return zones, nil, nil
+}
@@ -0,0 +1,656 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForViewTest = "comment,disable,dns64_enabled,dns64_groups,extattrs,forward_only,forwarders,is_default,match_clients,match_destinations,name,network_view,recursion,response_rate_limiting,use_dns64,use_forwarders,use_recursion,use_response_rate_limiting"
+
+func TestAccViewResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_view.test"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "network_view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_forwarders", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_recursion", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_view.test"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckViewDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViewBasicConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					testAccCheckViewDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccViewResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_view.test_comment"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewComment(name, "This is a new view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new view"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccViewComment(name, "This is an updated view"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated view"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_view.test_disable"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewDisable(name, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccViewDisable(name, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_view.test_extattrs"
+	var v dns.View
+	name := acctest.RandomName()
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewExtattrs(name, map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccViewExtattrs(name, map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_Forwarders(t *testing.T) {
+	var resourceName = "nios_dns_view.test_forwarders"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewForwarders(name, `["192.0.2.53", "2001:db8::53"]`, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.0", "192.0.2.53"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.1", "2001:db8::53"),
+					resource.TestCheckResourceAttr(resourceName, "forward_only", "false"),
+					resource.TestCheckResourceAttr(resourceName, "use_forwarders", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccViewForwarders(name, `["192.0.2.54"]`, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.0", "192.0.2.54"),
+					resource.TestCheckResourceAttr(resourceName, "forward_only", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_Recursion(t *testing.T) {
+	var resourceName = "nios_dns_view.test_recursion"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewRecursion(name, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "recursion", "true"),
+					resource.TestCheckResourceAttr(resourceName, "use_recursion", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccViewRecursion(name, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "recursion", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_Dns64(t *testing.T) {
+	var resourceName = "nios_dns_view.test_dns64"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewDns64(name, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dns64_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "use_dns64", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccViewDns64(name, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dns64_enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_MatchClients(t *testing.T) {
+	var resourceName = "nios_dns_view.test_match_clients"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewMatchClients(name, "10.0.0.0/8", "DENY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "match_clients.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "match_clients.0.address", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "match_clients.0.permission", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "match_destinations.0.address", "Any"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccViewMatchClients(name, "192.0.2.0/24", "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "match_clients.0.address", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "match_clients.0.permission", "ALLOW"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_ResponseRateLimiting(t *testing.T) {
+	var resourceName = "nios_dns_view.test_response_rate_limiting"
+	var v dns.View
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccViewResponseRateLimiting(name, 50, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_response_rate_limiting", "true"),
+					resource.TestCheckResourceAttr(resourceName, "response_rate_limiting.enable_rrl_rate_limiting", "true"),
+					resource.TestCheckResourceAttr(resourceName, "response_rate_limiting.responses_per_second", "50"),
+					resource.TestCheckResourceAttr(resourceName, "response_rate_limiting.slip", "0"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccViewResponseRateLimiting(name, 200, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckViewExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "response_rate_limiting.responses_per_second", "200"),
+					resource.TestCheckResourceAttr(resourceName, "response_rate_limiting.slip", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_view.test_forwarders"
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("view"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccViewForwarders(name, `["192.0.2.53", "2001:db8::53"]`, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "network_view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "response_rate_limiting.slip", "2"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccViewForwarders(name, `["192.0.2.54"]`, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "forwarders.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "forward_only", "true"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccViewForwarders(name, `["192.0.2.54"]`, "true"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccViewImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccViewResource_ForceDelete(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("view"),
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + testAccViewForceDelete(name, "false") + testAccViewZone(name, true),
+			},
+			// A view that still contains zones is kept
+			{
+				Config:      fake.ProviderConfig() + testAccViewZone(name, false),
+				ExpectError: regexp.MustCompile("View contains zones"),
+			},
+			// Unless force_delete is set
+			{
+				Config: fake.ProviderConfig() + testAccViewForceDelete(name, "true") + testAccViewZone(name, true),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccViewZone(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					func(*terraform.State) error {
+						if views := fake.Objects("view"); len(views) != 0 {
+							return fmt.Errorf("expected the view to be deleted, got %v", views)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccViewResource_ForceDeleteRPZone(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	name := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("view"),
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + testAccViewForceDelete(name, "false") + testAccViewRPZone(name, true),
+			},
+			// A response policy zone is a zone of the view as well
+			{
+				Config:      fake.ProviderConfig() + testAccViewRPZone(name, false),
+				ExpectError: regexp.MustCompile("View contains zones"),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccViewForceDelete(name, "true") + testAccViewRPZone(name, true),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccViewRPZone(name, false),
+			},
+		},
+	})
+}
+
+func testAccViewImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckViewExists(ctx context.Context, resourceName string, v *dns.View) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ViewAPI.
+			ViewReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForViewTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckViewDestroy(ctx context.Context, v *dns.View) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			ViewAPI.
+			ViewReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForViewTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckViewDisappears(ctx context.Context, v *dns.View) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			ViewAPI.
+			ViewReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccViewBasicConfig(name string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test" {
+	name = %q
+}
+`, name)
+}
+
+func testAccViewComment(name, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_comment" {
+	name = %q
+	comment = %q
+}
+`, name, comment)
+}
+
+func testAccViewDisable(name, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_disable" {
+	name = %q
+	disable = %q
+}
+`, name, disable)
+}
+
+func testAccViewExtattrs(name string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_extattrs" {
+	name = %q
+	extattrs = %s
+}
+`, name, extattrsStr)
+}
+
+func testAccViewForwarders(name, forwarders, forwardOnly string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_forwarders" {
+	name = %q
+	forwarders = %s
+	forward_only = %q
+	use_forwarders = true
+}
+`, name, forwarders, forwardOnly)
+}
+
+func testAccViewRecursion(name, recursion string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_recursion" {
+	name = %q
+	recursion = %q
+	use_recursion = true
+}
+`, name, recursion)
+}
+
+func testAccViewDns64(name, dns64Enabled string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_dns64" {
+	name = %q
+	dns64_enabled = %q
+	use_dns64 = true
+}
+`, name, dns64Enabled)
+}
+
+func testAccViewMatchClients(name, address, permission string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_match_clients" {
+	name = %q
+	match_clients = [
+		{
+			address = %q
+			permission = %q
+		}
+	]
+	match_destinations = [
+		{
+			address = "Any"
+		}
+	]
+}
+`, name, address, permission)
+}
+
+func testAccViewResponseRateLimiting(name string, responsesPerSecond, slip int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_response_rate_limiting" {
+	name = %q
+	response_rate_limiting = {
+		enable_rrl_rate_limiting = true
+		responses_per_second = %d
+		slip = %d
+	}
+	use_response_rate_limiting = true
+}
+`, name, responsesPerSecond, slip)
+}
+
+func testAccViewForceDelete(name, forceDelete string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_view" "test_force_delete" {
+	name = %q
+	force_delete = %q
+}
+`, name, forceDelete)
+}
+
+// testAccViewZone returns an authoritative zone in the view, which depends on the view resource when withView is true.
+func testAccViewZone(name string, withView bool) string {
+	view := fmt.Sprintf("%q", name)
+	if withView {
+		view = "nios_dns_view.test_force_delete.name"
+	}
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_force_delete" {
+	fqdn = "tenant.example.com"
+	view = %s
+}
+`, view)
+}
+
+// testAccViewRPZone returns a response policy zone in the view, which depends on the view resource when withView is
+// true.
+func testAccViewRPZone(name string, withView bool) string {
+	view := fmt.Sprintf("%q", name)
+	if withView {
+		view = "nios_dns_view.test_force_delete.name"
+	}
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test_force_delete" {
+	fqdn = "rpz.example.com"
+	view = %s
+}
+`, view)
+}
@@ -0,0 +1,110 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccZoneAuthDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_auths.test"
+	resourceName := "nios_dns_zone_auth.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneAuthDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneAuthDataSourceConfigFilters(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					}, testAccCheckZoneAuthResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneAuthDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_auths.test"
+	resourceName := "nios_dns_zone_auth.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneAuthDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneAuthDataSourceConfigTagFilters(fqdn, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					}, testAccCheckZoneAuthResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckZoneAuthResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "display_domain", dataSourceName, "result.0.display_domain"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "fqdn", dataSourceName, "result.0.fqdn"),
+		resource.TestCheckResourceAttrPair(resourceName, "soa_refresh", dataSourceName, "result.0.soa_refresh"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_grid_zone_timer", dataSourceName, "result.0.use_grid_zone_timer"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+		resource.TestCheckResourceAttrPair(resourceName, "zone_format", dataSourceName, "result.0.zone_format"),
+	}
+}
+
+func testAccZoneAuthDataSourceConfigFilters(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test" {
+	fqdn = %q
+	view = %q
+}
+
+data "nios_dns_zone_auths" "test" {
+	filters = {
+		"fqdn": nios_dns_zone_auth.test.fqdn
+	}
+}
+`, fqdn, view)
+}
+
+func testAccZoneAuthDataSourceConfigTagFilters(fqdn, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test" {
+	fqdn = %q
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_zone_auths" "test" {
+	filters = {
+		"*Site" = nios_dns_zone_auth.test.extattrs.Site.value
+	}
+}
+`, fqdn, view, extAttrsValue)
+}
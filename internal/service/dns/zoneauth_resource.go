@@ -0,0 +1,239 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForZoneAuth = "allow_transfer,allow_update,comment,disable,display_domain,dns_fqdn,extattrs,fqdn,grid_primary,grid_secondaries,ns_group,prefix,primary_type,soa_default_ttl,soa_email,soa_expire,soa_negative_ttl,soa_refresh,soa_retry,soa_serial_number,use_allow_transfer,use_allow_update,use_grid_zone_timer,use_soa_email,view,zone_format"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneAuthResource{}
+var _ resource.ResourceWithImportState = &ZoneAuthResource{}
+var _ resource.ResourceWithModifyPlan = &ZoneAuthResource{}
+
+func NewZoneAuthResource() resource.Resource {
+	return &ZoneAuthResource{}
+}
+
+// ZoneAuthResource defines the resource implementation.
+type ZoneAuthResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *ZoneAuthResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_zone_auth"
+}
+
+func (r *ZoneAuthResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          ZoneAuthResourceSchemaAttributes,
+	}
+}
+
+func (r *ZoneAuthResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneAuthResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "zone_auth", readableAttributesForZoneAuth) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the zone
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	checkZoneName(ctx, req.Plan, &resp.Diagnostics)
+	r.checkACLs(ctx, req, resp)
+}
+
+// checkZoneName rejects a zone name that does not match the zone_format of the zone: the name of a forward zone is a
+// domain name and the one of a reverse zone is its network. It applies to all the zone types.
+func checkZoneName(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	var fqdn, zoneFormat types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("fqdn"), &fqdn)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("zone_format"), &zoneFormat)...)
+	if diags.HasError() || fqdn.IsUnknown() || zoneFormat.IsUnknown() {
+		return
+	}
+
+	if err := checkZoneFqdn(fqdn.ValueString(), zoneFormat.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("fqdn"), "Invalid zone name",
+			fmt.Sprintf("The name of the %s zone %s %s.", zoneFormat.ValueString(), fqdn.ValueString(), err))
+	}
+}
+
+func (r *ZoneAuthResource) checkACLs(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config ZoneAuthModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkAddressACs(ctx, "allow_transfer", config.AllowTransfer, &resp.Diagnostics)
+	checkAddressACs(ctx, "allow_update", config.AllowUpdate, &resp.Diagnostics)
+}
+
+func (r *ZoneAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneAuthModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneAuth := data.Expand(ctx, &resp.Diagnostics, true)
+	zoneAuth.Extattrs = utils.MergeDefaultExtAttrs(zoneAuth.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		Post(ctx).
+		ZoneAuth(*zoneAuth).
+		ReturnFields2(readableAttributesForZoneAuth).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "ZoneAuth", err, httpRes, ZoneAuthResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneAuthModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		ZoneAuthReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForZoneAuth).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "ZoneAuth", err, httpRes, ZoneAuthResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ZoneAuthModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneAuth := data.Expand(ctx, &resp.Diagnostics, false)
+	zoneAuth.Extattrs = utils.MergeDefaultExtAttrs(zoneAuth.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		ZoneAuthReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ZoneAuth(*zoneAuth).
+		ReturnFields2(readableAttributesForZoneAuth).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "ZoneAuth", err, httpRes, ZoneAuthResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneAuthResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneAuthModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		ZoneAuthReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "ZoneAuth", err, httpRes, ZoneAuthResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the zone.
+func (r *ZoneAuthResource) flatten(ctx context.Context, data *ZoneAuthModel, res *dns.ZoneAuth, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *ZoneAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
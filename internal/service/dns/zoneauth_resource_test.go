@@ -0,0 +1,623 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForZoneAuthTest = "allow_transfer,allow_update,comment,disable,display_domain,dns_fqdn,extattrs,fqdn,grid_primary,grid_secondaries,ns_group,prefix,primary_type,soa_default_ttl,soa_email,soa_expire,soa_negative_ttl,soa_refresh,soa_retry,soa_serial_number,use_allow_transfer,use_allow_update,use_grid_zone_timer,use_soa_email,view,zone_format"
+
+func TestAccZoneAuthResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "zone_format", "FORWARD"),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "soa_serial_number"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_zone_auth.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneAuthDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneAuthBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					testAccCheckZoneAuthDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccZoneAuthResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_comment"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneAuthComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_disable"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthDisable(fqdn, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneAuthDisable(fqdn, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_extattrs"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthExtattrs(fqdn, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneAuthExtattrs(fqdn, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_GridPrimary(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_grid_primary"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthGridPrimary(fqdn, "infoblox.localdomain", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "grid_primary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grid_primary.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr(resourceName, "grid_primary.0.stealth", "false"),
+					resource.TestCheckResourceAttr(resourceName, "primary_type", "Grid"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneAuthGridPrimary(fqdn, "infoblox.localdomain", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "grid_primary.0.stealth", "true"),
+				),
+			},
+			// Remove the grid primary
+			{
+				Config: testAccZoneAuthBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), "nios_dns_zone_auth.test", &v),
+					resource.TestCheckNoResourceAttr("nios_dns_zone_auth.test", "grid_primary.#"),
+					resource.TestCheckResourceAttr("nios_dns_zone_auth.test", "primary_type", "None"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_AllowTransfer(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_allow_transfer"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthAllowTransfer(fqdn, "192.0.2.0/24", "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_allow_transfer", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allow_transfer.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allow_transfer.0.address", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "allow_transfer.0.permission", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "allow_transfer.1.tsig_key_name", "transfer.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "allow_transfer.1.tsig_key_alg", "HMAC-SHA256"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneAuthAllowTransfer(fqdn, "198.51.100.10", "DENY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allow_transfer.0.address", "198.51.100.10"),
+					resource.TestCheckResourceAttr(resourceName, "allow_transfer.0.permission", "DENY"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_SoaTimers(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_soa_timers"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthSoaTimers(fqdn, 3600, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "use_grid_zone_timer", "true"),
+					resource.TestCheckResourceAttr(resourceName, "soa_refresh", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "soa_retry", "600"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneAuthSoaTimers(fqdn, 7200, 900),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "soa_refresh", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "soa_retry", "900"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_SoaEmail(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_soa_email"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneAuthSoaEmail(fqdn, "hostmaster@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "soa_email", "hostmaster@example.com"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneAuthSoaEmail(fqdn, "admin@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneAuthExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "soa_email", "admin@example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_zone_auth.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_auth"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneAuthComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "display_domain", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "dns_fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "primary_type", "None"),
+					resource.TestCheckResourceAttr(resourceName, "soa_refresh", "10800"),
+					resource.TestCheckResourceAttr(resourceName, "soa_serial_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneAuthComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+					resource.TestCheckResourceAttr(resourceName, "soa_serial_number", "2"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccZoneAuthComment(fqdn, "default", "This is an updated zone"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccZoneAuthImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_ReverseZone(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_auth"),
+		Steps: []resource.TestStep{
+			// The reverse zones are created from their network
+			{
+				Config: fake.ProviderConfig() + testAccZoneAuthReverse("ipv4", "192.0.2.0/24", "IPV4") +
+					testAccZoneAuthReverse("classless", "198.51.100.128/26", "IPV4") +
+					testAccZoneAuthReverse("ipv6", "2001:db8::/32", "IPV6"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nios_dns_zone_auth.ipv4", "display_domain", "2.0.192.in-addr.arpa"),
+					resource.TestCheckResourceAttr("nios_dns_zone_auth.classless", "prefix", "128/26"),
+					resource.TestCheckResourceAttr("nios_dns_zone_auth.classless", "display_domain", "128/26.100.51.198.in-addr.arpa"),
+					resource.TestCheckResourceAttr("nios_dns_zone_auth.ipv6", "display_domain", "8.b.d.0.1.0.0.2.ip6.arpa"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneAuthResource_InvalidFqdn(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A network is the name of a reverse zone
+			{
+				Config:      fake.ProviderConfig() + testAccZoneAuthReverse("test", "192.0.2.0/24", "FORWARD"),
+				ExpectError: regexp.MustCompile("Invalid zone name"),
+			},
+			// The network of a reverse zone is in its canonical form
+			{
+				Config:      fake.ProviderConfig() + testAccZoneAuthReverse("test", "192.0.2.1/24", "IPV4"),
+				ExpectError: regexp.MustCompile("Invalid zone name"),
+			},
+			// The network of a reverse zone matches its format
+			{
+				Config:      fake.ProviderConfig() + testAccZoneAuthReverse("test", "2001:db8::/32", "IPV4"),
+				ExpectError: regexp.MustCompile("Invalid zone name"),
+			},
+		},
+	})
+}
+
+func TestAccZoneAuthResource_InvalidACL(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A rule has either an address or a TSIG key name
+			{
+				Config:      fake.ProviderConfig() + testAccZoneAuthAllowUpdate("example.com", `address = "192.0.2.1", tsig_key_name = "update.example.com"`),
+				ExpectError: regexp.MustCompile("Invalid ACL rule"),
+			},
+			// A TSIG rule has no permission
+			{
+				Config:      fake.ProviderConfig() + testAccZoneAuthAllowUpdate("example.com", `tsig_key_name = "update.example.com", permission = "DENY"`),
+				ExpectError: regexp.MustCompile("Invalid ACL rule"),
+			},
+		},
+	})
+}
+
+func testAccZoneAuthImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckZoneAuthExists(ctx context.Context, resourceName string, v *dns.ZoneAuth) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneAuthAPI.
+			ZoneAuthReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForZoneAuthTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckZoneAuthDestroy(ctx context.Context, v *dns.ZoneAuth) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			ZoneAuthAPI.
+			ZoneAuthReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForZoneAuthTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckZoneAuthDisappears(ctx context.Context, v *dns.ZoneAuth) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			ZoneAuthAPI.
+			ZoneAuthReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccZoneAuthBasicConfig(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test" {
+	fqdn = %q
+	view = %q
+}
+`, fqdn, view)
+}
+
+func testAccZoneAuthComment(fqdn, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_comment" {
+	fqdn = %q
+	view = %q
+	comment = %q
+}
+`, fqdn, view, comment)
+}
+
+func testAccZoneAuthDisable(fqdn, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_disable" {
+	fqdn = %q
+	view = %q
+	disable = %q
+}
+`, fqdn, view, disable)
+}
+
+func testAccZoneAuthExtattrs(fqdn, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_extattrs" {
+	fqdn = %q
+	view = %q
+	extattrs = %s
+}
+`, fqdn, view, extattrsStr)
+}
+
+func testAccZoneAuthGridPrimary(fqdn, member, stealth string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_grid_primary" {
+	fqdn = %q
+	grid_primary = [
+		{
+			name = %q
+			stealth = %q
+		}
+	]
+	restart_if_needed = true
+}
+`, fqdn, member, stealth)
+}
+
+func testAccZoneAuthAllowTransfer(fqdn, address, permission string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_allow_transfer" {
+	fqdn = %q
+	use_allow_transfer = true
+	allow_transfer = [
+		{
+			address = %q
+			permission = %q
+		},
+		{
+			tsig_key_name = "transfer.example.com"
+			tsig_key = "c2VjcmV0LWtleS1mb3ItdHJhbnNmZXJz"
+			tsig_key_alg = "HMAC-SHA256"
+		}
+	]
+}
+`, fqdn, address, permission)
+}
+
+func testAccZoneAuthAllowUpdate(fqdn, rule string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_allow_update" {
+	fqdn = %q
+	use_allow_update = true
+	allow_update = [
+		{ %s }
+	]
+}
+`, fqdn, rule)
+}
+
+func testAccZoneAuthSoaTimers(fqdn string, refresh, retry int32) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_soa_timers" {
+	fqdn = %q
+	use_grid_zone_timer = true
+	soa_refresh = %d
+	soa_retry = %d
+	soa_expire = 2419200
+	soa_negative_ttl = 900
+	soa_default_ttl = 28800
+}
+`, fqdn, refresh, retry)
+}
+
+func testAccZoneAuthSoaEmail(fqdn, email string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" "test_soa_email" {
+	fqdn = %q
+	use_soa_email = true
+	soa_email = %q
+}
+`, fqdn, email)
+}
+
+func testAccZoneAuthReverse(name, network, zoneFormat string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_auth" %q {
+	fqdn = %q
+	zone_format = %q
+}
+`, name, network, zoneFormat)
+}
@@ -0,0 +1,123 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccZoneDelegatedDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_delegateds.test"
+	resourceName := "nios_dns_zone_delegated.test"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDelegatedDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneDelegatedDataSourceConfigFilters(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					}, testAccCheckZoneDelegatedResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneDelegatedDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_delegateds.test"
+	resourceName := "nios_dns_zone_delegated.test"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDelegatedDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneDelegatedDataSourceConfigTagFilters(fqdn, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					}, testAccCheckZoneDelegatedResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckZoneDelegatedResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "delegate_to", dataSourceName, "result.0.delegate_to"),
+		resource.TestCheckResourceAttrPair(resourceName, "delegated_ttl", dataSourceName, "result.0.delegated_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "display_domain", dataSourceName, "result.0.display_domain"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "fqdn", dataSourceName, "result.0.fqdn"),
+		resource.TestCheckResourceAttrPair(resourceName, "use_delegated_ttl", dataSourceName, "result.0.use_delegated_ttl"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+		resource.TestCheckResourceAttrPair(resourceName, "zone_format", dataSourceName, "result.0.zone_format"),
+	}
+}
+
+func testAccZoneDelegatedDataSourceConfigFilters(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test" {
+	fqdn = %q
+	view = %q
+	delegate_to = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+}
+
+data "nios_dns_zone_delegateds" "test" {
+	filters = {
+		"fqdn": nios_dns_zone_delegated.test.fqdn
+	}
+}
+`, fqdn, view)
+}
+
+func testAccZoneDelegatedDataSourceConfigTagFilters(fqdn, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test" {
+	fqdn = %q
+	view = %q
+	delegate_to = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_zone_delegateds" "test" {
+	filters = {
+		"*Site" = nios_dns_zone_delegated.test.extattrs.Site.value
+	}
+}
+`, fqdn, view, extAttrsValue)
+}
@@ -0,0 +1,483 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForZoneDelegatedTest = "comment,delegate_to,delegated_ttl,disable,display_domain,dns_fqdn,extattrs,fqdn,ns_group,use_delegated_ttl,view,zone_format"
+
+func TestAccZoneDelegatedResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_zone_delegated.test"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDelegatedBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "zone_format", "FORWARD"),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "delegate_to.0.address", "192.0.2.10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDelegatedResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_zone_delegated.test"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDelegatedDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneDelegatedBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					testAccCheckZoneDelegatedDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccZoneDelegatedResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_zone_delegated.test_comment"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDelegatedComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneDelegatedComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDelegatedResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_zone_delegated.test_disable"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDelegatedDisable(fqdn, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneDelegatedDisable(fqdn, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDelegatedResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_zone_delegated.test_extattrs"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDelegatedExtattrs(fqdn, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneDelegatedExtattrs(fqdn, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDelegatedResource_DelegateTo(t *testing.T) {
+	var resourceName = "nios_dns_zone_delegated.test_delegate_to"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDelegatedDelegateTo(fqdn, "192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delegate_to.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delegate_to.0.address", "192.0.2.10"),
+					resource.TestCheckResourceAttr(resourceName, "delegate_to.0.name", "ns1.example.net"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneDelegatedDelegateTo(fqdn, "192.0.2.11"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delegate_to.0.address", "192.0.2.11"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDelegatedResource_DelegatedTtl(t *testing.T) {
+	var resourceName = "nios_dns_zone_delegated.test_delegated_ttl"
+	var v dns.ZoneDelegated
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDelegatedDelegatedTtl(fqdn, 3600, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delegated_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "use_delegated_ttl", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneDelegatedDelegatedTtl(fqdn, 7200, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDelegatedExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "delegated_ttl", "7200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDelegatedResource_NameServers(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The zone is delegated to delegate_to or to the name servers of ns_group, not both
+			{
+				Config:      fake.ProviderConfig() + testAccZoneDelegatedNsGroup("sub.example.com", true),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccZoneDelegatedNsGroup("sub.example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nios_dns_zone_delegated.test_ns_group", "ns_group", "delegation"),
+					resource.TestCheckNoResourceAttr("nios_dns_zone_delegated.test_ns_group", "delegate_to.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneDelegatedResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_zone_delegated.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_delegated"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneDelegatedComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "display_domain", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "dns_fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "use_delegated_ttl", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneDelegatedComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccZoneDelegatedComment(fqdn, "default", "This is an updated zone"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccZoneDelegatedImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneDelegatedImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckZoneDelegatedExists(ctx context.Context, resourceName string, v *dns.ZoneDelegated) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneDelegatedAPI.
+			ZoneDelegatedReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForZoneDelegatedTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckZoneDelegatedDestroy(ctx context.Context, v *dns.ZoneDelegated) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			ZoneDelegatedAPI.
+			ZoneDelegatedReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForZoneDelegatedTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckZoneDelegatedDisappears(ctx context.Context, v *dns.ZoneDelegated) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			ZoneDelegatedAPI.
+			ZoneDelegatedReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccZoneDelegatedBasicConfig(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test" {
+	fqdn = %q
+	view = %q
+	delegate_to = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+}
+`, fqdn, view)
+}
+
+func testAccZoneDelegatedComment(fqdn, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test_comment" {
+	fqdn = %q
+	view = %q
+	delegate_to = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	comment = %q
+}
+`, fqdn, view, comment)
+}
+
+func testAccZoneDelegatedDisable(fqdn, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test_disable" {
+	fqdn = %q
+	view = %q
+	delegate_to = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	disable = %q
+}
+`, fqdn, view, disable)
+}
+
+func testAccZoneDelegatedExtattrs(fqdn, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test_extattrs" {
+	fqdn = %q
+	view = %q
+	delegate_to = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	extattrs = %s
+}
+`, fqdn, view, extattrsStr)
+}
+
+func testAccZoneDelegatedDelegateTo(fqdn, address string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test_delegate_to" {
+	fqdn = %q
+	delegate_to = [
+		{
+			address = %q
+			name = "ns1.example.net"
+		}
+	]
+}
+`, fqdn, address)
+}
+
+func testAccZoneDelegatedDelegatedTtl(fqdn string, delegatedTtl int32, useDelegatedTtl string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test_delegated_ttl" {
+	fqdn = %q
+	delegate_to = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	delegated_ttl = %d
+	use_delegated_ttl = %q
+}
+`, fqdn, delegatedTtl, useDelegatedTtl)
+}
+
+func testAccZoneDelegatedNsGroup(fqdn string, withDelegateTo bool) string {
+	delegateTo := ""
+	if withDelegateTo {
+		delegateTo = `delegate_to = [{ address = "192.0.2.10", name = "ns1.example.net" }]`
+	}
+	return fmt.Sprintf(`
+resource "nios_dns_zone_delegated" "test_ns_group" {
+	fqdn = %q
+	ns_group = "delegation"
+	%s
+}
+`, fqdn, delegateTo)
+}
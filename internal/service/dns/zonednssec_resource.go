@@ -0,0 +1,240 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForZoneDnssec = "dnssec_key_params,dnssec_ksk_rollover_date,dnssec_zsk_rollover_date,fqdn,is_dnssec_enabled,is_dnssec_signed,use_dnssec_key_params,view"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneDnssecResource{}
+var _ resource.ResourceWithImportState = &ZoneDnssecResource{}
+var _ resource.ResourceWithModifyPlan = &ZoneDnssecResource{}
+
+func NewZoneDnssecResource() resource.Resource {
+	return &ZoneDnssecResource{}
+}
+
+// ZoneDnssecResource defines the resource implementation. It manages the DNSSEC key parameters of an existing
+// authoritative zone, which override the ones of the grid while the resource exists.
+type ZoneDnssecResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *ZoneDnssecResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_zone_dnssec"
+}
+
+func (r *ZoneDnssecResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          ZoneDnssecResourceSchemaAttributes,
+	}
+}
+
+func (r *ZoneDnssecResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneDnssecResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "zone_auth", readableAttributesForZoneDnssec) {
+		return
+	}
+
+	r.checkSaltLengths(ctx, req, resp)
+}
+
+// checkSaltLengths checks that the minimum length of the NSEC3 salts is not greater than their maximum length.
+func (r *ZoneDnssecResource) checkSaltLengths(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var params ZoneAuthDnssecKeyParamsModel
+	var config ZoneDnssecModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.DnssecKeyParams.IsNull() || config.DnssecKeyParams.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(config.DnssecKeyParams.As(ctx, &params, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minLength, maxLength := params.Nsec3SaltMinLength, params.Nsec3SaltMaxLength
+	if minLength.IsNull() || minLength.IsUnknown() || maxLength.IsNull() || maxLength.IsUnknown() {
+		return
+	}
+	if minLength.ValueInt32() > maxLength.ValueInt32() {
+		resp.Diagnostics.AddAttributeError(path.Root("dnssec_key_params").AtName("nsec3_salt_min_length"), "Invalid NSEC3 salt lengths",
+			fmt.Sprintf("The minimum length of the NSEC3 salts, %d, is greater than their maximum length, %d.", minLength.ValueInt32(), maxLength.ValueInt32()))
+	}
+}
+
+func (r *ZoneDnssecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneDnssecModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The DNSSEC settings are set on the existing zone
+	zone := lookupZoneAuth(ctx, r.client, data.Fqdn.ValueString(), data.View.ValueString(), readableAttributesForZoneDnssec, &resp.Diagnostics)
+	if zone == nil {
+		return
+	}
+	zoneAuth := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		ZoneAuthReferencePut(ctx, utils.ExtractResourceRef(zone.GetRef())).
+		ZoneAuth(*zoneAuth).
+		ReturnFields2(readableAttributesForZoneDnssec).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "ZoneDnssec", err, httpRes, ZoneDnssecResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneDnssecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneDnssecModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		ZoneAuthReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForZoneDnssec).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "ZoneDnssec", err, httpRes, ZoneDnssecResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	// The zone uses the DNSSEC settings of the grid again, they are not managed anymore
+	if !res.GetUseDnssecKeyParams() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneDnssecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ZoneDnssecModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneAuth := data.Expand(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		ZoneAuthReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ZoneAuth(*zoneAuth).
+		ReturnFields2(readableAttributesForZoneDnssec).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "ZoneDnssec", err, httpRes, ZoneDnssecResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	data.Flatten(ctx, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete reverts the zone to the DNSSEC settings of the grid, the zone itself is kept.
+func (r *ZoneDnssecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneDnssecModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneAuth := dns.ZoneAuth{
+		Fqdn:               data.Fqdn.ValueString(),
+		UseDnssecKeyParams: utils.Ptr(false),
+	}
+	_, httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		ZoneAuthReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ZoneAuth(zoneAuth).
+		ReturnFields2(readableAttributesForZoneDnssec).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "ZoneDnssec", err, httpRes, ZoneDnssecResourceSchemaAttributes)
+		return
+	}
+}
+
+func (r *ZoneDnssecResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
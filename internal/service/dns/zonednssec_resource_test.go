@@ -0,0 +1,376 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForZoneDnssecTest = "dnssec_key_params,dnssec_ksk_rollover_date,dnssec_zsk_rollover_date,fqdn,is_dnssec_enabled,is_dnssec_signed,use_dnssec_key_params,view"
+
+func TestAccZoneDnssecResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_zone_dnssec.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDnssecNextSecureType(fqdn, "NSEC3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDnssecExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.next_secure_type", "NSEC3"),
+					resource.TestCheckResourceAttr(resourceName, "is_dnssec_signed", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDnssecResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_zone_dnssec.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDnssecDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneDnssecNextSecureType(fqdn, "NSEC3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDnssecExists(context.Background(), resourceName, &v),
+					testAccCheckZoneDnssecDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccZoneDnssecResource_NextSecureType(t *testing.T) {
+	var resourceName = "nios_dns_zone_dnssec.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDnssecNextSecureType(fqdn, "NSEC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDnssecExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.next_secure_type", "NSEC"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneDnssecNextSecureType(fqdn, "NSEC3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDnssecExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.next_secure_type", "NSEC3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDnssecResource_KskAlgorithms(t *testing.T) {
+	var resourceName = "nios_dns_zone_dnssec.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDnssecKskAlgorithm(fqdn, "RSASHA256", 2048),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDnssecExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.ksk_algorithms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.ksk_algorithms.0.algorithm", "RSASHA256"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.ksk_algorithms.0.size", "2048"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneDnssecKskAlgorithm(fqdn, "ECDSAP256SHA256", 256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDnssecExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.ksk_algorithms.0.algorithm", "ECDSAP256SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.ksk_algorithms.0.size", "256"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDnssecResource_Rollover(t *testing.T) {
+	var resourceName = "nios_dns_zone_dnssec.test"
+	var v dns.ZoneAuth
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneDnssecRollover(fqdn, 31536000, 2592000, "PRE_PUBLISH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDnssecExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.ksk_rollover", "31536000"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.zsk_rollover", "2592000"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.zsk_rollover_mechanism", "PRE_PUBLISH"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneDnssecRollover(fqdn, 15768000, 604800, "DOUBLE_SIGN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneDnssecExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.ksk_rollover", "15768000"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.zsk_rollover", "604800"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.zsk_rollover_mechanism", "DOUBLE_SIGN"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDnssecResource_Validation(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_auth"),
+		Steps: []resource.TestStep{
+			// The DNSSEC settings apply to an existing zone
+			{
+				Config: fake.ProviderConfig() + fmt.Sprintf(`
+resource "nios_dns_zone_dnssec" "test" {
+	fqdn = %q
+	dnssec_key_params = {
+		next_secure_type = "NSEC"
+	}
+}
+`, fqdn),
+				ExpectError: regexp.MustCompile("Zone not found"),
+			},
+			{
+				Config:      fake.ProviderConfig() + testAccZoneDnssecNsec3Salt(fqdn, 10, 5),
+				ExpectError: regexp.MustCompile("Invalid NSEC3 salt lengths"),
+			},
+		},
+	})
+}
+
+func TestAccZoneDnssecResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_zone_dnssec.test"
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_auth"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneDnssecKskAlgorithm(fqdn, "RSASHA512", 2048),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "ref", "nios_dns_zone_auth.test", "ref"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.ksk_algorithms.0.algorithm", "RSASHA512"),
+					// The parameters that are not set are the ones of the grid
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.next_secure_type", "NSEC3"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.zsk_algorithms.0.algorithm", "RSASHA256"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.signature_expiration", "345600"),
+					resource.TestCheckResourceAttr(resourceName, "is_dnssec_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_dnssec_signed", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneDnssecNsec3Salt(fqdn, 4, 8),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.nsec3_salt_min_length", "4"),
+					resource.TestCheckResourceAttr(resourceName, "dnssec_key_params.nsec3_salt_max_length", "8"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccZoneDnssecNsec3Salt(fqdn, 4, 8),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccZoneDnssecImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// The zone uses the parameters of the grid again once the resource is removed
+			{
+				Config: fake.ProviderConfig() + testAccZoneAuthBasicConfig(fqdn, "default"),
+				Check: func(*terraform.State) error {
+					for _, zone := range fake.Objects("zone_auth") {
+						if zone["use_dnssec_key_params"] != false {
+							return fmt.Errorf("expected the zone %v to use the DNSSEC key parameters of the grid", zone["fqdn"])
+						}
+					}
+					return nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneDnssecImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckZoneDnssecExists(ctx context.Context, resourceName string, v *dns.ZoneAuth) resource.TestCheckFunc {
+	// Verify the zone overrides the DNSSEC key parameters of the grid
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneAuthAPI.
+			ZoneAuthReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForZoneDnssecTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		if !v.GetUseDnssecKeyParams() {
+			return fmt.Errorf("expected the zone %s to override the DNSSEC key parameters of the grid", v.Fqdn)
+		}
+		return nil
+	}
+}
+
+func testAccCheckZoneDnssecDestroy(ctx context.Context, v *dns.ZoneAuth) resource.TestCheckFunc {
+	// Verify the zone was deleted, or uses the DNSSEC key parameters of the grid again
+	return func(state *terraform.State) error {
+		apiRes, httpRes, err := acctest.NIOSClient.DNSAPI.
+			ZoneAuthAPI.
+			ZoneAuthReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForZoneDnssecTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// zone was deleted
+				return nil
+			}
+			return err
+		}
+		if res := apiRes.GetResult(); res.GetUseDnssecKeyParams() {
+			return errors.New("expected the DNSSEC key parameters of the zone to be removed")
+		}
+		return nil
+	}
+}
+
+func testAccCheckZoneDnssecDisappears(ctx context.Context, v *dns.ZoneAuth) resource.TestCheckFunc {
+	// Revert the zone to the DNSSEC key parameters of the grid externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneAuthAPI.
+			ZoneAuthReferencePut(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ZoneAuth(dns.ZoneAuth{Fqdn: v.Fqdn, UseDnssecKeyParams: utils.Ptr(false)}).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccZoneDnssecNextSecureType(fqdn, nextSecureType string) string {
+	return testAccZoneAuthBasicConfig(fqdn, "default") + fmt.Sprintf(`
+resource "nios_dns_zone_dnssec" "test" {
+	fqdn = nios_dns_zone_auth.test.fqdn
+	dnssec_key_params = {
+		next_secure_type = %q
+	}
+}
+`, nextSecureType)
+}
+
+func testAccZoneDnssecKskAlgorithm(fqdn, algorithm string, size int) string {
+	return testAccZoneAuthBasicConfig(fqdn, "default") + fmt.Sprintf(`
+resource "nios_dns_zone_dnssec" "test" {
+	fqdn = nios_dns_zone_auth.test.fqdn
+	dnssec_key_params = {
+		ksk_algorithms = [
+			{
+				algorithm = %q
+				size = %d
+			}
+		]
+	}
+}
+`, algorithm, size)
+}
+
+func testAccZoneDnssecRollover(fqdn string, kskRollover, zskRollover int, zskRolloverMechanism string) string {
+	return testAccZoneAuthBasicConfig(fqdn, "default") + fmt.Sprintf(`
+resource "nios_dns_zone_dnssec" "test" {
+	fqdn = nios_dns_zone_auth.test.fqdn
+	dnssec_key_params = {
+		ksk_rollover = %d
+		zsk_rollover = %d
+		zsk_rollover_mechanism = %q
+	}
+}
+`, kskRollover, zskRollover, zskRolloverMechanism)
+}
+
+func testAccZoneDnssecNsec3Salt(fqdn string, minLength, maxLength int) string {
+	return testAccZoneAuthBasicConfig(fqdn, "default") + fmt.Sprintf(`
+resource "nios_dns_zone_dnssec" "test" {
+	fqdn = nios_dns_zone_auth.test.fqdn
+	dnssec_key_params = {
+		next_secure_type = "NSEC3"
+		nsec3_salt_min_length = %d
+		nsec3_salt_max_length = %d
+	}
+}
+`, minLength, maxLength)
+}
@@ -0,0 +1,203 @@
+package dns
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneDnssecDsRecordsDataSource{}
+
+func NewZoneDnssecDsRecordsDataSource() datasource.DataSource {
+	return &ZoneDnssecDsRecordsDataSource{}
+}
+
+// ZoneDnssecDsRecordsDataSource defines the data source implementation. It returns the DS records of the key-signing
+// keys of a signed zone, to be published in its parent zone.
+type ZoneDnssecDsRecordsDataSource struct {
+	client *niosclient.APIClient
+}
+
+func (d *ZoneDnssecDsRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_zone_dnssec_ds_records"
+}
+
+type ZoneDnssecDsRecordsModel struct {
+	Fqdn      types.String `tfsdk:"fqdn"`
+	View      types.String `tfsdk:"view"`
+	DsRecords types.List   `tfsdk:"ds_records"`
+}
+
+type DsRecordModel struct {
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+	Status     types.String `tfsdk:"status"`
+	Record     types.String `tfsdk:"record"`
+}
+
+var DsRecordAttrTypes = map[string]attr.Type{
+	"key_tag":     types.Int64Type,
+	"algorithm":   types.Int64Type,
+	"digest_type": types.Int64Type,
+	"digest":      types.StringType,
+	"status":      types.StringType,
+	"record":      types.StringType,
+}
+
+func (d *ZoneDnssecDsRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes: map[string]schema.Attribute{
+			"fqdn": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the authoritative zone, in FQDN format for a forward zone or in CIDR notation for a reverse zone.",
+			},
+			"view": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The DNS view of the zone. Defaults to `default`.",
+			},
+			"ds_records": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_tag": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The tag of the key-signing key.",
+						},
+						"algorithm": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of the algorithm of the key-signing key, e.g. 8 for RSASHA256.",
+						},
+						"digest_type": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the digest, always 2 for SHA-256.",
+						},
+						"digest": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The digest of the DNSKEY record of the key-signing key, in hexadecimal.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the key-signing key, e.g. `ACTIVE` or `PUBLISHED`.",
+						},
+						"record": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The DS record in presentation format, as published in the parent zone.",
+						},
+					},
+				},
+				Computed:            true,
+				MarkdownDescription: "The DS records of the key-signing keys of the zone. It is null when the zone is not signed.",
+			},
+		},
+	}
+}
+
+func (d *ZoneDnssecDsRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ZoneDnssecDsRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneDnssecDsRecordsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.View.IsNull() {
+		data.View = types.StringValue("default")
+	}
+
+	// The owner of the DS records is the DNS name of the zone, which differs from its name for a reverse zone
+	zone := lookupZoneAuth(ctx, d.client, data.Fqdn.ValueString(), data.View.ValueString(), "dns_fqdn,fqdn,view", &resp.Diagnostics)
+	if zone == nil {
+		return
+	}
+	keys := dnssecZoneKeys(ctx, d.client, zone.GetRef(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var records []DsRecordModel
+	for _, key := range keys {
+		if key.GetType() != "KSK" {
+			continue
+		}
+		records = append(records, flattenDsRecord(zone.GetDnsFqdn(), key, &resp.Diagnostics))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(records) == 0 {
+		data.DsRecords = types.ListNull(types.ObjectType{AttrTypes: DsRecordAttrTypes})
+	} else {
+		var diags diag.Diagnostics
+		data.DsRecords, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: DsRecordAttrTypes}, records)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenDsRecord returns the DS record of the key-signing key of the zone named owner.
+func flattenDsRecord(owner string, key dns.ZoneAuthDnssecKeys, diags *diag.Diagnostics) DsRecordModel {
+	algorithm, err := strconv.ParseUint(key.GetAlgorithm(), 10, 8)
+	if err != nil {
+		diags.AddError("Invalid DNSSEC key", fmt.Sprintf("The algorithm of the key %d of %s is not an algorithm number: %q.", key.GetTag(), owner, key.GetAlgorithm()))
+		return DsRecordModel{}
+	}
+	publicKey, err := base64.StdEncoding.DecodeString(key.GetPublicKey())
+	if err != nil {
+		diags.AddError("Invalid DNSSEC key", fmt.Sprintf("The public key of the key %d of %s is not in Base-64: %s", key.GetTag(), owner, err))
+		return DsRecordModel{}
+	}
+	digest, err := dsRecordDigest(owner, dnssecKeyFlags, uint8(algorithm), publicKey)
+	if err != nil {
+		diags.AddError("Invalid DNSSEC key", fmt.Sprintf("Unable to compute the DS record of the key %d of %s: %s", key.GetTag(), owner, err))
+		return DsRecordModel{}
+	}
+
+	return DsRecordModel{
+		KeyTag:     types.Int64Value(int64(key.GetTag())),
+		Algorithm:  types.Int64Value(int64(algorithm)),
+		DigestType: types.Int64Value(dsDigestTypeSHA256),
+		Digest:     types.StringValue(digest),
+		Status:     types.StringPointerValue(key.Status),
+		Record: types.StringValue(fmt.Sprintf("%s. IN DS %d %d %d %s",
+			strings.TrimSuffix(owner, "."), key.GetTag(), algorithm, dsDigestTypeSHA256, digest)),
+	}
+}
@@ -0,0 +1,118 @@
+package dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccZoneDnssecDsRecordsDataSource_Fqdn(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_dnssec_ds_records.test"
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneDnssecDsRecordsDataSourceConfigFqdn(fqdn),
+				Check:  resource.ComposeTestCheckFunc(testAccCheckZoneDnssecDsRecords(dataSourceName, fqdn, "13")...),
+			},
+		},
+	})
+}
+
+func TestAccZoneDnssecDsRecordsDataSource_FakeWAPI(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_dnssec_ds_records.test"
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_auth"),
+		Steps: []resource.TestStep{
+			// The zone is not signed yet
+			{
+				Config: fake.ProviderConfig() + testAccZoneAuthBasicConfig(fqdn, "default") + `
+data "nios_dns_zone_dnssec_ds_records" "test" {
+	fqdn = nios_dns_zone_auth.test.fqdn
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "view", "default"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "ds_records.#"),
+				),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccZoneDnssecDsRecordsDataSourceConfigFqdn(fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						resource.TestCheckResourceAttr(dataSourceName, "ds_records.0.status", "ACTIVE"),
+					}, testAccCheckZoneDnssecDsRecords(dataSourceName, fqdn, "13")...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneDnssecDsRecordsDataSource_MissingZone(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fake.ProviderConfig() + `
+data "nios_dns_zone_dnssec_ds_records" "test" {
+	fqdn = "missing.example.com"
+}
+`,
+				ExpectError: regexp.MustCompile("Zone not found"),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckZoneDnssecDsRecords(dataSourceName, fqdn, algorithm string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttr(dataSourceName, "ds_records.#", "1"),
+		resource.TestCheckResourceAttrSet(dataSourceName, "ds_records.0.key_tag"),
+		resource.TestCheckResourceAttr(dataSourceName, "ds_records.0.algorithm", algorithm),
+		resource.TestCheckResourceAttr(dataSourceName, "ds_records.0.digest_type", "2"),
+		resource.TestMatchResourceAttr(dataSourceName, "ds_records.0.digest", regexp.MustCompile("^[0-9A-F]{64}$")),
+		resource.TestMatchResourceAttr(dataSourceName, "ds_records.0.record",
+			regexp.MustCompile(fmt.Sprintf(`^%s\. IN DS [0-9]+ %s 2 [0-9A-F]{64}$`, regexp.QuoteMeta(fqdn), algorithm))),
+	}
+}
+
+func testAccZoneDnssecDsRecordsDataSourceConfigFqdn(fqdn string) string {
+	// The zone is signed by its grid primary
+	return testAccZoneAuthGridPrimary(fqdn, "infoblox.localdomain", "false") + `
+resource "nios_dns_zone_dnssec" "test" {
+	fqdn = nios_dns_zone_auth.test_grid_primary.fqdn
+	dnssec_key_params = {
+		ksk_algorithms = [
+			{
+				algorithm = "ECDSAP256SHA256"
+				size = 256
+			}
+		]
+	}
+}
+
+resource "nios_dns_zone_dnssec_operation" "test" {
+	fqdn = nios_dns_zone_dnssec.test.fqdn
+	operation = "SIGN"
+}
+
+data "nios_dns_zone_dnssec_ds_records" "test" {
+	fqdn = nios_dns_zone_dnssec_operation.test.fqdn
+}
+`
+}
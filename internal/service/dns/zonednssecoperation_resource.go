@@ -0,0 +1,121 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneDnssecOperationResource{}
+
+func NewZoneDnssecOperationResource() resource.Resource {
+	return &ZoneDnssecOperationResource{}
+}
+
+// ZoneDnssecOperationResource defines the resource implementation. It runs a DNSSEC operation on an authoritative
+// zone when it is created; destroying it does not revert the operation.
+type ZoneDnssecOperationResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *ZoneDnssecOperationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_zone_dnssec_operation"
+}
+
+func (r *ZoneDnssecOperationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          ZoneDnssecOperationResourceSchemaAttributes,
+	}
+}
+
+func (r *ZoneDnssecOperationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneDnssecOperationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneDnssecOperationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := lookupZoneAuth(ctx, r.client, data.Fqdn.ValueString(), data.View.ValueString(), "fqdn,view", &resp.Diagnostics)
+	if zone == nil {
+		return
+	}
+
+	args := map[string]interface{}{"operation": data.Operation.ValueString()}
+	httpRes, err := r.client.CallFunction(ctx, zone.GetRef(), dnssecOperation, args, nil)
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "run", "ZoneDnssecOperation", err, httpRes, ZoneDnssecOperationResourceSchemaAttributes)
+		return
+	}
+	data.Ref = types.StringValue(zone.GetRef())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read only checks that the zone still exists, so that the operation is run again on a zone created anew.
+func (r *ZoneDnssecOperationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneDnssecOperationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpRes, err := r.client.DNSAPI.
+		ZoneAuthAPI.
+		ZoneAuthReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2("fqdn,view").
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIError(&resp.Diagnostics, "read", "ZoneAuth", err, httpRes)
+		return
+	}
+}
+
+// Update is never called, as all the attributes require the resource to be replaced.
+func (r *ZoneDnssecOperationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Unexpected update", "The DNSSEC operation of a zone cannot be updated, it is run again by replacing the resource.")
+}
+
+// Delete only removes the operation from the state, the signatures and keys of the zone are left unchanged.
+func (r *ZoneDnssecOperationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
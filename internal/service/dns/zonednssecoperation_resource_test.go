@@ -0,0 +1,157 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+func TestAccZoneDnssecOperationResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_zone_dnssec_operation.test"
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Sign the zone
+			{
+				Config: testAccZoneDnssecOperation(fqdn, "SIGN", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "ref", "nios_dns_zone_auth.test_grid_primary", "ref"),
+					resource.TestCheckResourceAttr(resourceName, "operation", "SIGN"),
+					testAccCheckZoneDnssecSigned(context.Background(), "nios_dns_zone_auth.test_grid_primary", true),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDnssecOperationResource_Unsign(t *testing.T) {
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneDnssecOperation(fqdn, "SIGN", ""),
+				Check:  testAccCheckZoneDnssecSigned(context.Background(), "nios_dns_zone_auth.test_grid_primary", true),
+			},
+			// Replacing the resource runs the new operation
+			{
+				Config: testAccZoneDnssecOperation(fqdn, "UNSIGN", ""),
+				Check:  testAccCheckZoneDnssecSigned(context.Background(), "nios_dns_zone_auth.test_grid_primary", false),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneDnssecOperationResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_zone_dnssec_operation.test"
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_auth"),
+		Steps: []resource.TestStep{
+			// Only a signed zone can be unsigned
+			{
+				Config:      fake.ProviderConfig() + testAccZoneDnssecOperation(fqdn, "UNSIGN", ""),
+				ExpectError: regexp.MustCompile("is not signed"),
+			},
+			// Sign the zone
+			{
+				Config: fake.ProviderConfig() + testAccZoneDnssecOperation(fqdn, "SIGN", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "ref", "nios_dns_zone_auth.test_grid_primary", "ref"),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					testAccCheckFakeZoneDnssecKeys(fake, 1),
+				),
+			},
+			// Roll the key-signing key over, and again when the trigger changes
+			{
+				Config: fake.ProviderConfig() + testAccZoneDnssecOperation(fqdn, "ROLLOVER_KSK", "2026-01"),
+				Check:  testAccCheckFakeZoneDnssecKeys(fake, 2),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccZoneDnssecOperation(fqdn, "ROLLOVER_KSK", "2026-07"),
+				Check:  testAccCheckFakeZoneDnssecKeys(fake, 3),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckZoneDnssecSigned(ctx context.Context, zoneResourceName string, signed bool) resource.TestCheckFunc {
+	// Verify the zone is signed or not
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[zoneResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", zoneResourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneAuthAPI.
+			ZoneAuthReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2("fqdn,is_dnssec_signed").
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if res := apiRes.GetResult(); res.GetIsDnssecSigned() != signed {
+			return fmt.Errorf("expected the signed state of the zone %s to be %t", res.Fqdn, signed)
+		}
+		return nil
+	}
+}
+
+// testAccCheckFakeZoneDnssecKeys checks the number of key-signing keys of the zone of the fake WAPI server.
+func testAccCheckFakeZoneDnssecKeys(fake *acctest.FakeWAPI, ksks int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		zones := fake.Objects("zone_auth")
+		if len(zones) != 1 {
+			return fmt.Errorf("expected 1 zone, got %d", len(zones))
+		}
+		if zones[0]["is_dnssec_signed"] != true {
+			return fmt.Errorf("expected the zone %v to be signed", zones[0]["fqdn"])
+		}
+		keys, _ := zones[0]["dnssec_keys"].([]interface{})
+		count := 0
+		for _, k := range keys {
+			if k.(map[string]interface{})["type"] == "KSK" {
+				count++
+			}
+		}
+		if count != ksks {
+			return fmt.Errorf("expected %d key-signing keys, got %d", ksks, count)
+		}
+		return nil
+	}
+}
+
+func testAccZoneDnssecOperation(fqdn, operation, trigger string) string {
+	triggers := ""
+	if trigger != "" {
+		triggers = fmt.Sprintf(`triggers = {
+		rollover = %q
+	}`, trigger)
+	}
+	return testAccZoneAuthGridPrimary(fqdn, "infoblox.localdomain", "false") + fmt.Sprintf(`
+resource "nios_dns_zone_dnssec_operation" "test" {
+	fqdn = nios_dns_zone_auth.test_grid_primary.fqdn
+	operation = %q
+	%s
+}
+`, operation, triggers)
+}
@@ -0,0 +1,122 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccZoneForwardDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_forwards.test"
+	resourceName := "nios_dns_zone_forward.test"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneForwardDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneForwardDataSourceConfigFilters(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					}, testAccCheckZoneForwardResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneForwardDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_forwards.test"
+	resourceName := "nios_dns_zone_forward.test"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneForwardDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneForwardDataSourceConfigTagFilters(fqdn, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					}, testAccCheckZoneForwardResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckZoneForwardResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "display_domain", dataSourceName, "result.0.display_domain"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "forward_to", dataSourceName, "result.0.forward_to"),
+		resource.TestCheckResourceAttrPair(resourceName, "forwarders_only", dataSourceName, "result.0.forwarders_only"),
+		resource.TestCheckResourceAttrPair(resourceName, "fqdn", dataSourceName, "result.0.fqdn"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+		resource.TestCheckResourceAttrPair(resourceName, "zone_format", dataSourceName, "result.0.zone_format"),
+	}
+}
+
+func testAccZoneForwardDataSourceConfigFilters(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test" {
+	fqdn = %q
+	view = %q
+	forward_to = [
+		{
+			address = "192.0.2.53"
+			name = "dc1.corp.example.com"
+		}
+	]
+}
+
+data "nios_dns_zone_forwards" "test" {
+	filters = {
+		"fqdn": nios_dns_zone_forward.test.fqdn
+	}
+}
+`, fqdn, view)
+}
+
+func testAccZoneForwardDataSourceConfigTagFilters(fqdn, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test" {
+	fqdn = %q
+	view = %q
+	forward_to = [
+		{
+			address = "192.0.2.53"
+			name = "dc1.corp.example.com"
+		}
+	]
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_zone_forwards" "test" {
+	filters = {
+		"*Site" = nios_dns_zone_forward.test.extattrs.Site.value
+	}
+}
+`, fqdn, view, extAttrsValue)
+}
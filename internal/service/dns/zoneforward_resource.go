@@ -0,0 +1,240 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+	"strings"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForZoneForward = "comment,disable,display_domain,dns_fqdn,extattrs,forward_to,forwarders_only,forwarding_servers,fqdn,ns_group,view,zone_format"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneForwardResource{}
+var _ resource.ResourceWithImportState = &ZoneForwardResource{}
+var _ resource.ResourceWithModifyPlan = &ZoneForwardResource{}
+
+func NewZoneForwardResource() resource.Resource {
+	return &ZoneForwardResource{}
+}
+
+// ZoneForwardResource defines the resource implementation.
+type ZoneForwardResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *ZoneForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "dns_zone_forward"
+}
+
+func (r *ZoneForwardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          ZoneForwardResourceSchemaAttributes,
+	}
+}
+
+func (r *ZoneForwardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneForwardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "zone_forward", readableAttributesForZoneForward) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the zone
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	checkZoneName(ctx, req.Plan, &resp.Diagnostics)
+	r.checkForwardingServers(ctx, req, resp)
+}
+
+// checkForwardingServers rejects a grid member set more than once in the forwarding servers of the zone.
+func (r *ZoneForwardResource) checkForwardingServers(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan ZoneForwardModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ForwardingServers.IsNull() || plan.ForwardingServers.IsUnknown() {
+		return
+	}
+	var servers []ForwardingMemberServerModel
+	resp.Diagnostics.Append(plan.ForwardingServers.ElementsAs(ctx, &servers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := map[string]bool{}
+	for i, server := range servers {
+		if server.Name.IsUnknown() {
+			continue
+		}
+		name := strings.ToLower(server.Name.ValueString())
+		if names[name] {
+			resp.Diagnostics.AddAttributeError(path.Root("forwarding_servers").AtListIndex(i).AtName("name"), "Duplicate forwarding server",
+				fmt.Sprintf("The grid member %s is set more than once in the forwarding servers of the zone.", server.Name.ValueString()))
+		}
+		names[name] = true
+	}
+}
+
+func (r *ZoneForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneForwardModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneForward := data.Expand(ctx, &resp.Diagnostics, true)
+	zoneForward.Extattrs = utils.MergeDefaultExtAttrs(zoneForward.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneForwardAPI.
+		Post(ctx).
+		ZoneForward(*zoneForward).
+		ReturnFields2(readableAttributesForZoneForward).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "ZoneForward", err, httpRes, ZoneForwardResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneForwardModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneForwardAPI.
+		ZoneForwardReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForZoneForward).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "ZoneForward", err, httpRes, ZoneForwardResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ZoneForwardModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneForward := data.Expand(ctx, &resp.Diagnostics, false)
+	zoneForward.Extattrs = utils.MergeDefaultExtAttrs(zoneForward.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneForwardAPI.
+		ZoneForwardReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ZoneForward(*zoneForward).
+		ReturnFields2(readableAttributesForZoneForward).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "ZoneForward", err, httpRes, ZoneForwardResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneForwardModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		ZoneForwardAPI.
+		ZoneForwardReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "ZoneForward", err, httpRes, ZoneForwardResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the zone.
+func (r *ZoneForwardResource) flatten(ctx context.Context, data *ZoneForwardModel, res *dns.ZoneForward, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *ZoneForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
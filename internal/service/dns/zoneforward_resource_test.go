@@ -0,0 +1,504 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForZoneForwardTest = "comment,disable,display_domain,dns_fqdn,extattrs,forward_to,forwarders_only,forwarding_servers,fqdn,ns_group,view,zone_format"
+
+func TestAccZoneForwardResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_zone_forward.test"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneForwardBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "zone_format", "FORWARD"),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "forward_to.0.address", "192.0.2.53"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneForwardResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_zone_forward.test"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneForwardDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneForwardBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					testAccCheckZoneForwardDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccZoneForwardResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_zone_forward.test_comment"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneForwardComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneForwardComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneForwardResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_zone_forward.test_disable"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneForwardDisable(fqdn, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneForwardDisable(fqdn, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneForwardResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_zone_forward.test_extattrs"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneForwardExtattrs(fqdn, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneForwardExtattrs(fqdn, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneForwardResource_ForwardTo(t *testing.T) {
+	var resourceName = "nios_dns_zone_forward.test_forward_to"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneForwardForwardTo(fqdn, "192.0.2.53", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forward_to.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "forward_to.0.address", "192.0.2.53"),
+					resource.TestCheckResourceAttr(resourceName, "forward_to.0.name", "dc1.corp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders_only", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneForwardForwardTo(fqdn, "2001:db8::53", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forward_to.0.address", "2001:db8::53"),
+					resource.TestCheckResourceAttr(resourceName, "forwarders_only", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneForwardResource_ForwardingServers(t *testing.T) {
+	var resourceName = "nios_dns_zone_forward.test_forwarding_servers"
+	var v dns.ZoneForward
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneForwardForwardingServers(fqdn, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forwarding_servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "forwarding_servers.0.name", "infoblox.localdomain"),
+					resource.TestCheckResourceAttr(resourceName, "forwarding_servers.0.use_override_forwarders", "false"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneForwardForwardingServers(fqdn, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneForwardExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "forwarding_servers.0.use_override_forwarders", "true"),
+					resource.TestCheckResourceAttr(resourceName, "forwarding_servers.0.forward_to.0.address", "192.0.2.54"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneForwardResource_DuplicateForwardingServer(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A grid member forwards the queries of the zone once
+			{
+				Config:      fake.ProviderConfig() + testAccZoneForwardDuplicateForwardingServer("corp.example.com"),
+				ExpectError: regexp.MustCompile("Duplicate forwarding server"),
+			},
+			// The name of a reverse zone is its network
+			{
+				Config:      fake.ProviderConfig() + testAccZoneForwardBasicConfig("192.0.2.0/24", "default"),
+				ExpectError: regexp.MustCompile("Invalid zone name"),
+			},
+		},
+	})
+}
+
+func TestAccZoneForwardResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_zone_forward.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_forward"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneForwardComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "display_domain", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "dns_fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "forwarders_only", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneForwardComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccZoneForwardComment(fqdn, "default", "This is an updated zone"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccZoneForwardImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneForwardImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckZoneForwardExists(ctx context.Context, resourceName string, v *dns.ZoneForward) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneForwardAPI.
+			ZoneForwardReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForZoneForwardTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckZoneForwardDestroy(ctx context.Context, v *dns.ZoneForward) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			ZoneForwardAPI.
+			ZoneForwardReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForZoneForwardTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckZoneForwardDisappears(ctx context.Context, v *dns.ZoneForward) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			ZoneForwardAPI.
+			ZoneForwardReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccZoneForwardBasicConfig(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test" {
+	fqdn = %q
+	view = %q
+	forward_to = [
+		{
+			address = "192.0.2.53"
+			name = "dc1.corp.example.com"
+		}
+	]
+}
+`, fqdn, view)
+}
+
+func testAccZoneForwardComment(fqdn, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test_comment" {
+	fqdn = %q
+	view = %q
+	forward_to = [
+		{
+			address = "192.0.2.53"
+			name = "dc1.corp.example.com"
+		}
+	]
+	comment = %q
+}
+`, fqdn, view, comment)
+}
+
+func testAccZoneForwardDisable(fqdn, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test_disable" {
+	fqdn = %q
+	view = %q
+	forward_to = [
+		{
+			address = "192.0.2.53"
+			name = "dc1.corp.example.com"
+		}
+	]
+	disable = %q
+}
+`, fqdn, view, disable)
+}
+
+func testAccZoneForwardExtattrs(fqdn, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test_extattrs" {
+	fqdn = %q
+	view = %q
+	forward_to = [
+		{
+			address = "192.0.2.53"
+			name = "dc1.corp.example.com"
+		}
+	]
+	extattrs = %s
+}
+`, fqdn, view, extattrsStr)
+}
+
+func testAccZoneForwardForwardTo(fqdn, address, forwardersOnly string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test_forward_to" {
+	fqdn = %q
+	forward_to = [
+		{
+			address = %q
+			name = "dc1.corp.example.com"
+		}
+	]
+	forwarders_only = %q
+}
+`, fqdn, address, forwardersOnly)
+}
+
+func testAccZoneForwardForwardingServers(fqdn, useOverrideForwarders string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test_forwarding_servers" {
+	fqdn = %q
+	forward_to = [
+		{
+			address = "192.0.2.53"
+			name = "dc1.corp.example.com"
+		}
+	]
+	forwarding_servers = [
+		{
+			name = "infoblox.localdomain"
+			use_override_forwarders = %q
+			forward_to = [
+				{
+					address = "192.0.2.54"
+					name = "dc2.corp.example.com"
+				}
+			]
+		}
+	]
+}
+`, fqdn, useOverrideForwarders)
+}
+
+func testAccZoneForwardDuplicateForwardingServer(fqdn string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_forward" "test" {
+	fqdn = %q
+	forward_to = [
+		{
+			address = "192.0.2.53"
+			name = "dc1.corp.example.com"
+		}
+	]
+	forwarding_servers = [
+		{
+			name = "infoblox.localdomain"
+		},
+		{
+			name = "INFOBLOX.localdomain"
+		}
+	]
+}
+`, fqdn)
+}
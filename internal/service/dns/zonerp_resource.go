@@ -0,0 +1,246 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+	"net/http"
+
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/dns"
+)
+
+var readableAttributesForZoneRp = "comment,disable,display_domain,external_primaries,extattrs,fqdn,grid_primary,grid_secondaries,ns_group,rpz_policy,rpz_priority,rpz_severity,rpz_type,substitute_name,view"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneRpResource{}
+var _ resource.ResourceWithImportState = &ZoneRpResource{}
+var _ resource.ResourceWithModifyPlan = &ZoneRpResource{}
+
+func NewZoneRpResource() resource.Resource {
+	return &ZoneRpResource{}
+}
+
+// ZoneRpResource defines the resource implementation.
+type ZoneRpResource struct {
+	client *niosclient.APIClient
+}
+
+func (r *ZoneRpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + "rpz_zone"
+}
+
+func (r *ZoneRpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "",
+		Attributes:          ZoneRpResourceSchemaAttributes,
+	}
+}
+
+func (r *ZoneRpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*niosclient.APIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *niosclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ZoneRpResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !utils.PlanWAPIObject(ctx, r.client, req, resp, "zone_rp", readableAttributesForZoneRp) {
+		return
+	}
+
+	// The default extensible attributes of the provider are part of the effective extensible attributes of the zone
+	var extattrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("extattrs"), &extattrs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	extattrsAll := utils.PlanExtAttrsAll(ctx, extattrs, r.client.DNSAPI.Cfg.DefaultTags, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("extattrs_all"), extattrsAll)...)
+
+	r.checkPolicy(ctx, req, resp)
+}
+
+// checkPolicy checks the attributes that only apply to a policy or a type of zone: the substitute name of the
+// `SUBSTITUTE` policy and the external primary servers of a `FEED` zone.
+func (r *ZoneRpResource) checkPolicy(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, config ZoneRpModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RpzPolicy.IsUnknown() && !config.SubstituteName.IsUnknown() {
+		substitute := plan.RpzPolicy.ValueString() == "SUBSTITUTE"
+		switch {
+		case substitute && config.SubstituteName.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("substitute_name"), "Missing substitute name",
+				"The SUBSTITUTE policy requires the substitute_name of the response policy zone.")
+		case !substitute && !config.SubstituteName.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("substitute_name"), "Unexpected substitute name",
+				fmt.Sprintf("The substitute_name only applies to the SUBSTITUTE policy, the policy of the response policy zone is %s.", plan.RpzPolicy.ValueString()))
+		}
+	}
+
+	if !plan.RpzType.IsUnknown() && !config.ExternalPrimaries.IsUnknown() {
+		feed := plan.RpzType.ValueString() == "FEED"
+		switch {
+		case feed && config.ExternalPrimaries.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("external_primaries"), "Missing external primaries",
+				"A FEED response policy zone requires the external_primaries it gets its rules from.")
+		case !feed && !config.ExternalPrimaries.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("external_primaries"), "Unexpected external primaries",
+				fmt.Sprintf("The external_primaries only apply to a FEED response policy zone, the type of the zone is %s.", plan.RpzType.ValueString()))
+		}
+	}
+}
+
+func (r *ZoneRpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneRpModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneRp := data.Expand(ctx, &resp.Diagnostics, true)
+	zoneRp.Extattrs = utils.MergeDefaultExtAttrs(zoneRp.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneRpAPI.
+		Post(ctx).
+		ZoneRp(*zoneRp).
+		ReturnFields2(readableAttributesForZoneRp).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "create", "ZoneRp", err, httpRes, ZoneRpResourceSchemaAttributes)
+		return
+	}
+	res := apiRes.GetResult()
+
+	// Save data into Terraform state
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneRpModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneRpAPI.
+		ZoneRpReferenceGet(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ReturnFields2(readableAttributesForZoneRp).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "read", "ZoneRp", err, httpRes, ZoneRpResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ZoneRpModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneRp := data.Expand(ctx, &resp.Diagnostics, false)
+	zoneRp.Extattrs = utils.MergeDefaultExtAttrs(zoneRp.Extattrs, r.client.DNSAPI.Cfg.DefaultTags)
+
+	apiRes, httpRes, err := r.client.DNSAPI.
+		ZoneRpAPI.
+		ZoneRpReferencePut(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		ZoneRp(*zoneRp).
+		ReturnFields2(readableAttributesForZoneRp).
+		ReturnAsObject(1).
+		Execute()
+	if err != nil {
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "update", "ZoneRp", err, httpRes, ZoneRpResourceSchemaAttributes)
+		return
+	}
+
+	res := apiRes.GetResult()
+	r.flatten(ctx, &data, &res, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneRpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneRpModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.DNSAPI.
+		ZoneRpAPI.
+		ZoneRpReferenceDelete(ctx, utils.ExtractResourceRef(data.Ref.ValueString())).
+		Execute()
+	if err != nil {
+		if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+			return
+		}
+		utils.AddWAPIAttributeError(&resp.Diagnostics, "delete", "ZoneRp", err, httpRes, ZoneRpResourceSchemaAttributes)
+		return
+	}
+}
+
+// flatten updates data with res. The default extensible attributes of the provider are only kept in extattrs_all,
+// unless they are also set in the extattrs of the zone.
+func (r *ZoneRpResource) flatten(ctx context.Context, data *ZoneRpModel, res *dns.ZoneRp, diags *diag.Diagnostics) {
+	configured := data.Extattrs
+	data.Flatten(ctx, res, diags)
+	data.Extattrs = utils.RemoveDefaultExtAttrs(ctx, configured, data.ExtattrsAll, r.client.DNSAPI.Cfg.DefaultTags, diags)
+}
+
+func (r *ZoneRpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ref"), req, resp)
+}
@@ -0,0 +1,417 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForZoneRpTest = "comment,disable,display_domain,external_primaries,extattrs,fqdn,grid_primary,grid_secondaries,ns_group,rpz_policy,rpz_priority,rpz_severity,rpz_type,substitute_name,view"
+
+func TestAccZoneRpResource_basic(t *testing.T) {
+	var resourceName = "nios_rpz_zone.test"
+	var v dns.ZoneRp
+	fqdn := acctest.RandomName() + ".rpz.example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneRpBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rpz_policy", "GIVEN"),
+					resource.TestCheckResourceAttr(resourceName, "rpz_severity", "MAJOR"),
+					resource.TestCheckResourceAttr(resourceName, "rpz_type", "LOCAL"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRpResource_disappears(t *testing.T) {
+	resourceName := "nios_rpz_zone.test"
+	var v dns.ZoneRp
+	fqdn := acctest.RandomName() + ".rpz.example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRpDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRpBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					testAccCheckZoneRpDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccZoneRpResource_Comment(t *testing.T) {
+	var resourceName = "nios_rpz_zone.test_comment"
+	var v dns.ZoneRp
+	fqdn := acctest.RandomName() + ".rpz.example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneRpComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneRpComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRpResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_rpz_zone.test_extattrs"
+	var v dns.ZoneRp
+	fqdn := acctest.RandomName() + ".rpz.example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneRpExtattrs(fqdn, "default", extAttrValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneRpExtattrs(fqdn, "default", extAttrValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRpResource_RpzPolicy(t *testing.T) {
+	var resourceName = "nios_rpz_zone.test_rpz_policy"
+	var v dns.ZoneRp
+	fqdn := acctest.RandomName() + ".rpz.example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneRpRpzPolicy(fqdn, "NXDOMAIN", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rpz_policy", "NXDOMAIN"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneRpRpzPolicy(fqdn, "SUBSTITUTE", "walled-garden.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rpz_policy", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr(resourceName, "substitute_name", "walled-garden.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRpResource_RpzSeverity(t *testing.T) {
+	var resourceName = "nios_rpz_zone.test_rpz_severity"
+	var v dns.ZoneRp
+	fqdn := acctest.RandomName() + ".rpz.example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneRpRpzSeverity(fqdn, "CRITICAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rpz_severity", "CRITICAL"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneRpRpzSeverity(fqdn, "INFORMATIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneRpExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rpz_severity", "INFORMATIONAL"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneRpResource_Policy(t *testing.T) {
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".rpz.example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_rp"),
+		Steps: []resource.TestStep{
+			// The SUBSTITUTE policy requires a substitute name
+			{
+				Config:      fake.ProviderConfig() + testAccZoneRpRpzPolicy(fqdn, "SUBSTITUTE", ""),
+				ExpectError: regexp.MustCompile("Missing substitute name"),
+			},
+			// The other policies do not take one
+			{
+				Config:      fake.ProviderConfig() + testAccZoneRpRpzPolicy(fqdn, "PASSTHRU", "walled-garden.example.com"),
+				ExpectError: regexp.MustCompile("Unexpected substitute name"),
+			},
+			// A feed zone requires its external primaries
+			{
+				Config:      fake.ProviderConfig() + testAccZoneRpRpzType(fqdn, "FEED", false),
+				ExpectError: regexp.MustCompile("Missing external primaries"),
+			},
+			// A local zone does not take any
+			{
+				Config:      fake.ProviderConfig() + testAccZoneRpRpzType(fqdn, "LOCAL", true),
+				ExpectError: regexp.MustCompile("Unexpected external primaries"),
+			},
+			{
+				Config: fake.ProviderConfig() + testAccZoneRpRpzType(fqdn, "FEED", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nios_rpz_zone.test_rpz_type", "rpz_type", "FEED"),
+					resource.TestCheckResourceAttr("nios_rpz_zone.test_rpz_type", "external_primaries.0.address", "192.0.2.10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneRpResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_rpz_zone.test_rpz_policy"
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".rpz.example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_rp"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneRpRpzPolicy(fqdn, "NXDOMAIN", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "display_domain", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "rpz_policy", "NXDOMAIN"),
+					resource.TestCheckResourceAttr(resourceName, "rpz_severity", "MAJOR"),
+					resource.TestCheckResourceAttr(resourceName, "rpz_type", "LOCAL"),
+					resource.TestCheckResourceAttr(resourceName, "rpz_priority", "0"),
+					resource.TestCheckResourceAttr(resourceName, "substitute_name", ""),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneRpRpzPolicy(fqdn, "SUBSTITUTE", "walled-garden.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rpz_policy", "SUBSTITUTE"),
+					resource.TestCheckResourceAttr(resourceName, "substitute_name", "walled-garden.example.com"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccZoneRpRpzPolicy(fqdn, "SUBSTITUTE", "walled-garden.example.com"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccZoneRpImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneRpImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckZoneRpExists(ctx context.Context, resourceName string, v *dns.ZoneRp) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneRpAPI.
+			ZoneRpReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForZoneRpTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckZoneRpDestroy(ctx context.Context, v *dns.ZoneRp) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			ZoneRpAPI.
+			ZoneRpReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForZoneRpTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckZoneRpDisappears(ctx context.Context, v *dns.ZoneRp) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			ZoneRpAPI.
+			ZoneRpReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccZoneRpBasicConfig(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test" {
+	fqdn = %q
+	view = %q
+}
+`, fqdn, view)
+}
+
+func testAccZoneRpComment(fqdn, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test_comment" {
+	fqdn = %q
+	view = %q
+	comment = %q
+}
+`, fqdn, view, comment)
+}
+
+func testAccZoneRpExtattrs(fqdn, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test_extattrs" {
+	fqdn = %q
+	view = %q
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+`, fqdn, view, extAttrsValue)
+}
+
+func testAccZoneRpRpzPolicy(fqdn, policy, substituteName string) string {
+	substitute := ""
+	if substituteName != "" {
+		substitute = fmt.Sprintf("substitute_name = %q", substituteName)
+	}
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test_rpz_policy" {
+	fqdn = %q
+	rpz_policy = %q
+	%s
+}
+`, fqdn, policy, substitute)
+}
+
+func testAccZoneRpRpzSeverity(fqdn, severity string) string {
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test_rpz_severity" {
+	fqdn = %q
+	rpz_severity = %q
+}
+`, fqdn, severity)
+}
+
+func testAccZoneRpRpzType(fqdn, rpzType string, withPrimaries bool) string {
+	primaries := ""
+	if withPrimaries {
+		primaries = `external_primaries = [
+		{
+			address = "192.0.2.10"
+			name = "feed.example.net"
+		}
+	]`
+	}
+	return fmt.Sprintf(`
+resource "nios_rpz_zone" "test_rpz_type" {
+	fqdn = %q
+	rpz_type = %q
+	%s
+}
+`, fqdn, rpzType, primaries)
+}
@@ -0,0 +1,121 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+)
+
+func TestAccZoneStubDataSource_Filters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_stubs.test"
+	resourceName := "nios_dns_zone_stub.test"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneStubDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneStubDataSourceConfigFilters(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					}, testAccCheckZoneStubResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+func TestAccZoneStubDataSource_TagFilters(t *testing.T) {
+	dataSourceName := "data.nios_dns_zone_stubs.test"
+	resourceName := "nios_dns_zone_stub.test"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+	extAttrValue := acctest.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneStubDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneStubDataSourceConfigTagFilters(fqdn, "default", extAttrValue),
+				Check: resource.ComposeTestCheckFunc(
+					append([]resource.TestCheckFunc{
+						testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					}, testAccCheckZoneStubResourceAttrPair(resourceName, dataSourceName)...)...,
+				),
+			},
+		},
+	})
+}
+
+// below all TestAcc functions
+
+func testAccCheckZoneStubResourceAttrPair(resourceName, dataSourceName string) []resource.TestCheckFunc {
+	return []resource.TestCheckFunc{
+		resource.TestCheckResourceAttrPair(resourceName, "comment", dataSourceName, "result.0.comment"),
+		resource.TestCheckResourceAttrPair(resourceName, "disable", dataSourceName, "result.0.disable"),
+		resource.TestCheckResourceAttrPair(resourceName, "display_domain", dataSourceName, "result.0.display_domain"),
+		resource.TestCheckResourceAttrPair(resourceName, "extattrs", dataSourceName, "result.0.extattrs"),
+		resource.TestCheckResourceAttrPair(resourceName, "fqdn", dataSourceName, "result.0.fqdn"),
+		resource.TestCheckResourceAttrPair(resourceName, "stub_from", dataSourceName, "result.0.stub_from"),
+		resource.TestCheckResourceAttrPair(resourceName, "view", dataSourceName, "result.0.view"),
+		resource.TestCheckResourceAttrPair(resourceName, "zone_format", dataSourceName, "result.0.zone_format"),
+	}
+}
+
+func testAccZoneStubDataSourceConfigFilters(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test" {
+	fqdn = %q
+	view = %q
+	stub_from = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+}
+
+data "nios_dns_zone_stubs" "test" {
+	filters = {
+		"fqdn": nios_dns_zone_stub.test.fqdn
+	}
+}
+`, fqdn, view)
+}
+
+func testAccZoneStubDataSourceConfigTagFilters(fqdn, view, extAttrsValue string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test" {
+	fqdn = %q
+	view = %q
+	stub_from = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	extattrs = {
+		Site = {
+			value = %q
+		}
+	}
+}
+
+data "nios_dns_zone_stubs" "test" {
+	filters = {
+		"*Site" = nios_dns_zone_stub.test.extattrs.Site.value
+	}
+}
+`, fqdn, view, extAttrsValue)
+}
@@ -0,0 +1,481 @@
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/unasra/nios-go-client/dns"
+	"github.com/unasra/terraform-provider-nios/internal/acctest"
+	"github.com/unasra/terraform-provider-nios/internal/utils"
+)
+
+var readableAttributesForZoneStubTest = "comment,disable,display_domain,dns_fqdn,extattrs,fqdn,ns_group,stub_from,stub_members,view,zone_format"
+
+func TestAccZoneStubResource_basic(t *testing.T) {
+	var resourceName = "nios_dns_zone_stub.test"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneStubBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "zone_format", "FORWARD"),
+					resource.TestCheckResourceAttr(resourceName, "view", "default"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "stub_from.0.address", "192.0.2.10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneStubResource_disappears(t *testing.T) {
+	resourceName := "nios_dns_zone_stub.test"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckZoneStubDestroy(context.Background(), &v),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneStubBasicConfig(fqdn, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					testAccCheckZoneStubDisappears(context.Background(), &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccZoneStubResource_Comment(t *testing.T) {
+	var resourceName = "nios_dns_zone_stub.test_comment"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneStubComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneStubComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneStubResource_Disable(t *testing.T) {
+	var resourceName = "nios_dns_zone_stub.test_disable"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneStubDisable(fqdn, "default", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "true"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneStubDisable(fqdn, "default", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneStubResource_Extattrs(t *testing.T) {
+	var resourceName = "nios_dns_zone_stub.test_extattrs"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+	extAttrValue1 := acctest.RandomName()
+	extAttrValue2 := acctest.RandomName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneStubExtattrs(fqdn, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue1,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue1),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneStubExtattrs(fqdn, "default", map[string]map[string]string{
+					"Site": {
+						"value": extAttrValue2,
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "extattrs.Site.value", extAttrValue2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneStubResource_StubFrom(t *testing.T) {
+	var resourceName = "nios_dns_zone_stub.test_stub_from"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneStubStubFrom(fqdn, "192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "stub_from.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stub_from.0.address", "192.0.2.10"),
+					resource.TestCheckResourceAttr(resourceName, "stub_from.0.name", "ns1.example.net"),
+				),
+			},
+			// Update and Read
+			{
+				Config: testAccZoneStubStubFrom(fqdn, "2001:db8::10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "stub_from.0.address", "2001:db8::10"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneStubResource_StubMembers(t *testing.T) {
+	var resourceName = "nios_dns_zone_stub.test_stub_members"
+	var v dns.ZoneStub
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccZoneStubStubMembers(fqdn, "infoblox.localdomain"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZoneStubExists(context.Background(), resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "stub_members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stub_members.0.name", "infoblox.localdomain"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccZoneStubResource_ReverseZone(t *testing.T) {
+	var resourceName = "nios_dns_zone_stub.test_reverse"
+	fake := acctest.NewFakeWAPI(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_stub"),
+		Steps: []resource.TestStep{
+			// The reverse zones are created from their network
+			{
+				Config: fake.ProviderConfig() + testAccZoneStubReverse("198.51.100.0/24", "IPV4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "display_domain", "100.51.198.in-addr.arpa"),
+				),
+			},
+			// The network of a reverse zone matches its format
+			{
+				Config:      fake.ProviderConfig() + testAccZoneStubReverse("198.51.100.0/24", "IPV6"),
+				ExpectError: regexp.MustCompile("Invalid zone name"),
+			},
+		},
+	})
+}
+
+func TestAccZoneStubResource_FakeWAPI(t *testing.T) {
+	var resourceName = "nios_dns_zone_stub.test_comment"
+	fake := acctest.NewFakeWAPI(t)
+	fqdn := acctest.RandomName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             fake.CheckDestroy("zone_stub"),
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneStubComment(fqdn, "default", "This is a new zone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ref"),
+					resource.TestCheckResourceAttr(resourceName, "display_domain", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "dns_fqdn", fqdn),
+					resource.TestCheckResourceAttr(resourceName, "stub_from.0.name", "ns1.example.net"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is a new zone"),
+				),
+			},
+			// Update and Read
+			{
+				Config: fake.ProviderConfig() + testAccZoneStubComment(fqdn, "default", "This is an updated zone"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comment", "This is an updated zone"),
+				),
+			},
+			// Import
+			{
+				Config:                               fake.ProviderConfig() + testAccZoneStubComment(fqdn, "default", "This is an updated zone"),
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccZoneStubImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "ref",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccZoneStubImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["ref"], nil
+	}
+}
+
+func testAccCheckZoneStubExists(ctx context.Context, resourceName string, v *dns.ZoneStub) resource.TestCheckFunc {
+	// Verify the resource exists in the cloud
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		apiRes, _, err := acctest.NIOSClient.DNSAPI.
+			ZoneStubAPI.
+			ZoneStubReferenceGet(ctx, utils.ExtractResourceRef(rs.Primary.Attributes["ref"])).
+			ReturnFields2(readableAttributesForZoneStubTest).
+			ReturnAsObject(1).
+			Execute()
+		if err != nil {
+			return err
+		}
+		if !apiRes.HasResult() {
+			return fmt.Errorf("expected result to be returned: %s", resourceName)
+		}
+		*v = apiRes.GetResult()
+		return nil
+	}
+}
+
+func testAccCheckZoneStubDestroy(ctx context.Context, v *dns.ZoneStub) resource.TestCheckFunc {
+	// Verify the resource was destroyed
+	return func(state *terraform.State) error {
+		_, httpRes, err := acctest.NIOSClient.DNSAPI.
+			ZoneStubAPI.
+			ZoneStubReferenceGet(ctx, utils.ExtractResourceRef(*v.Ref)).
+			ReturnAsObject(1).
+			ReturnFields2(readableAttributesForZoneStubTest).
+			Execute()
+		if err != nil {
+			if httpRes != nil && httpRes.StatusCode == http.StatusNotFound {
+				// resource was deleted
+				return nil
+			}
+			return err
+		}
+		return errors.New("expected to be deleted")
+	}
+}
+
+func testAccCheckZoneStubDisappears(ctx context.Context, v *dns.ZoneStub) resource.TestCheckFunc {
+	// Delete the resource externally to verify disappears test
+	return func(state *terraform.State) error {
+		_, err := acctest.NIOSClient.DNSAPI.
+			ZoneStubAPI.
+			ZoneStubReferenceDelete(ctx, utils.ExtractResourceRef(*v.Ref)).
+			Execute()
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccZoneStubBasicConfig(fqdn, view string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test" {
+	fqdn = %q
+	view = %q
+	stub_from = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+}
+`, fqdn, view)
+}
+
+func testAccZoneStubComment(fqdn, view, comment string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test_comment" {
+	fqdn = %q
+	view = %q
+	stub_from = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	comment = %q
+}
+`, fqdn, view, comment)
+}
+
+func testAccZoneStubDisable(fqdn, view, disable string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test_disable" {
+	fqdn = %q
+	view = %q
+	stub_from = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	disable = %q
+}
+`, fqdn, view, disable)
+}
+
+func testAccZoneStubExtattrs(fqdn, view string, extAttrs map[string]map[string]string) string {
+	valueStr := ""
+	extattrsStr := "{\n"
+	for k, v := range extAttrs {
+		valueStr += "{\n"
+		for k1, v1 := range v {
+			valueStr += fmt.Sprintf(`
+					%s = %q
+		`, k1, v1)
+		}
+		valueStr += "\t}"
+		extattrsStr += fmt.Sprintf(`
+			%s = %s
+	`, k, valueStr)
+	}
+	extattrsStr += "\t}"
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test_extattrs" {
+	fqdn = %q
+	view = %q
+	stub_from = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	extattrs = %s
+}
+`, fqdn, view, extattrsStr)
+}
+
+func testAccZoneStubStubFrom(fqdn, address string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test_stub_from" {
+	fqdn = %q
+	stub_from = [
+		{
+			address = %q
+			name = "ns1.example.net"
+		}
+	]
+}
+`, fqdn, address)
+}
+
+func testAccZoneStubStubMembers(fqdn, member string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test_stub_members" {
+	fqdn = %q
+	stub_from = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+	stub_members = [
+		{
+			name = %q
+		}
+	]
+}
+`, fqdn, member)
+}
+
+func testAccZoneStubReverse(network, zoneFormat string) string {
+	return fmt.Sprintf(`
+resource "nios_dns_zone_stub" "test_reverse" {
+	fqdn = %q
+	zone_format = %q
+	stub_from = [
+		{
+			address = "192.0.2.10"
+			name = "ns1.example.net"
+		}
+	]
+}
+`, network, zoneFormat)
+}
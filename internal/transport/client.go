@@ -0,0 +1,40 @@
+package transport
+
+import (
+	"net/http"
+)
+
+// Config holds the settings of the HTTP client shared by all the WAPI calls made by a provider instance.
+type Config struct {
+	TLS TLSConfig
+	// Session enables WAPI session authentication: the credentials are only sent to log in and the session
+	// cookie is used for all the following requests. Open sessions are closed by CloseSessions.
+	Session bool
+	Retry   RetryConfig
+	Limit   LimitConfig
+}
+
+// NewHTTPClient builds the HTTP client described by cfg.
+// The returned client is meant to be passed to the NIOS client with option.WithHTTPClient.
+func NewHTTPClient(cfg Config) (*http.Client, error) {
+	tlsConfig, err := cfg.TLS.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = tlsConfig
+
+	// The limits apply to every request sent to the Grid Master, including logins and retries.
+	// Every request is logged, with the time spent by the Grid Master on it.
+	var rt http.RoundTripper = newLimitTransport(newLogTransport(t), cfg.Limit)
+	if cfg.Session {
+		rt = newSessionTransport(rt)
+	}
+	// Retries wrap the session handling, so that a retried request can log in again if needed.
+	rt = newRetryTransport(rt, cfg.Retry)
+
+	return &http.Client{
+		Transport: rt,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package transport
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// LimitConfig bounds the load a provider instance puts on the Grid Master.
+type LimitConfig struct {
+	// MaxConcurrentRequests is the maximum number of requests in flight. Zero means no limit.
+	MaxConcurrentRequests int
+	// RequestsPerSecond is the maximum rate at which requests are sent. Zero means no limit.
+	RequestsPerSecond int
+}
+
+// limitTransport shares a concurrency and rate budget between all the requests sent through it.
+// Requests are spaced evenly rather than sent in bursts, so that the Grid Master sees a steady load.
+type limitTransport struct {
+	next http.RoundTripper
+	// slots holds a token per request in flight, it is nil when the concurrency is not limited.
+	slots chan struct{}
+	// interval is the minimum time between two requests, zero when the rate is not limited.
+	interval time.Duration
+
+	mu       sync.Mutex
+	nextSend time.Time
+}
+
+func newLimitTransport(next http.RoundTripper, cfg LimitConfig) *limitTransport {
+	t := &limitTransport{next: next}
+	if cfg.MaxConcurrentRequests > 0 {
+		t.slots = make(chan struct{}, cfg.MaxConcurrentRequests)
+	}
+	if cfg.RequestsPerSecond > 0 {
+		t.interval = time.Second / time.Duration(cfg.RequestsPerSecond)
+	}
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			defer func() { <-t.slots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if wait := t.reserve(); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// reserve books the next send time available and returns how long to wait for it.
+func (t *limitTransport) reserve() time.Duration {
+	if t.interval == 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	send := t.nextSend
+	if send.Before(now) {
+		send = now
+	}
+	t.nextSend = send.Add(t.interval)
+	return send.Sub(now)
+}
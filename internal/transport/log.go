@@ -0,0 +1,173 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LogSubsystem is the tflog subsystem of the WAPI traffic. Its level can be set with TF_LOG_PROVIDER_NIOS.
+	LogSubsystem = "nios"
+
+	redacted = "***"
+	// maxLoggedBodyLength bounds the part of the request and response bodies logged at the TRACE level.
+	maxLoggedBodyLength = 16 * 1024
+)
+
+var (
+	// sensitiveHeaders carry credentials: the basic authentication and the WAPI session cookie.
+	sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+	// sensitiveFieldPattern matches the WAPI fields holding secrets, e.g. password, shared_secret or tsig_key.
+	sensitiveFieldPattern = regexp.MustCompile(`(?i)(password|secret|passphrase|token|private_key|tsig_key|shared_key)`)
+)
+
+// logTransport logs every request sent to the Grid Master in the nios tflog subsystem.
+// A summary of each request is logged at the DEBUG level and the headers and bodies at the TRACE level,
+// with the credentials redacted.
+type logTransport struct {
+	next http.RoundTripper
+}
+
+func newLogTransport(next http.RoundTripper) *logTransport {
+	return &logTransport{next: next}
+}
+
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := logContext(req.Context())
+
+	objectType, ref := wapiObject(req)
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"object_type": objectType,
+	}
+	if ref != "" {
+		fields["ref"] = ref
+	}
+	if req.URL.RawQuery != "" {
+		fields["query"] = req.URL.RawQuery
+	}
+
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Sending WAPI request", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.Redacted(),
+		"headers": redactHeaders(req.Header),
+		"body":    requestBody(req),
+	})
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, LogSubsystem, "WAPI request failed", fields)
+		return resp, err
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		fields["error"] = readErr.Error()
+		tflog.SubsystemDebug(ctx, LogSubsystem, "WAPI request failed", fields)
+		return nil, readErr
+	}
+
+	fields["status_code"] = resp.StatusCode
+	fields["response_size"] = len(body)
+	tflog.SubsystemDebug(ctx, LogSubsystem, "WAPI request completed", fields)
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Received WAPI response", map[string]interface{}{
+		"status_code": resp.StatusCode,
+		"headers":     redactHeaders(resp.Header),
+		"body":        redactBody(body),
+	})
+	return resp, nil
+}
+
+// logContext returns ctx with the nios tflog subsystem.
+func logContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "NIOS"))
+}
+
+// wapiObject returns the object type and the reference a request is about, e.g. "record:a" and
+// "record:a/ZG5zLmJpbmRfYSQuX2RlZmF1bHQ:a.example.com/default". The reference is empty for requests on an object type.
+func wapiObject(req *http.Request) (objectType, ref string) {
+	path := req.URL.Path
+	if m := wapiBasePath.FindStringSubmatch(path); m != nil {
+		path = path[len(m[0]):]
+	}
+	objectType, _, found := strings.Cut(path, "/")
+	if found {
+		ref = path
+	}
+	return objectType, ref
+}
+
+func requestBody(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+	return redactBody(b)
+}
+
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range sensitiveHeaders {
+		if _, ok := h[k]; ok {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
+
+// redactBody returns body with the values of the sensitive JSON fields redacted.
+// Bodies that are not JSON are returned as is, they do not carry object fields.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		if b, err := json.Marshal(redactValue(v)); err == nil {
+			body = b
+		}
+	}
+	if len(body) > maxLoggedBodyLength {
+		return string(body[:maxLoggedBodyLength]) + "..."
+	}
+	return string(body)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if sensitiveFieldPattern.MatchString(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(e)
+		}
+	}
+	return v
+}
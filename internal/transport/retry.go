@@ -0,0 +1,210 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+
+	// AttemptsHeader is set on the response returned once the retries are exhausted, to the number of attempts made.
+	AttemptsHeader = "X-Nios-Retry-Attempts"
+)
+
+// RetryConfig describes how requests failing with a transient error are retried.
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt. Zero disables the retries.
+	MaxRetries int
+	// WaitMin and WaitMax bound the exponential backoff between two attempts.
+	WaitMin time.Duration
+	WaitMax time.Duration
+	// RequestTimeout bounds the duration of each attempt. Zero means no timeout.
+	RequestTimeout time.Duration
+}
+
+// retryTransport retries the requests failing with a transient error, with an exponential backoff and jitter.
+//
+// Reads are retried on network errors and on the statuses returned while a Grid Master is unavailable.
+// Writes are only retried when they did not reach the server, so that they are never applied twice.
+// Once the retries are exhausted, the last response is returned so that the WAPI error can be reported, with the
+// number of attempts in its AttemptsHeader.
+type retryTransport struct {
+	next http.RoundTripper
+	cfg  RetryConfig
+}
+
+func newRetryTransport(next http.RoundTripper, cfg RetryConfig) *retryTransport {
+	if cfg.WaitMin <= 0 {
+		cfg.WaitMin = DefaultRetryWaitMin
+	}
+	if cfg.WaitMax < cfg.WaitMin {
+		cfg.WaitMax = cfg.WaitMin
+	}
+	return &retryTransport{next: next, cfg: cfg}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			if err := rewindBody(req); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := t.attempt(req)
+		if !t.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+		if attempt > t.cfg.MaxRetries {
+			if err != nil {
+				return nil, fmt.Errorf("giving up after %d attempt(s): %w", attempt, err)
+			}
+			tflog.SubsystemWarn(logContext(ctx), LogSubsystem, "Giving up on WAPI request", map[string]interface{}{
+				"method":      req.Method,
+				"url":         req.URL.Redacted(),
+				"attempts":    attempt,
+				"status_code": resp.StatusCode,
+			})
+			resp.Header.Set(AttemptsHeader, strconv.Itoa(attempt))
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			drainBody(resp)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("giving up after %d attempt(s): %w", attempt, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// attempt sends req once, bounded by the request timeout.
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.cfg.RequestTimeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.cfg.RequestTimeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return resp, err
+	}
+	// The timeout also covers reading the body, so it is only released once the body is closed.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// Stop as soon as the caller gave up, but keep retrying the attempts that timed out on their own.
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isTransient(err) && (isIdempotent(req.Method) || notSent(err))
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		// The request was rejected before being processed.
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+// backoff returns the time to wait before the next attempt.
+// It honors the Retry-After header, and otherwise doubles the wait at each attempt with a random jitter.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s >= 0 {
+			return min(time.Duration(s)*time.Second, t.cfg.WaitMax)
+		}
+	}
+
+	wait := float64(t.cfg.WaitMin) * math.Pow(2, float64(attempt-1))
+	if wait > float64(t.cfg.WaitMax) {
+		wait = float64(t.cfg.WaitMax)
+	}
+	// Full jitter between half and the whole wait spreads the retries of concurrent requests.
+	return time.Duration(wait/2 + rand.Float64()*wait/2)
+}
+
+// isIdempotent reports whether a request with the given method can be sent twice without side effects.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// isTransient reports whether err is a network error or a timeout that may not happen again, as opposed to e.g. a
+// certificate verification failure.
+func isTransient(err error) bool {
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidCertErr x509.CertificateInvalidError
+	if errors.As(err, &certErr) || errors.As(err, &unknownAuthorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &invalidCertErr) {
+		return false
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// notSent reports whether err happened before the request could reach the server.
+func notSent(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// drainBody reads and closes the body of a response that is discarded, so that the connection can be reused.
+func drainBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
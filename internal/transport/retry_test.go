@@ -0,0 +1,121 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		failures     int32
+		status       int
+		wantAttempts int32
+		wantStatus   int
+		wantHeader   string
+	}{
+		{name: "read recovers", method: http.MethodGet, failures: 2, status: http.StatusServiceUnavailable, wantAttempts: 3, wantStatus: http.StatusOK},
+		// The last response is returned so that the WAPI error can be reported
+		{name: "read gives up", method: http.MethodGet, failures: 10, status: http.StatusServiceUnavailable, wantAttempts: 4, wantStatus: http.StatusServiceUnavailable, wantHeader: "4"},
+		{name: "write is not retried once sent", method: http.MethodPost, failures: 1, status: http.StatusServiceUnavailable, wantAttempts: 1, wantStatus: http.StatusServiceUnavailable},
+		{name: "throttled write is retried", method: http.MethodPost, failures: 1, status: http.StatusTooManyRequests, wantAttempts: 2, wantStatus: http.StatusOK},
+		{name: "client errors are not retried", method: http.MethodGet, failures: 1, status: http.StatusBadRequest, wantAttempts: 1, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != "payload" {
+					t.Errorf("unexpected body %q", body)
+				}
+				if attempts.Add(1) <= tt.failures {
+					http.Error(w, "grid master unavailable", tt.status)
+				}
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, RetryConfig{
+				MaxRetries: 3,
+				WaitMin:    time.Millisecond,
+				WaitMax:    5 * time.Millisecond,
+			})}
+
+			req, _ := http.NewRequest(tt.method, server.URL, strings.NewReader("payload"))
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if resp.StatusCode != http.StatusOK && !strings.Contains(string(body), "grid master unavailable") {
+				t.Errorf("expected the body of the last response, got %q", body)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, got)
+			}
+			if got := resp.Header.Get(AttemptsHeader); got != tt.wantHeader {
+				t.Errorf("expected the %s header %q, got %q", AttemptsHeader, tt.wantHeader, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_ConnectionRefused(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, RetryConfig{
+		MaxRetries: 2,
+		WaitMin:    time.Millisecond,
+		WaitMax:    time.Millisecond,
+	})}
+
+	_, err := client.Post(url, "application/json", strings.NewReader("{}"))
+	if err == nil || !strings.Contains(err.Error(), "giving up after 3 attempt(s)") {
+		t.Fatalf("expected the write to be retried as it was never sent, got %v", err)
+	}
+}
+
+func TestRetryTransport_CertificateError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	var attempts atomic.Int32
+	next := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client := &http.Client{Transport: newRetryTransport(next, RetryConfig{
+		MaxRetries: 2,
+		WaitMin:    time.Millisecond,
+		WaitMax:    time.Millisecond,
+	})}
+
+	// The certificate of the test server is not trusted, which retrying does not fix
+	_, err := client.Get(server.URL)
+	if err == nil {
+		t.Fatal("expected a certificate verification error")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected 1 attempt, got %d", got)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
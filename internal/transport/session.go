@@ -0,0 +1,178 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+)
+
+const (
+	// sessionCookieName is the name of the cookie WAPI uses to identify an authenticated session.
+	sessionCookieName   = "ibapauth"
+	headerAuthorization = "Authorization"
+)
+
+// wapiBasePath matches the versioned WAPI base path of a request, e.g. /wapi/v2.12.3/.
+var wapiBasePath = regexp.MustCompile(`^(.*?/wapi/v[0-9.]+)/`)
+
+var (
+	sessionsMu sync.Mutex
+	// sessions holds all the sessions opened by this process, so they can be closed on shutdown.
+	sessions = map[*sessionTransport]struct{}{}
+)
+
+// sessionTransport authenticates once with the Authorization header set by the NIOS client and reuses the
+// WAPI session cookie for all the following requests. The session is transparently re-established when
+// WAPI rejects the cookie, e.g. after the session timed out.
+type sessionTransport struct {
+	next http.RoundTripper
+
+	// loginMu serializes the requests sent while there is no session, so that only one of them authenticates.
+	loginMu sync.Mutex
+
+	mu      sync.Mutex
+	cookie  *http.Cookie
+	baseURL string
+}
+
+func newSessionTransport(next http.RoundTripper) *sessionTransport {
+	s := &sessionTransport{next: next}
+
+	sessionsMu.Lock()
+	sessions[s] = struct{}{}
+	sessionsMu.Unlock()
+
+	return s
+}
+
+func (s *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if cookie := s.sessionCookie(); cookie != nil {
+		resp, err := s.next.RoundTrip(withSessionCookie(req, cookie))
+		if err != nil || resp.StatusCode != http.StatusUnauthorized || req.Header.Get(headerAuthorization) == "" {
+			return resp, err
+		}
+		// The session is no longer valid, drop it and log in again with the request credentials.
+		s.invalidate(cookie)
+		if err := rewindBody(req); err != nil {
+			return resp, nil
+		}
+		resp.Body.Close()
+	}
+
+	s.loginMu.Lock()
+	defer s.loginMu.Unlock()
+
+	// Another request may have established a session while this one was waiting.
+	if cookie := s.sessionCookie(); cookie != nil {
+		return s.next.RoundTrip(withSessionCookie(req, cookie))
+	}
+
+	resp, err := s.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	for _, c := range resp.Cookies() {
+		if c.Name == sessionCookieName && c.Value != "" {
+			s.setSession(c, req.URL)
+			break
+		}
+	}
+	return resp, nil
+}
+
+// Logout closes the WAPI session, if any.
+func (s *sessionTransport) Logout(ctx context.Context) error {
+	s.mu.Lock()
+	cookie, baseURL := s.cookie, s.baseURL
+	s.cookie = nil
+	s.mu.Unlock()
+
+	if cookie == nil || baseURL == "" {
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/logout", nil)
+	if err != nil {
+		return err
+	}
+	req.AddCookie(cookie)
+
+	resp, err := s.next.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unable to log out from WAPI: %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *sessionTransport) sessionCookie() *http.Cookie {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cookie
+}
+
+func (s *sessionTransport) setSession(cookie *http.Cookie, u *url.URL) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cookie = &http.Cookie{Name: cookie.Name, Value: cookie.Value}
+	if m := wapiBasePath.FindStringSubmatch(u.Path); m != nil {
+		s.baseURL = (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: m[1]}).String()
+	}
+}
+
+// invalidate drops the session if it still uses the given cookie.
+func (s *sessionTransport) invalidate(cookie *http.Cookie) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cookie == cookie {
+		s.cookie = nil
+	}
+}
+
+// withSessionCookie returns a copy of req authenticated with the session cookie instead of the credentials.
+func withSessionCookie(req *http.Request, cookie *http.Cookie) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Del(headerAuthorization)
+	r.AddCookie(cookie)
+	return r
+}
+
+// rewindBody resets the body of req so that it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	if req.GetBody == nil {
+		return fmt.Errorf("request body of %s %s cannot be replayed", req.Method, req.URL.Path)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// CloseSessions logs out of all the WAPI sessions opened by this process.
+// It is meant to be called once the provider server has stopped.
+func CloseSessions(ctx context.Context) error {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+
+	var errs []error
+	for s := range sessions {
+		if err := s.Logout(ctx); err != nil {
+			errs = append(errs, err)
+		}
+		delete(sessions, s)
+	}
+	return errors.Join(errs...)
+}
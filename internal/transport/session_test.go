@@ -0,0 +1,90 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeSessionServer emulates the WAPI session handling: Basic authentication issues a new session cookie,
+// and only the latest session cookie is accepted.
+type fakeSessionServer struct {
+	logins  atomic.Int32
+	logouts atomic.Int32
+	session atomic.Value
+}
+
+func (f *fakeSessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if c, err := r.Cookie(sessionCookieName); err == nil {
+		if r.Header.Get(headerAuthorization) != "" {
+			http.Error(w, "both cookie and credentials sent", http.StatusBadRequest)
+			return
+		}
+		if c.Value != f.session.Load() {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/logout") {
+			f.logouts.Add(1)
+			f.session.Store("")
+		}
+		return
+	}
+
+	if _, _, ok := r.BasicAuth(); !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	n := f.logins.Add(1)
+	value := "session" + string(rune('0'+n))
+	f.session.Store(value)
+	http.SetCookie(w, &http.Cookie{Name: sessionCookieName, Value: value})
+}
+
+func TestSessionTransport(t *testing.T) {
+	fake := &fakeSessionServer{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	s := newSessionTransport(http.DefaultTransport)
+	client := &http.Client{Transport: s}
+
+	get := func() {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/wapi/v2.12.3/record:a", strings.NewReader(`{"name":"a"}`))
+		req.SetBasicAuth("admin", "infoblox")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status %s", resp.Status)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		get()
+	}
+	if got := fake.logins.Load(); got != 1 {
+		t.Errorf("expected a single login, got %d", got)
+	}
+
+	// Expire the session on the server side, the transport must log in again.
+	fake.session.Store("expired")
+	get()
+	get()
+	if got := fake.logins.Load(); got != 2 {
+		t.Errorf("expected a second login after the session expired, got %d logins", got)
+	}
+
+	if err := s.Logout(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := fake.logouts.Load(); got != 1 {
+		t.Errorf("expected a logout, got %d", got)
+	}
+}
@@ -0,0 +1,120 @@
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	// SSLModeVerifyFull verifies the server certificate chain and that the certificate matches the host name.
+	SSLModeVerifyFull = "verify_full"
+	// SSLModeVerifyCA verifies the server certificate chain but not the host name.
+	// This is useful when the Grid Master is reached by IP address and its certificate only carries its FQDN.
+	SSLModeVerifyCA = "verify_ca"
+	// SSLModeInsecure disables all server certificate verification.
+	SSLModeInsecure = "insecure"
+)
+
+// SSLModes lists all the supported values of the ssl_mode provider attribute.
+var SSLModes = []string{SSLModeVerifyFull, SSLModeVerifyCA, SSLModeInsecure}
+
+// TLSConfig describes how the connection to the NIOS Grid Master is secured.
+type TLSConfig struct {
+	// SSLMode is one of SSLModes. Defaults to SSLModeVerifyFull when empty.
+	SSLMode string
+	// CACertificate is a PEM encoded bundle of CA certificates used to verify the server.
+	CACertificate string
+	// CACertificateFile is the path to a PEM encoded bundle of CA certificates used to verify the server.
+	CACertificateFile string
+	// ClientCertificate is the PEM encoded client certificate presented to the server.
+	ClientCertificate string
+	// ClientKey is the PEM encoded private key of ClientCertificate.
+	ClientKey string
+}
+
+// Build returns the *tls.Config described by c.
+// When neither CACertificate nor CACertificateFile is set, the system certificate pool is used.
+func (c TLSConfig) Build() (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if (c.ClientCertificate == "") != (c.ClientKey == "") {
+		return nil, errors.New("client_certificate and client_key must be set together")
+	}
+	if c.ClientCertificate != "" {
+		cert, err := tls.X509KeyPair([]byte(c.ClientCertificate), []byte(c.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	roots, err := c.rootCAs()
+	if err != nil {
+		return nil, err
+	}
+	cfg.RootCAs = roots
+
+	switch c.SSLMode {
+	case "", SSLModeVerifyFull:
+	case SSLModeVerifyCA:
+		// The standard verification always checks the host name, so it is disabled here
+		// and the certificate chain is verified by hand against the same roots.
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifyChain(cs, roots)
+		}
+	case SSLModeInsecure:
+		cfg.InsecureSkipVerify = true
+	default:
+		return nil, fmt.Errorf("unsupported ssl_mode %q, expected one of %v", c.SSLMode, SSLModes)
+	}
+
+	return cfg, nil
+}
+
+// rootCAs returns the pool of CA certificates used to verify the server, or nil to use the system pool.
+func (c TLSConfig) rootCAs() (*x509.CertPool, error) {
+	if c.CACertificate == "" && c.CACertificateFile == "" {
+		return nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if c.CACertificate != "" {
+		if !pool.AppendCertsFromPEM([]byte(c.CACertificate)) {
+			return nil, errors.New("ca_certificate does not contain any valid PEM encoded certificate")
+		}
+	}
+	if c.CACertificateFile != "" {
+		pem, err := os.ReadFile(c.CACertificateFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_certificate_file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca_certificate_file %q does not contain any valid PEM encoded certificate", c.CACertificateFile)
+		}
+	}
+	return pool, nil
+}
+
+// verifyChain verifies the certificate chain presented by the server without checking the host name.
+func verifyChain(cs tls.ConnectionState, roots *x509.CertPool) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server did not present a certificate")
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
@@ -0,0 +1,68 @@
+package transport
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewHTTPClient_SSLMode(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	// The test certificate is valid for 127.0.0.1 but not for localhost.
+	wrongHostURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	tests := []struct {
+		name    string
+		tls     TLSConfig
+		url     string
+		wantErr bool
+	}{
+		{name: "verify_full without CA", tls: TLSConfig{}, url: server.URL, wantErr: true},
+		{name: "verify_full with CA", tls: TLSConfig{CACertificate: caPEM}, url: server.URL},
+		{name: "verify_full with wrong host", tls: TLSConfig{CACertificate: caPEM}, url: wrongHostURL, wantErr: true},
+		{name: "verify_ca with wrong host", tls: TLSConfig{SSLMode: SSLModeVerifyCA, CACertificate: caPEM}, url: wrongHostURL},
+		{name: "verify_ca without CA", tls: TLSConfig{SSLMode: SSLModeVerifyCA}, url: server.URL, wantErr: true},
+		{name: "insecure", tls: TLSConfig{SSLMode: SSLModeInsecure}, url: wrongHostURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewHTTPClient(Config{TLS: tt.tls})
+			if err != nil {
+				t.Fatalf("unexpected error building client: %s", err)
+			}
+			resp, err := client.Get(tt.url)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error: %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTLSConfig_Build_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		tls  TLSConfig
+	}{
+		{name: "unknown ssl_mode", tls: TLSConfig{SSLMode: "disable"}},
+		{name: "invalid ca_certificate", tls: TLSConfig{CACertificate: "not a certificate"}},
+		{name: "missing ca_certificate_file", tls: TLSConfig{CACertificateFile: "/does/not/exist.pem"}},
+		{name: "client_certificate without client_key", tls: TLSConfig{ClientCertificate: "cert"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.tls.Build(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
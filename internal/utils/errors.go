@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/unasra/terraform-provider-nios/internal/transport"
+)
+
+// WAPIError is the body WAPI returns when it rejects a request, e.g.
+//
+//	{"Error": "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:...)", "code": "Client.Ibap.Data.Conflict", "text": "..."}
+type WAPIError struct {
+	StatusCode int    `json:"-"`
+	Err        string `json:"Error"`
+	Code       string `json:"code"`
+	Text       string `json:"text"`
+}
+
+// WAPIErrorKind classifies the errors returned by WAPI.
+type WAPIErrorKind int
+
+const (
+	WAPIErrorOther WAPIErrorKind = iota
+	WAPIErrorConflict
+	WAPIErrorAuth
+	WAPIErrorNotFound
+	WAPIErrorMissingZone
+)
+
+var (
+	// missingZonePattern matches the messages WAPI returns when the zone of a record does not exist.
+	missingZonePattern = regexp.MustCompile(`(?i)(parent was not found|zone .*(not found|does not exist)|no such zone|cannot find .*zone)`)
+	// fieldPatterns extract the field a WAPI error message is about.
+	fieldPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)(?:invalid value for|unknown argument/field:?|field is not (?:readable|writable|searchable):?|required field missing:?|invalid field:?|for field)\s+'?([a-z0-9_]+)'?`),
+		regexp.MustCompile(`(?i)'([a-z0-9_]+)'\s+(?:is|must be|cannot)`),
+	}
+)
+
+// ParseWAPIError extracts the WAPI error from an error returned by the NIOS client.
+// It returns nil if err does not carry a WAPI error body.
+func ParseWAPIError(err error, httpRes *http.Response) *WAPIError {
+	if err == nil {
+		return nil
+	}
+
+	var bodyErr interface{ Body() []byte }
+	if !errors.As(err, &bodyErr) || len(bodyErr.Body()) == 0 {
+		if httpRes == nil {
+			return nil
+		}
+		return &WAPIError{StatusCode: httpRes.StatusCode, Text: http.StatusText(httpRes.StatusCode)}
+	}
+
+	var e WAPIError
+	if jsonErr := json.Unmarshal(bodyErr.Body(), &e); jsonErr != nil || (e.Code == "" && e.Text == "" && e.Err == "") {
+		// Authentication failures and proxies return HTML instead of JSON.
+		e = WAPIError{Text: strings.TrimSpace(string(bodyErr.Body()))}
+	}
+	if httpRes != nil {
+		e.StatusCode = httpRes.StatusCode
+	}
+	return &e
+}
+
+// Kind classifies the error.
+func (e *WAPIError) Kind() WAPIErrorKind {
+	switch {
+	case e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden || strings.Contains(e.Code, ".Auth"):
+		return WAPIErrorAuth
+	case strings.HasSuffix(e.Code, ".Conflict"):
+		return WAPIErrorConflict
+	case missingZonePattern.MatchString(e.Text) || missingZonePattern.MatchString(e.Err):
+		return WAPIErrorMissingZone
+	case e.StatusCode == http.StatusNotFound:
+		return WAPIErrorNotFound
+	}
+	return WAPIErrorOther
+}
+
+// Field returns the field the error is about, if it can be inferred from the message.
+func (e *WAPIError) Field() string {
+	for _, p := range fieldPatterns {
+		for _, msg := range []string{e.Text, e.Err} {
+			if m := p.FindStringSubmatch(msg); m != nil {
+				return strings.ToLower(m[1])
+			}
+		}
+	}
+	return ""
+}
+
+// Message returns a human-readable message for the error.
+func (e *WAPIError) Message() string {
+	msg := e.Text
+	if msg == "" {
+		msg = e.Err
+	}
+	if e.Code != "" {
+		msg = fmt.Sprintf("%s (%s)", msg, e.Code)
+	}
+	return msg
+}
+
+// AddWAPIError translates an error returned by the NIOS client into a diagnostic.
+// operation and objectName describe what failed, e.g. "create" and "Recorda".
+func AddWAPIError(diags *diag.Diagnostics, operation, objectName string, err error, httpRes *http.Response) {
+	summary, detail, _ := translateWAPIError(operation, objectName, err, httpRes)
+	diags.AddError(summary, detail)
+}
+
+// AddWAPIAttributeError is AddWAPIError for resources: when the error is about one of the given schema
+// attributes, the diagnostic is attached to its path.
+func AddWAPIAttributeError[A any](diags *diag.Diagnostics, operation, objectName string, err error, httpRes *http.Response, attributes map[string]A) {
+	summary, detail, field := translateWAPIError(operation, objectName, err, httpRes)
+	if _, ok := attributes[field]; ok && field != "" {
+		diags.AddAttributeError(path.Root(field), summary, detail)
+		return
+	}
+	diags.AddError(summary, detail)
+}
+
+func translateWAPIError(operation, objectName string, err error, httpRes *http.Response) (summary, detail, field string) {
+	e := ParseWAPIError(err, httpRes)
+	if e == nil {
+		return "Client Error", fmt.Sprintf("Unable to %s %s, got error: %s", operation, objectName, err), ""
+	}
+
+	switch e.Kind() {
+	case WAPIErrorConflict:
+		summary = "Conflicting object"
+		detail = fmt.Sprintf("Unable to %s %s, it conflicts with an existing object: %s", operation, objectName, e.Message())
+	case WAPIErrorAuth:
+		summary = "Authentication failed"
+		detail = fmt.Sprintf("Unable to %s %s, the grid rejected the credentials or the user lacks the required permissions: %s. "+
+			"Check the provider credentials and the permissions of the admin group of the user.", operation, objectName, e.Message())
+	case WAPIErrorMissingZone:
+		summary = "Zone not found"
+		detail = fmt.Sprintf("Unable to %s %s, its zone does not exist in the given view: %s. "+
+			"Check the name and view of the object, or create the authoritative zone first.", operation, objectName, e.Message())
+		// The zone of an object is derived from its name.
+		field = "name"
+	case WAPIErrorNotFound:
+		summary = "Object not found"
+		detail = fmt.Sprintf("Unable to %s %s, the object was not found: %s", operation, objectName, e.Message())
+	default:
+		summary = "Client Error"
+		detail = fmt.Sprintf("Unable to %s %s, got error: %s", operation, objectName, e.Message())
+	}
+	if f := e.Field(); f != "" {
+		field = f
+	}
+	// The transport retries the requests failing while the grid is busy or unavailable before giving up.
+	if httpRes != nil && httpRes.Header.Get(transport.AttemptsHeader) != "" {
+		detail = fmt.Sprintf("%s (giving up after %s attempt(s))", detail, httpRes.Header.Get(transport.AttemptsHeader))
+	}
+	return summary, detail, field
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/option"
+
+	"github.com/unasra/terraform-provider-nios/internal/transport"
+)
+
+// bodyError mimics the GenericOpenAPIError returned by the NIOS client.
+type bodyError struct {
+	body []byte
+}
+
+func (e bodyError) Error() string { return "400 Bad Request" }
+func (e bodyError) Body() []byte  { return e.body }
+
+func TestAddWAPIAttributeError(t *testing.T) {
+	attributes := map[string]struct{}{"name": {}, "ipv4addr": {}, "ttl": {}}
+
+	tests := []struct {
+		name        string
+		err         error
+		status      int
+		wantSummary string
+		wantPath    path.Path
+	}{
+		{
+			name:        "conflict",
+			err:         bodyError{[]byte(`{"Error": "AdmConDataError: None (IBDataConflictError: IB.Data.Conflict:The record 'a.example.com' already exists.)", "code": "Client.Ibap.Data.Conflict", "text": "The record 'a.example.com' already exists."}`)},
+			status:      http.StatusBadRequest,
+			wantSummary: "Conflicting object",
+		},
+		{
+			name:        "invalid field value",
+			err:         bodyError{[]byte(`{"Error": "AdmConProtoError: Invalid value for ipv4addr: \"10.0.0\"", "code": "Client.Ibap.Proto", "text": "Invalid value for ipv4addr: \"10.0.0\""}`)},
+			status:      http.StatusBadRequest,
+			wantSummary: "Client Error",
+			wantPath:    path.Root("ipv4addr"),
+		},
+		{
+			name:        "missing zone",
+			err:         bodyError{[]byte(`{"Error": "AdmConDataNotFoundError: The action is not allowed. A parent was not found.", "code": "Client.Ibap.Data.NotFound", "text": "The action is not allowed. A parent was not found."}`)},
+			status:      http.StatusBadRequest,
+			wantSummary: "Zone not found",
+			wantPath:    path.Root("name"),
+		},
+		{
+			name:        "authentication",
+			err:         bodyError{[]byte(`<html><body>Authorization Required</body></html>`)},
+			status:      http.StatusUnauthorized,
+			wantSummary: "Authentication failed",
+		},
+		{
+			name:        "transport error",
+			err:         errors.New("giving up after 4 attempt(s): connection refused"),
+			wantSummary: "Client Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var httpRes *http.Response
+			if tt.status != 0 {
+				httpRes = &http.Response{StatusCode: tt.status}
+			}
+
+			var diags diag.Diagnostics
+			AddWAPIAttributeError(&diags, "create", "Recorda", tt.err, httpRes, attributes)
+
+			if len(diags) != 1 {
+				t.Fatalf("expected a single diagnostic, got %d", len(diags))
+			}
+			if got := diags[0].Summary(); got != tt.wantSummary {
+				t.Errorf("expected summary %q, got %q", tt.wantSummary, got)
+			}
+			var gotPath path.Path
+			if d, ok := diags[0].(diag.DiagnosticWithPath); ok {
+				gotPath = d.Path()
+			}
+			if !gotPath.Equal(tt.wantPath) {
+				t.Errorf("expected path %q, got %q", tt.wantPath, gotPath)
+			}
+		})
+	}
+}
+
+func TestAddWAPIError_RetriesExhausted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "grid master unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	httpClient, err := transport.NewHTTPClient(transport.Config{Retry: transport.RetryConfig{
+		MaxRetries: 2,
+		WaitMin:    time.Millisecond,
+		WaitMax:    time.Millisecond,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := niosclient.NewAPIClient(option.WithNIOSHostUrl(server.URL), option.WithNIOSAuth("token"), option.WithHTTPClient(httpClient))
+
+	_, httpRes, err := client.SearchObjects(context.Background(), "grid", nil, "")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	var diags diag.Diagnostics
+	AddWAPIError(&diags, "read", "Grid", err, httpRes)
+
+	want := "Unable to read Grid, got error: grid master unavailable (giving up after 3 attempt(s))"
+	if len(diags) != 1 || diags[0].Detail() != want {
+		t.Errorf("expected the diagnostic %q, got %v", want, diags)
+	}
+}
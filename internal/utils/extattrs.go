@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// extAttrValueKey is the key of the value of an extensible attribute in the WAPI representation,
+// e.g. {"Site": {"value": "blr"}}.
+const extAttrValueKey = "value"
+
+// MergeDefaultExtAttrs returns the extensible attributes sent to WAPI for an object: the configured ones,
+// completed with the default extensible attributes of the provider. Configured values take precedence.
+func MergeDefaultExtAttrs(extattrs map[string]interface{}, defaults map[string]string) map[string]interface{} {
+	if len(defaults) == 0 {
+		return extattrs
+	}
+
+	merged := make(map[string]interface{}, len(extattrs)+len(defaults))
+	for k, v := range defaults {
+		merged[k] = map[string]string{extAttrValueKey: v}
+	}
+	for k, v := range extattrs {
+		merged[k] = v
+	}
+	return merged
+}
+
+// RemoveDefaultExtAttrs returns the extensible attributes of an object as they should appear in its extattrs
+// attribute: all the effective extensible attributes, except the provider defaults that are not explicitly
+// configured. This way objects do not show a diff for the extensible attributes added by the provider.
+//
+// configured is the previous value of the extattrs attribute, from the plan or the state.
+func RemoveDefaultExtAttrs(ctx context.Context, configured, all types.Map, defaults map[string]string, diags *diag.Diagnostics) types.Map {
+	if len(defaults) == 0 || all.IsNull() || all.IsUnknown() {
+		return all
+	}
+
+	var allElems map[string]map[string]string
+	diags.Append(all.ElementsAs(ctx, &allElems, false)...)
+
+	configuredKeys := map[string]struct{}{}
+	if !configured.IsNull() && !configured.IsUnknown() {
+		for k := range configured.Elements() {
+			configuredKeys[k] = struct{}{}
+		}
+	}
+
+	elems := make(map[string]map[string]string, len(allElems))
+	for k, v := range allElems {
+		if _, ok := configuredKeys[k]; !ok {
+			if def, isDefault := defaults[k]; isDefault && v[extAttrValueKey] == def {
+				continue
+			}
+		}
+		elems[k] = v
+	}
+
+	if len(elems) == 0 && (configured.IsNull() || configured.IsUnknown()) {
+		return types.MapNull(types.MapType{ElemType: types.StringType})
+	}
+	tfMap, d := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, elems)
+	diags.Append(d...)
+	return tfMap
+}
+
+// PlanExtAttrsAll returns the planned value of the extattrs_all attribute of an object: the planned extensible
+// attributes, completed with the default extensible attributes of the provider.
+func PlanExtAttrsAll(ctx context.Context, extattrs types.Map, defaults map[string]string, diags *diag.Diagnostics) types.Map {
+	attrType := types.MapType{ElemType: types.StringType}
+	if extattrs.IsUnknown() {
+		return types.MapUnknown(attrType)
+	}
+
+	elems := make(map[string]map[string]string, len(extattrs.Elements())+len(defaults))
+	for k, v := range defaults {
+		elems[k] = map[string]string{extAttrValueKey: v}
+	}
+	if !extattrs.IsNull() {
+		var configured map[string]map[string]string
+		diags.Append(extattrs.ElementsAs(ctx, &configured, false)...)
+		for k, v := range configured {
+			elems[k] = v
+		}
+	}
+
+	if len(elems) == 0 {
+		return types.MapNull(attrType)
+	}
+	tfMap, d := types.MapValueFrom(ctx, attrType, elems)
+	diags.Append(d...)
+	return tfMap
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func extAttrsMap(t *testing.T, values map[string]string) types.Map {
+	t.Helper()
+	elems := make(map[string]map[string]string, len(values))
+	for k, v := range values {
+		elems[k] = map[string]string{"value": v}
+	}
+	m, d := types.MapValueFrom(context.Background(), types.MapType{ElemType: types.StringType}, elems)
+	if d.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", d)
+	}
+	return m
+}
+
+func TestMergeDefaultExtAttrs(t *testing.T) {
+	merged := MergeDefaultExtAttrs(
+		map[string]interface{}{"Owner": map[string]string{"value": "dns"}},
+		map[string]string{"Owner": "netops", "managed_by": "terraform"},
+	)
+
+	if got := merged["Owner"].(map[string]string)["value"]; got != "dns" {
+		t.Errorf("Owner = %q, want the configured value %q", got, "dns")
+	}
+	if got := merged["managed_by"].(map[string]string)["value"]; got != "terraform" {
+		t.Errorf("managed_by = %q, want the default value %q", got, "terraform")
+	}
+	if got := MergeDefaultExtAttrs(nil, nil); got != nil {
+		t.Errorf("MergeDefaultExtAttrs(nil, nil) = %v, want nil", got)
+	}
+}
+
+func TestRemoveDefaultExtAttrs(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"Owner": "netops", "managed_by": "terraform"}
+	nullMap := types.MapNull(types.MapType{ElemType: types.StringType})
+
+	tests := []struct {
+		name       string
+		configured types.Map
+		all        types.Map
+		want       types.Map
+	}{
+		{
+			name:       "only defaults",
+			configured: nullMap,
+			all:        extAttrsMap(t, defaults),
+			want:       nullMap,
+		},
+		{
+			name:       "configured default is kept",
+			configured: extAttrsMap(t, map[string]string{"Owner": "netops", "Site": "blr"}),
+			all:        extAttrsMap(t, map[string]string{"Owner": "netops", "Site": "blr", "managed_by": "terraform"}),
+			want:       extAttrsMap(t, map[string]string{"Owner": "netops", "Site": "blr"}),
+		},
+		{
+			name:       "changed default is kept",
+			configured: nullMap,
+			all:        extAttrsMap(t, map[string]string{"Owner": "dns", "managed_by": "terraform"}),
+			want:       extAttrsMap(t, map[string]string{"Owner": "dns"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := RemoveDefaultExtAttrs(ctx, tt.configured, tt.all, defaults, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("RemoveDefaultExtAttrs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanExtAttrsAll(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	got := PlanExtAttrsAll(ctx, extAttrsMap(t, map[string]string{"Owner": "dns"}), map[string]string{"Owner": "netops", "managed_by": "terraform"}, &diags)
+	want := extAttrsMap(t, map[string]string{"Owner": "dns", "managed_by": "terraform"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !got.Equal(want) {
+		t.Errorf("PlanExtAttrsAll() = %v, want %v", got, want)
+	}
+}
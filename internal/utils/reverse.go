@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+const (
+	ipv4ReverseSuffix = ".in-addr.arpa"
+	ipv6ReverseSuffix = ".ip6.arpa"
+)
+
+// ReverseName returns the name of the PTR record of addr, in the in-addr.arpa or ip6.arpa domain,
+// e.g. `4.3.2.1.in-addr.arpa` for 1.2.3.4.
+func ReverseName(addr netip.Addr) string {
+	if addr.Is4() || addr.Is4In6() {
+		b := addr.Unmap().As4()
+		return strconv.Itoa(int(b[3])) + "." + strconv.Itoa(int(b[2])) + "." + strconv.Itoa(int(b[1])) + "." +
+			strconv.Itoa(int(b[0])) + ipv4ReverseSuffix
+	}
+
+	const hexDigits = "0123456789abcdef"
+	b := addr.As16()
+	var sb strings.Builder
+	for i := len(b) - 1; i >= 0; i-- {
+		sb.WriteByte(hexDigits[b[i]&0x0f])
+		sb.WriteByte('.')
+		sb.WriteByte(hexDigits[b[i]>>4])
+		sb.WriteByte('.')
+	}
+	return sb.String() + ipv6ReverseSuffix[1:]
+}
+
+// ReverseZoneName returns the name of the reverse zone of prefix, e.g. `2.0.192.in-addr.arpa` for 192.0.2.0/24.
+// An IPv4 network longer than /24 has an RFC 2317 classless name, e.g. `128/26.2.0.192.in-addr.arpa` for
+// 192.0.2.128/26, and an IPv6 network not on a nibble boundary is named after its nibbles only.
+func ReverseZoneName(prefix netip.Prefix) string {
+	prefix = prefix.Masked()
+	var labels []string
+	if prefix.Addr().Is4() {
+		b := prefix.Addr().As4()
+		for i := 0; i < prefix.Bits()/8; i++ {
+			labels = append([]string{strconv.Itoa(int(b[i]))}, labels...)
+		}
+		if prefix.Bits() > 24 && prefix.Bits() < 32 {
+			labels = append([]string{strconv.Itoa(int(b[3])) + "/" + strconv.Itoa(prefix.Bits())}, labels...)
+		}
+		return strings.Join(append(labels, ipv4ReverseSuffix[1:]), ".")
+	}
+
+	const hexDigits = "0123456789abcdef"
+	b := prefix.Addr().As16()
+	for i := 0; i < prefix.Bits()/4; i++ {
+		nibble := b[i/2] >> 4
+		if i%2 == 1 {
+			nibble = b[i/2] & 0x0f
+		}
+		labels = append([]string{string(hexDigits[nibble])}, labels...)
+	}
+	return strings.Join(append(labels, ipv6ReverseSuffix[1:]), ".")
+}
+
+// AddrFromReverseName returns the address a PTR record name stands for. It returns false when name is not the
+// reverse name of a single address, e.g. the name of a reverse zone or a name outside of in-addr.arpa and ip6.arpa.
+func AddrFromReverseName(name string) (netip.Addr, bool) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+
+	if labels, ok := strings.CutSuffix(name, ipv4ReverseSuffix); ok {
+		octets := strings.Split(labels, ".")
+		if len(octets) != 4 {
+			return netip.Addr{}, false
+		}
+		var b [4]byte
+		for i, o := range octets {
+			// Reject the leading zeros, they are not part of the canonical reverse name
+			v, err := strconv.ParseUint(o, 10, 8)
+			if err != nil || strconv.FormatUint(v, 10) != o {
+				return netip.Addr{}, false
+			}
+			b[3-i] = byte(v)
+		}
+		return netip.AddrFrom4(b), true
+	}
+
+	if labels, ok := strings.CutSuffix(name, ipv6ReverseSuffix); ok {
+		nibbles := strings.Split(labels, ".")
+		if len(nibbles) != 32 {
+			return netip.Addr{}, false
+		}
+		var b [16]byte
+		for i, n := range nibbles {
+			v, err := strconv.ParseUint(n, 16, 4)
+			if err != nil || len(n) != 1 {
+				return netip.Addr{}, false
+			}
+			// Nibbles are in reverse order, the first one is the low nibble of the last byte
+			pos := 31 - i
+			if pos%2 == 0 {
+				b[pos/2] |= byte(v) << 4
+			} else {
+				b[pos/2] |= byte(v)
+			}
+		}
+		return netip.AddrFrom16(b), true
+	}
+
+	return netip.Addr{}, false
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestReverseName(t *testing.T) {
+	tests := []struct {
+		addr string
+		want string
+	}{
+		{"192.0.2.10", "10.2.0.192.in-addr.arpa"},
+		{"10.0.0.1", "1.0.0.10.in-addr.arpa"},
+		{"::ffff:192.0.2.10", "10.2.0.192.in-addr.arpa"},
+		{"2001:db8::567:89ab", "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			addr := netip.MustParseAddr(tt.addr)
+			if got := ReverseName(addr); got != tt.want {
+				t.Errorf("ReverseName(%s) = %q, want %q", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReverseZoneName(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{"192.0.2.0/24", "2.0.192.in-addr.arpa"},
+		{"10.0.0.0/8", "10.in-addr.arpa"},
+		{"172.16.0.0/12", "172.in-addr.arpa"},
+		{"192.0.2.128/26", "128/26.2.0.192.in-addr.arpa"},
+		{"2001:db8::/32", "8.b.d.0.1.0.0.2.ip6.arpa"},
+		{"2001:db8:1200::/40", "2.1.8.b.d.0.1.0.0.2.ip6.arpa"},
+		{"2001:db8:1230::/42", "2.1.8.b.d.0.1.0.0.2.ip6.arpa"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			prefix := netip.MustParsePrefix(tt.prefix)
+			if got := ReverseZoneName(prefix); got != tt.want {
+				t.Errorf("ReverseZoneName(%s) = %q, want %q", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddrFromReverseName(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOk bool
+	}{
+		{"10.2.0.192.in-addr.arpa", "192.0.2.10", true},
+		{"10.2.0.192.IN-ADDR.ARPA.", "192.0.2.10", true},
+		{"b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", "2001:db8::567:89ab", true},
+		{"2.0.192.in-addr.arpa", "", false},
+		{"010.2.0.192.in-addr.arpa", "", false},
+		{"256.2.0.192.in-addr.arpa", "", false},
+		{"10.0/26.2.0.192.in-addr.arpa", "", false},
+		{"8.b.d.0.1.0.0.2.ip6.arpa", "", false},
+		{"bb.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", "", false},
+		{"host.example.com", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := AddrFromReverseName(tt.name)
+			if ok != tt.wantOk {
+				t.Fatalf("AddrFromReverseName(%q) ok = %t, want %t", tt.name, ok, tt.wantOk)
+			}
+			if ok && got != netip.MustParseAddr(tt.want) {
+				t.Errorf("AddrFromReverseName(%q) = %s, want %s", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxTXTStringLength is the maximum length of a character-string of a TXT record, in bytes.
+const MaxTXTStringLength = 255
+
+// ParseTXTText returns the character-strings of the text of a TXT record, as WAPI interprets it. A text that does
+// not start with a quote is a single character-string, e.g. `v=spf1 mx -all`. Otherwise the text is a sequence of
+// quoted character-strings in the zone file syntax, e.g. `"part 1" "part 2"`, where `\"` and `\\` are escaped and
+// `\DDD` is a byte in decimal. The character-strings longer than 255 bytes are split.
+func ParseTXTText(text string) []string {
+	trimmed := strings.TrimLeftFunc(text, unicode.IsSpace)
+	if !strings.HasPrefix(trimmed, `"`) {
+		return SplitTXTString(text)
+	}
+
+	var result []string
+	s := trimmed
+	for {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		if s == "" {
+			break
+		}
+		var part []byte
+		quoted := s[0] == '"'
+		if quoted {
+			s = s[1:]
+		}
+		for s != "" {
+			c := s[0]
+			if quoted && c == '"' {
+				s = s[1:]
+				break
+			}
+			if !quoted && (c == ' ' || c == '\t' || c == '\n' || c == '\r') {
+				break
+			}
+			if c == '\\' && len(s) > 1 {
+				if len(s) > 3 && isDigit(s[1]) && isDigit(s[2]) && isDigit(s[3]) {
+					if v := int(s[1]-'0')*100 + int(s[2]-'0')*10 + int(s[3]-'0'); v <= 255 {
+						part = append(part, byte(v))
+						s = s[4:]
+						continue
+					}
+				}
+				part = append(part, s[1])
+				s = s[2:]
+				continue
+			}
+			part = append(part, c)
+			s = s[1:]
+		}
+		result = append(result, SplitTXTString(string(part))...)
+	}
+	if len(result) == 0 {
+		return []string{""}
+	}
+	return result
+}
+
+// FormatTXTText returns the text of a TXT record with the given character-strings. A single character-string is
+// returned as is when WAPI would not interpret it otherwise, so that the common records, such as SPF records, keep
+// their usual form. The character-strings longer than 255 bytes are split.
+func FormatTXTText(parts []string) string {
+	var split []string
+	for _, p := range parts {
+		split = append(split, SplitTXTString(p)...)
+	}
+	if len(split) == 1 && !needsTXTQuoting(split[0]) {
+		return split[0]
+	}
+
+	quoted := make([]string, len(split))
+	for i, p := range split {
+		quoted[i] = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(p) + `"`
+	}
+	return strings.Join(quoted, " ")
+}
+
+// SplitTXTString splits s in character-strings of at most 255 bytes. Multi-byte characters are not split.
+func SplitTXTString(s string) []string {
+	var parts []string
+	for len(s) > MaxTXTStringLength {
+		i := MaxTXTStringLength
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		parts = append(parts, s[:i])
+		s = s[i:]
+	}
+	return append(parts, s)
+}
+
+func needsTXTQuoting(s string) bool {
+	if s == "" || strings.ContainsAny(s, `"\`) {
+		return true
+	}
+	first, _ := utf8.DecodeRuneInString(s)
+	last, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsSpace(first) || unicode.IsSpace(last)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTXTText(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"v=spf1 mx -all", []string{"v=spf1 mx -all"}},
+		{`"v=spf1 mx -all"`, []string{"v=spf1 mx -all"}},
+		{`"part 1" "part 2"`, []string{"part 1", "part 2"}},
+		{`"part 1"   "part 2" unquoted`, []string{"part 1", "part 2", "unquoted"}},
+		{`"say \"hi\"" "back\\slash"`, []string{`say "hi"`, `back\slash`}},
+		{`"caf\195\169"`, []string{"café"}},
+		{`""`, []string{""}},
+		{"", []string{""}},
+		{long, []string{long[:255], long[255:]}},
+		{`"` + long + `"`, []string{long[:255], long[255:]}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := ParseTXTText(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTXTText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTXTText(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	tests := []struct {
+		parts []string
+		want  string
+	}{
+		{[]string{"v=spf1 mx -all"}, "v=spf1 mx -all"},
+		{[]string{"part 1", "part 2"}, `"part 1" "part 2"`},
+		{[]string{`say "hi"`}, `"say \"hi\""`},
+		{[]string{`back\slash`}, `"back\\slash"`},
+		{[]string{" padded "}, `" padded "`},
+		{[]string{""}, `""`},
+		{[]string{long}, `"` + long[:255] + `" "` + long[255:] + `"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := FormatTXTText(tt.parts)
+			if got != tt.want {
+				t.Errorf("FormatTXTText(%q) = %q, want %q", tt.parts, got, tt.want)
+			}
+			// The text is parsed back to the same character-strings, split at 255 bytes
+			var want []string
+			for _, p := range tt.parts {
+				want = append(want, SplitTXTString(p)...)
+			}
+			if parsed := ParseTXTText(got); !reflect.DeepEqual(parsed, want) {
+				t.Errorf("ParseTXTText(%q) = %q, want %q", got, parsed, want)
+			}
+		})
+	}
+}
+
+func TestSplitTXTString(t *testing.T) {
+	// The split does not cut the two bytes of é, which would start at offset 254
+	s := strings.Repeat("a", 254) + "é" + "b"
+	got := SplitTXTString(s)
+	want := []string{strings.Repeat("a", 254), "éb"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitTXTString() = %q, want %q", got, want)
+	}
+	for _, p := range SplitTXTString(strings.Repeat("é", 300)) {
+		if len(p) > MaxTXTStringLength {
+			t.Errorf("SplitTXTString() returned a %d bytes string", len(p))
+		}
+	}
+}
@@ -7,7 +7,10 @@ import (
 
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -252,6 +255,28 @@ func ReadWithPages[T any](read func(offset, limit int32) ([]T, error)) ([]T, err
 	return allResults, nil
 }
 
+// ReadWithPageIds reads all the results of a paged WAPI search (`_paging=1`). read is called with the ID of the page
+// to read, empty for the first one, and returns its results with the `next_page_id` of the response, empty for the
+// last page.
+func ReadWithPageIds[T any](read func(pageId string, limit int32) ([]T, string, error)) ([]T, error) {
+	var allResults []T
+	pageId := ""
+
+	for {
+		results, nextPageId, err := read(pageId, ReadPageSizeLimit)
+		if err != nil {
+			return nil, err
+		}
+		allResults = append(allResults, results...)
+		if nextPageId == "" {
+			break
+		}
+		pageId = nextPageId
+	}
+
+	return allResults, nil
+}
+
 // ToComputedAttributeMap converts a map of resource schema attributes to schema attributes with all fields set to "computed".
 func ToComputedAttributeMap(r map[string]resourceschema.Attribute) map[string]resourceschema.Attribute {
 	d := map[string]resourceschema.Attribute{}
@@ -362,3 +387,37 @@ func ExtractResourceRef(ref string) string {
 	v := strings.SplitN(strings.Trim(ref, "/"), "/", 2)
 	return v[1]
 }
+
+// PlanRefOnChange marks the planned `ref` of an object unknown when one of the given string attributes changes. WAPI
+// references contain the name of the object, e.g. `record:a/ZG5z...:a.example.com/default`, so renaming an object
+// changes its reference.
+func PlanRefOnChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributes ...string) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	for _, name := range attributes {
+		var planned, current types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &current)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planned.Equal(current) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ref"), types.StringUnknown())...)
+			return
+		}
+	}
+}
+
+// IsFullyKnown returns true if the list is known, as well as all its elements.
+func IsFullyKnown(l types.List) bool {
+	if l.IsUnknown() {
+		return false
+	}
+	for _, e := range l.Elements() {
+		if e.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
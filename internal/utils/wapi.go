@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	niosclient "github.com/unasra/nios-go-client/client"
+)
+
+// defaultDNSView is the name of the DNS view WAPI uses for the objects created without a view.
+const defaultDNSView = "default"
+
+// PlanWAPIObject runs the plan checks shared by the resources of WAPI objects, such as the support of the fields of
+// objectType by the grid. It returns false when there is nothing to plan: the provider is not configured yet or the
+// resource is destroyed.
+func PlanWAPIObject(ctx context.Context, client *niosclient.APIClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, objectType, fields string) bool {
+	if client == nil || req.Plan.Raw.IsNull() {
+		return false
+	}
+	CheckWAPIFieldsSupport(ctx, client, objectType, fields, &resp.Diagnostics)
+	return true
+}
+
+// schemaWarnings records the object schemas that could not be retrieved, per client, WAPI version and object type,
+// so that the failure is only reported once.
+var schemaWarnings sync.Map
+
+type schemaWarningKey struct {
+	client     *niosclient.APIClient
+	version    string
+	objectType string
+}
+
+// CheckWAPIFieldsSupport adds an error diagnostic when the WAPI version of the grid does not support some of the
+// fields of objectType used by the provider. fields is a comma separated list, as passed to `_return_fields+`.
+//
+// It is meant to be called at plan time, so that an unsupported field is reported before anything is changed.
+// If the schema of the object cannot be retrieved, a warning is added the first time and the check is skipped.
+func CheckWAPIFieldsSupport(ctx context.Context, client *niosclient.APIClient, objectType, fields string, diags *diag.Diagnostics) {
+	schema, err := client.GetObjectSchema(ctx, objectType)
+	if err != nil {
+		key := schemaWarningKey{client: client, version: client.WAPIVersion(), objectType: objectType}
+		if _, warned := schemaWarnings.LoadOrStore(key, true); warned {
+			return
+		}
+		diags.AddWarning("Unable to check the WAPI schema",
+			fmt.Sprintf("Unable to retrieve the WAPI schema of %s, the fields used by the provider could not be checked against the grid: %s", objectType, err))
+		return
+	}
+
+	var unsupported []string
+	for _, f := range strings.Split(fields, ",") {
+		if f = strings.TrimSpace(f); f != "" && !schema.HasField(f) {
+			unsupported = append(unsupported, f)
+		}
+	}
+	if len(unsupported) == 0 {
+		return
+	}
+
+	diags.AddError("Unsupported WAPI fields",
+		fmt.Sprintf("WAPI version %s of the grid does not support the following fields of %s: %s. "+
+			"Upgrade the grid, or set the `wapi_version` provider attribute to a version that supports them.",
+			client.WAPIVersion(), objectType, strings.Join(unsupported, ", ")))
+}
+
+// IsZoneApex returns true if fqdn is the name of an authoritative zone of the given DNS view, i.e. the apex of the
+// zone. An empty view stands for the default view of the grid.
+func IsZoneApex(ctx context.Context, client *niosclient.APIClient, fqdn, view string) (bool, *http.Response, error) {
+	if view == "" {
+		view = defaultDNSView
+	}
+	zones, httpRes, err := client.SearchObjects(ctx, "zone_auth", map[string]string{
+		"fqdn": strings.ToLower(strings.TrimSuffix(fqdn, ".")),
+		"view": view,
+	}, "fqdn")
+	if err != nil {
+		return false, httpRes, err
+	}
+	return len(zones) > 0, httpRes, nil
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	niosclient "github.com/unasra/nios-go-client/client"
+	"github.com/unasra/nios-go-client/option"
+)
+
+func TestCheckWAPIFieldsSupport(t *testing.T) {
+	// The grid knows the schema of record:a, but fails to return the one of record:aaaa
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if strings.HasSuffix(r.URL.Path, "/record:aaaa") {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(niosclient.ObjectSchema{
+			Type:   "record:a",
+			Fields: []niosclient.SchemaField{{Name: "name"}, {Name: "ipv4addr"}},
+		})
+	}))
+	defer server.Close()
+	client := niosclient.NewAPIClient(option.WithNIOSHostUrl(server.URL), option.WithNIOSAuth("token"))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		var diags diag.Diagnostics
+		CheckWAPIFieldsSupport(ctx, client, "record:a", "name,ipv4addr", &diags)
+		if len(diags) != 0 {
+			t.Errorf("expected no diagnostics, got %v", diags)
+		}
+	}
+	var diags diag.Diagnostics
+	CheckWAPIFieldsSupport(ctx, client, "record:a", "name,ipv4addr,shared_record_group", &diags)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "shared_record_group") {
+		t.Errorf("expected an error on the unsupported field, got %v", diags)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected the schema to be requested once, got %d requests", got)
+	}
+
+	// The failure is only reported once
+	for i := 0; i < 3; i++ {
+		var diags diag.Diagnostics
+		CheckWAPIFieldsSupport(ctx, client, "record:aaaa", "name,ipv6addr", &diags)
+		want := 0
+		if i == 0 {
+			want = 1
+		}
+		if diags.WarningsCount() != want || diags.HasError() {
+			t.Errorf("check %d: expected %d warning(s), got %v", i, want, diags)
+		}
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected the failing schema to be requested once, got %d requests", got-1)
+	}
+}
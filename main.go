@@ -4,10 +4,12 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
 	"github.com/unasra/terraform-provider-nios/internal/provider"
+	"github.com/unasra/terraform-provider-nios/internal/transport"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -30,6 +32,9 @@ var (
 	// https://goreleaser.com/cookbooks/using-main.version/
 )
 
+// logoutTimeout bounds the time spent closing the WAPI sessions on shutdown.
+const logoutTimeout = time.Second
+
 func main() {
 	var debug bool
 
@@ -43,6 +48,13 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version, commit), opts)
 
+	// Terraform stops the provider gracefully once it is done with it, log out of the WAPI sessions before exiting.
+	ctx, cancel := context.WithTimeout(context.Background(), logoutTimeout)
+	if logoutErr := transport.CloseSessions(ctx); logoutErr != nil {
+		log.Printf("[WARN] %s", logoutErr)
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
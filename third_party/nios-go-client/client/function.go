@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// FunctionError is returned by CallFunction when WAPI rejects a function call.
+type FunctionError struct {
+	function string
+	status   string
+	body     []byte
+}
+
+// Error returns the status of the response and the name of the function.
+func (e *FunctionError) Error() string {
+	return fmt.Sprintf("unable to call %s: %s", e.function, e.status)
+}
+
+// Body returns the body of the response, which holds the WAPI error.
+func (e *FunctionError) Body() []byte {
+	return e.body
+}
+
+// CallFunction calls the WAPI function of the object with the given reference, such as `dnssec_operation` on a zone.
+// args are sent as the JSON body of the call, and the result of the function is decoded into result unless it is nil.
+//
+// It is meant for the functions the client has no typed API for.
+func (c *APIClient) CallFunction(ctx context.Context, ref, function string, args, result interface{}) (*http.Response, error) {
+	base, err := c.DNSAPI.Cfg.ServerURL(0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("_function", function)
+	headers := map[string]string{"Accept": "application/json", "Content-Type": "application/json"}
+	req, err := c.DNSAPI.PrepareRequest(ctx, base+"/"+ref, http.MethodPost, args, headers, query, url.Values{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.DNSAPI.CallAPI(req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+	if resp.StatusCode >= 300 {
+		return resp, &FunctionError{function: function, status: resp.Status, body: respBody}
+	}
+	if result == nil || len(respBody) == 0 {
+		return resp, nil
+	}
+	return resp, json.Unmarshal(respBody, result)
+}
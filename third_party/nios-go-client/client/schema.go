@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// schemaVersionPath is the WAPI version every NIOS release supports, used to discover the supported versions.
+const schemaVersionPath = "/wapi/v1.0/"
+
+// WAPISchema is the WAPI schema of the grid, as returned by `?_schema`.
+type WAPISchema struct {
+	RequestedVersion  string   `json:"requested_version"`
+	SupportedObjects  []string `json:"supported_objects"`
+	SupportedVersions []string `json:"supported_versions"`
+}
+
+// ObjectSchema is the WAPI schema of an object type, as returned by `<object>?_schema`.
+type ObjectSchema struct {
+	Type    string        `json:"type"`
+	Version string        `json:"version"`
+	Fields  []SchemaField `json:"fields"`
+}
+
+// SchemaField describes a field of an object type.
+type SchemaField struct {
+	Name string `json:"name"`
+	// Supports lists the supported operations: r(ead), w(rite), u(pdate), s(earch) and d(elete).
+	Supports string `json:"supports"`
+}
+
+// HasField returns true if the object type has a field with the given name.
+func (s *ObjectSchema) HasField(name string) bool {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaCache caches the object schemas fetched by an APIClient, per WAPI version and object type.
+type schemaCache struct {
+	objects sync.Map // schemaKey -> *schemaEntry
+}
+
+type schemaKey struct {
+	version    string
+	objectType string
+}
+
+// schemaEntry is the result of the request of an object schema. A failed request is not repeated either.
+type schemaEntry struct {
+	once   sync.Once
+	schema *ObjectSchema
+	err    error
+}
+
+// WAPIVersion returns the WAPI version used by the client.
+func (c *APIClient) WAPIVersion() string {
+	u, err := c.DNSAPI.Cfg.ServerURL(0, nil)
+	if err != nil {
+		return ""
+	}
+	if i := strings.LastIndex(u, "/wapi/v"); i >= 0 {
+		return u[i+len("/wapi/v"):]
+	}
+	return ""
+}
+
+// GetWAPISchema returns the WAPI schema of the grid, including the WAPI versions it supports.
+// The schema is requested with WAPI version 1.0, so it succeeds whatever the NIOS release of the grid.
+func (c *APIClient) GetWAPISchema(ctx context.Context) (*WAPISchema, error) {
+	var schema WAPISchema
+	if err := c.getSchema(ctx, c.DNSAPI.Cfg.NIOSHostURL+schemaVersionPath, &schema); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// GetObjectSchema returns the WAPI schema of the given object type, e.g. "record:a", for the WAPI version used by the client.
+// Schemas are requested once per WAPI version and object type for the lifetime of the client, as are the errors.
+func (c *APIClient) GetObjectSchema(ctx context.Context, objectType string) (*ObjectSchema, error) {
+	v, _ := c.schemas.objects.LoadOrStore(schemaKey{version: c.WAPIVersion(), objectType: objectType}, &schemaEntry{})
+	e := v.(*schemaEntry)
+	e.once.Do(func() {
+		base, err := c.DNSAPI.Cfg.ServerURL(0, nil)
+		if err != nil {
+			e.err = err
+			return
+		}
+		var schema ObjectSchema
+		if e.err = c.getSchema(ctx, base+"/"+objectType, &schema); e.err == nil {
+			e.schema = &schema
+		}
+	})
+	return e.schema, e.err
+}
+
+func (c *APIClient) getSchema(ctx context.Context, path string, v interface{}) error {
+	query := url.Values{}
+	query.Set("_schema", "1")
+	req, err := c.DNSAPI.PrepareRequest(ctx, path, http.MethodGet, nil, map[string]string{"Accept": "application/json"}, query, url.Values{}, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.DNSAPI.CallAPI(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unable to get the WAPI schema: %s, '%s'", resp.Status, body)
+	}
+	return json.Unmarshal(body, v)
+}
+
+// LatestWAPIVersion returns the most recent of the given WAPI versions.
+func LatestWAPIVersion(versions []string) string {
+	if len(versions) == 0 {
+		return ""
+	}
+	sorted := append([]string(nil), versions...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return CompareWAPIVersions(sorted[i], sorted[j]) < 0
+	})
+	return sorted[len(sorted)-1]
+}
+
+// CompareWAPIVersions compares two WAPI versions such as "2.9" and "2.12.3".
+// The result is 0 if a == b, -1 if a < b and +1 if a > b.
+func CompareWAPIVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
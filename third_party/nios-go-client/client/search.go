@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// SearchError is returned by SearchObjects when WAPI rejects a search.
+type SearchError struct {
+	objectType string
+	status     string
+	body       []byte
+}
+
+// Error returns the status of the response and the searched object type.
+func (e *SearchError) Error() string {
+	return fmt.Sprintf("unable to search %s objects: %s", e.objectType, e.status)
+}
+
+// Body returns the body of the response, which holds the WAPI error.
+func (e *SearchError) Body() []byte {
+	return e.body
+}
+
+// SearchObjects returns the objects of the given type matching filters, e.g. {"fqdn": "example.com"}.
+// Each object is returned as decoded from WAPI, with its `_ref` and the requested returnFields.
+//
+// It is meant for the lookups of objects the client has no typed API for.
+func (c *APIClient) SearchObjects(ctx context.Context, objectType string, filters map[string]string, returnFields string) ([]map[string]interface{}, *http.Response, error) {
+	base, err := c.DNSAPI.Cfg.ServerURL(0, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := url.Values{}
+	for k, v := range filters {
+		query.Set(k, v)
+	}
+	if returnFields != "" {
+		query.Set("_return_fields", returnFields)
+	}
+	req, err := c.DNSAPI.PrepareRequest(ctx, base+"/"+objectType, http.MethodGet, nil, map[string]string{"Accept": "application/json"}, query, url.Values{}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := c.DNSAPI.CallAPI(req)
+	if err != nil {
+		return nil, resp, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, resp, &SearchError{objectType: objectType, status: resp.Status, body: body}
+	}
+	var objects []map[string]interface{}
+	if err := json.Unmarshal(body, &objects); err != nil {
+		return nil, resp, err
+	}
+	return objects, resp, nil
+}
@@ -0,0 +1,3 @@
+module github.com/unasra/nios-go-client
+
+go 1.19
@@ -0,0 +1,5 @@
+package internal
+
+type MappedNullable interface {
+	ToMap() (map[string]interface{}, error)
+}